@@ -0,0 +1,14 @@
+// Package analysis provides tools for conducting quantitative analysis on top
+// of the calculators defined by package core
+package analysis
+
+import "errors"
+
+// Sentinel errors that can be wrapped and returned by this package
+var (
+	ErrNoTaxCalc     = errors.New("no tax calculator given")
+	ErrNoFinances    = errors.New("no finances given")
+	ErrNoSpouse      = errors.New("target spouse does not exist in finances")
+	ErrInvalidRange  = errors.New("invalid range")
+	ErrInvalidSource = errors.New("invalid financial source")
+)
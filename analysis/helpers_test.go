@@ -0,0 +1,80 @@
+package analysis
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+)
+
+var (
+	_ core.TaxCalculator          = (*testTaxCalculator)(nil)
+	_ core.ChildBenefitCalculator = (*testBenefitCalculator)(nil)
+)
+
+// testTaxCalculator applies a flat rate on the earned income of both spouses
+type testTaxCalculator struct {
+	rate                      float64
+	finances                  core.HouseholdFinances
+	depsPassedOnSetDependents []*human.Person
+}
+
+func (ttc *testTaxCalculator) TaxPayable() (float64, float64, []core.TaxCredit) {
+	var taxA, taxB float64
+	if ttc.finances.SpouseA() != nil {
+		taxA = ttc.rate * ttc.finances.SpouseA().TotalAmount(core.IncSrcEarned)
+	}
+	if ttc.finances.SpouseB() != nil {
+		taxB = ttc.rate * ttc.finances.SpouseB().TotalAmount(core.IncSrcEarned)
+	}
+	return taxA, taxB, nil
+}
+func (ttc *testTaxCalculator) TaxBreakdown() ([]core.TaxBreakdown, []core.TaxBreakdown, []core.TaxCredit) {
+	return nil, nil, nil
+}
+func (ttc *testTaxCalculator) TaxRates(_ ...core.FinancialSource) (core.TaxRates, core.TaxRates) {
+	return core.TaxRates{}, core.TaxRates{}
+}
+func (ttc *testTaxCalculator) SetFinances(f core.HouseholdFinances, _ []core.TaxCredit) {
+	ttc.finances = f
+}
+func (ttc *testTaxCalculator) SetDependents(deps []*human.Person) {
+	ttc.depsPassedOnSetDependents = deps
+}
+func (ttc *testTaxCalculator) Year() uint {
+	return 0
+}
+func (ttc *testTaxCalculator) Regions() []core.Region {
+	return nil
+}
+
+// testBenefitCalculator reduces a maximum benefit by a flat rate of the earned
+// income of spouse A
+type testBenefitCalculator struct {
+	max           float64
+	reductionRate float64
+	finances      core.HouseholdFinances
+	children      []*human.Person
+}
+
+func (tbc *testBenefitCalculator) BenefitRecievable() float64 {
+	reduction := tbc.reductionRate * tbc.finances.SpouseA().TotalAmount(core.IncSrcEarned)
+	if reduction > tbc.max {
+		return 0.0
+	}
+	return tbc.max - reduction
+}
+func (tbc *testBenefitCalculator) MonthlyBenefitRecievable(_ uint) float64 {
+	return tbc.BenefitRecievable() / 12.0
+}
+func (tbc *testBenefitCalculator) PaymentSchedule() core.PaymentSchedule {
+	var schedule core.PaymentSchedule
+	for month := range schedule {
+		schedule[month] = tbc.MonthlyBenefitRecievable(uint(month))
+	}
+	return schedule
+}
+func (tbc *testBenefitCalculator) SetFinances(f core.HouseholdFinances) {
+	tbc.finances = f
+}
+func (tbc *testBenefitCalculator) SetBeneficiaries(children []*human.Person) {
+	tbc.children = children
+}
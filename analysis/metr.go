@@ -0,0 +1,185 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/pkg/errors"
+)
+
+// MaxMETRPoints is the maximum number of points of a METR curve
+const MaxMETRPoints = 100000
+
+// METRConfig is used to pass configurations for computing the marginal
+// effective tax rate (METR) curve of a household
+type METRConfig struct {
+	// TaxCalc is used to calculate the payable tax of the household
+	TaxCalc core.TaxCalculator
+	// BenefitCalc is used to calculate the recievable benefits of the
+	// household. If nil, the household is assumed to recieve no benefits
+	BenefitCalc core.ChildBenefitCalculator
+	// Finances is the baseline finances of the household, which are never
+	// modified while computing the curve
+	Finances core.HouseholdFinances
+	// Dependents are the dependents of the household, which are used as the
+	// tax dependents as well as the beneficiaries of the benefits
+	Dependents []*human.Person
+	// Source is the income source whose amount is swept
+	Source core.FinancialSource
+	// TargetSpouseB makes the sweep on spouse B's finances instead of the
+	// default spouse A
+	TargetSpouseB bool
+	// From is the first amount of the sweep
+	From float64
+	// To is the last amount of the sweep (inclusive)
+	To float64
+	// Step is the increment between successive amounts of the sweep
+	Step float64
+}
+
+// validate checks if the configurations are valid for use
+func (cfg METRConfig) validate() error {
+
+	if cfg.TaxCalc == nil {
+		return ErrNoTaxCalc
+	}
+
+	if cfg.Finances == nil {
+		return ErrNoFinances
+	}
+
+	if cfg.TargetSpouseB && cfg.Finances.SpouseB() == nil {
+		return errors.Wrap(ErrNoSpouse, "spouse B")
+	}
+
+	if !cfg.TargetSpouseB && cfg.Finances.SpouseA() == nil {
+		return errors.Wrap(ErrNoSpouse, "spouse A")
+	}
+
+	if !cfg.Source.IsIncomeSource() {
+		return errors.Wrap(ErrInvalidSource, "swept source must be an income source")
+	}
+
+	if cfg.Step <= 0 || math.IsInf(cfg.Step, 0) || math.IsNaN(cfg.Step) {
+		return errors.Wrapf(ErrInvalidRange, "step: %.2f", cfg.Step)
+	}
+
+	for _, bound := range []float64{cfg.From, cfg.To} {
+		if math.IsInf(bound, 0) || math.IsNaN(bound) {
+			return errors.Wrapf(ErrInvalidRange, "[%.2f, %.2f]", cfg.From, cfg.To)
+		}
+	}
+
+	if cfg.From > cfg.To {
+		return errors.Wrapf(ErrInvalidRange, "[%.2f, %.2f]", cfg.From, cfg.To)
+	}
+
+	if cfg.pointCount() > MaxMETRPoints {
+		return errors.Wrapf(ErrInvalidRange, "more than %d points", MaxMETRPoints)
+	}
+
+	return nil
+}
+
+// pointCount returns the number of points of the configured sweep, which is
+// not bounded for invalid ranges
+func (cfg METRConfig) pointCount() float64 {
+	return math.Floor((cfg.To-cfg.From)/cfg.Step) + 1
+}
+
+// METRPoint represents the household's position at a single point of a METR
+// curve
+type METRPoint struct {
+	// Income is the amount of the swept income source
+	Income float64
+	// Tax is the household's payable tax
+	Tax float64
+	// Benefits is the household's recievable benefits
+	Benefits float64
+	// DisposableIncome is the household's total income less payable tax plus
+	// recievable benefits
+	DisposableIncome float64
+	// METR is the proportion of an extra dollar from the swept source that is
+	// lost to tax and benefit reductions
+	METR float64
+}
+
+// NewMETRCurve sweeps the amount of the configured income source of the target
+// spouse over the configured range and returns one point for each amount. The
+// METR of a point is computed from the change in disposable income between the
+// point and the next step
+func NewMETRCurve(cfg METRConfig) ([]METRPoint, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	finances := cfg.Finances.Clone()
+	target := finances.MutableSpouseA()
+	if cfg.TargetSpouseB {
+		target = finances.MutableSpouseB()
+	}
+
+	cfg.TaxCalc.SetFinances(finances, nil)
+	cfg.TaxCalc.SetDependents(cfg.Dependents)
+	if cfg.BenefitCalc != nil {
+		cfg.BenefitCalc.SetFinances(finances)
+		cfg.BenefitCalc.SetBeneficiaries(cfg.Dependents)
+	}
+
+	pointAt := func(amount float64) METRPoint {
+		target.SetAmount(cfg.Source, amount)
+		return newMETRPoint(cfg, finances, amount)
+	}
+
+	count := int(cfg.pointCount())
+	curve := make([]METRPoint, count)
+	for i := range curve {
+		curve[i] = pointAt(cfg.From + float64(i)*cfg.Step)
+	}
+
+	next := pointAt(cfg.From + float64(count)*cfg.Step)
+	for i := len(curve) - 1; i >= 0; i-- {
+		gain := next.DisposableIncome - curve[i].DisposableIncome
+		curve[i].METR = 1.0 - (gain / (next.Income - curve[i].Income))
+		next = curve[i]
+	}
+
+	return curve, nil
+}
+
+// newMETRPoint returns a point of the METR curve for the given finances, which
+// are expected to be set in the configured calculators
+func newMETRPoint(cfg METRConfig, finances core.HouseholdFinances, amount float64) METRPoint {
+
+	taxA, taxB, _ := cfg.TaxCalc.TaxPayable()
+
+	var benefits float64
+	if cfg.BenefitCalc != nil {
+		benefits = cfg.BenefitCalc.BenefitRecievable()
+	}
+
+	totalIncome := householdIncome(finances)
+	return METRPoint{
+		Income:           amount,
+		Tax:              taxA + taxB,
+		Benefits:         benefits,
+		DisposableIncome: totalIncome - (taxA + taxB) + benefits,
+	}
+}
+
+// householdIncome returns the total amount of all income sources of both
+// spouses in the given finances
+func householdIncome(finances core.HouseholdFinances) float64 {
+
+	var total float64
+	for _, spouse := range []core.Financer{finances.SpouseA(), finances.SpouseB()} {
+		if spouse == nil {
+			continue
+		}
+		total += spouse.TotalAmount(spouse.IncomeSources()...)
+	}
+	return total
+}
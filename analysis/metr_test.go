@@ -0,0 +1,176 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/finance"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/pkg/errors"
+)
+
+func TestMETRConfig_validate(t *testing.T) {
+
+	couple := core.NewHouseholdFinancesNop()
+	single := finance.NewHouseholdFinances(finance.NewIndividualFinances(), nil)
+	valid := METRConfig{
+		TaxCalc:  &testTaxCalculator{},
+		Finances: couple,
+		Source:   core.IncSrcEarned,
+		From:     0,
+		To:       1000,
+		Step:     100,
+	}
+
+	cases := []struct {
+		name   string
+		mutate func(*METRConfig)
+		err    error
+	}{
+		{name: "valid", mutate: func(*METRConfig) {}, err: nil},
+		{name: "no-tax-calc", mutate: func(c *METRConfig) { c.TaxCalc = nil }, err: ErrNoTaxCalc},
+		{name: "no-finances", mutate: func(c *METRConfig) { c.Finances = nil }, err: ErrNoFinances},
+		{
+			name:   "no-spouse-b",
+			mutate: func(c *METRConfig) { c.Finances, c.TargetSpouseB = single, true },
+			err:    ErrNoSpouse,
+		},
+		{
+			name:   "no-spouse-a",
+			mutate: func(c *METRConfig) { c.Finances = finance.NewHouseholdFinances(nil, nil) },
+			err:    ErrNoSpouse,
+		},
+		{name: "deduction-source", mutate: func(c *METRConfig) { c.Source = core.DeducSrcRRSP }, err: ErrInvalidSource},
+		{name: "zero-step", mutate: func(c *METRConfig) { c.Step = 0 }, err: ErrInvalidRange},
+		{name: "inf-step", mutate: func(c *METRConfig) { c.Step = math.Inf(1) }, err: ErrInvalidRange},
+		{name: "reversed-range", mutate: func(c *METRConfig) { c.From, c.To = 1000, 0 }, err: ErrInvalidRange},
+		{name: "inf-range", mutate: func(c *METRConfig) { c.To = math.Inf(1) }, err: ErrInvalidRange},
+		{name: "nan-from", mutate: func(c *METRConfig) { c.From = math.NaN() }, err: ErrInvalidRange},
+		{name: "nan-to", mutate: func(c *METRConfig) { c.To = math.NaN() }, err: ErrInvalidRange},
+		{name: "too-many-points", mutate: func(c *METRConfig) { c.From, c.To, c.Step = 0, 1e6, 1e-3 }, err: ErrInvalidRange},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+			cfg := valid
+			c.mutate(&cfg)
+			err := cfg.validate()
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestNewMETRCurve(t *testing.T) {
+
+	spouseA := finance.NewIndividualFinances()
+	spouseA.SetAmount(core.IncSrcInterest, 500)
+	finances := finance.NewHouseholdFinances(spouseA, finance.NewIndividualFinances())
+
+	deps := []*human.Person{{Name: "child", AgeMonths: 12}}
+	taxCalc := &testTaxCalculator{rate: 0.20}
+	benefitCalc := &testBenefitCalculator{max: 1000, reductionRate: 0.10}
+
+	curve, err := NewMETRCurve(METRConfig{
+		TaxCalc:     taxCalc,
+		BenefitCalc: benefitCalc,
+		Finances:    finances,
+		Dependents:  deps,
+		Source:      core.IncSrcEarned,
+		From:        0,
+		To:          20000,
+		Step:        5000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []METRPoint{
+		{Income: 0, Tax: 0, Benefits: 1000, DisposableIncome: 1500, METR: 0.30},
+		{Income: 5000, Tax: 1000, Benefits: 500, DisposableIncome: 5000, METR: 0.30},
+		{Income: 10000, Tax: 2000, Benefits: 0, DisposableIncome: 8500, METR: 0.20},
+		{Income: 15000, Tax: 3000, Benefits: 0, DisposableIncome: 12500, METR: 0.20},
+		{Income: 20000, Tax: 4000, Benefits: 0, DisposableIncome: 16500, METR: 0.20},
+	}
+
+	if len(curve) != len(expected) {
+		t.Fatalf("expected %d points, got: %d", len(expected), len(curve))
+	}
+
+	for i := range expected {
+		actual, want := curve[i], expected[i]
+		if math.Abs(actual.METR-want.METR) > 1e-9 {
+			t.Errorf("point %d: unexpected METR\nwant: %.4f\n got: %.4f", i, want.METR, actual.METR)
+		}
+		actual.METR, want.METR = 0, 0
+		if actual != want {
+			t.Errorf("point %d: actual does not match expected\nwant: %+v\n got: %+v", i, want, actual)
+		}
+	}
+
+	if spouseA.TotalAmount(core.IncSrcEarned) != 0 {
+		t.Error("expected the given finances to not be modified")
+	}
+
+	if len(taxCalc.depsPassedOnSetDependents) != 1 || len(benefitCalc.children) != 1 {
+		t.Error("expected dependents to be set in the calculators")
+	}
+}
+
+func TestNewMETRCurve_NoBenefitCalc(t *testing.T) {
+
+	finances := finance.NewHouseholdFinances(nil, finance.NewIndividualFinances())
+	curve, err := NewMETRCurve(METRConfig{
+		TaxCalc:       &testTaxCalculator{rate: 0.25},
+		Finances:      finances,
+		Source:        core.IncSrcEarned,
+		TargetSpouseB: true,
+		From:          1000,
+		To:            1500,
+		Step:          1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(curve) != 1 {
+		t.Fatalf("expected 1 point, got: %d", len(curve))
+	}
+	if curve[0].Benefits != 0 {
+		t.Errorf("expected no benefits, got: %.2f", curve[0].Benefits)
+	}
+	if math.Abs(curve[0].METR-0.25) > 1e-9 {
+		t.Errorf("unexpected METR\nwant: %.4f\n got: %.4f", 0.25, curve[0].METR)
+	}
+}
+
+func TestNewMETRCurve_Error(t *testing.T) {
+
+	_, err := NewMETRCurve(METRConfig{})
+	if errors.Cause(err) != ErrNoTaxCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoTaxCalc, err)
+	}
+
+	cfg := METRConfig{
+		TaxCalc:  &testTaxCalculator{},
+		Finances: core.NewHouseholdFinancesNop(),
+		Source:   core.IncSrcEarned,
+		From:     math.NaN(),
+		To:       1000,
+		Step:     100,
+	}
+	_, err = NewMETRCurve(cfg)
+	if errors.Cause(err) != ErrInvalidRange {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidRange, err)
+	}
+
+	cfg.From, cfg.Step = 0, 1e-9
+	_, err = NewMETRCurve(cfg)
+	if errors.Cause(err) != ErrInvalidRange {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidRange, err)
+	}
+}
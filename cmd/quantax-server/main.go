@@ -0,0 +1,47 @@
+// Command quantax-server serves the quantax calculators over HTTP/JSON. See
+// package server for the endpoints.
+//
+// Usage:
+//   quantax-server [-addr host:port] [-rules file]
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/malkhamis/quantax/history"
+	"github.com/malkhamis/quantax/server"
+)
+
+func main() {
+
+	addr := flag.String("addr", "localhost:8080", "the `address` to listen on")
+	rules := flag.String("rules", "", "rule `file` (.json, .yaml, or .yml) with additional params")
+	flag.Parse()
+
+	registry := history.DefaultRegistry()
+	if *rules != "" {
+		registry = registry.Clone()
+		err := registry.LoadRulesFile(*rules)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	handler, err := server.NewServer(registry)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := &http.Server{
+		Addr:         *addr,
+		Handler:      handler,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
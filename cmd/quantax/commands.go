@@ -0,0 +1,185 @@
+package main
+
+import (
+	"io"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/pkg/errors"
+)
+
+// runTax writes the payable tax of each spouse of the household
+func runTax(opts *options, w io.Writer) error {
+
+	h := opts.household
+	finances := h.Finances()
+	dependents, err := h.DependentPeople()
+	if err != nil {
+		return err
+	}
+
+	calculator, err := factory.NewTaxFactoryWithRegistry(opts.registry, h.Year, h.Regions...).NewCalculator()
+	if err != nil {
+		return err
+	}
+	calculator.SetFinances(finances, nil)
+	calculator.SetDependents(dependents)
+
+	breakdownA, breakdownB, credits := calculator.TaxBreakdown()
+
+	report := &taxReport{
+		Year:    h.Year,
+		Regions: h.Regions,
+		SpouseA: newSpouseTax(breakdownA),
+		Credits: newCreditReports(credits),
+	}
+	report.Total = report.SpouseA.Total
+	if h.IsCouple() {
+		report.SpouseB = newSpouseTax(breakdownB)
+		report.Total += report.SpouseB.Total
+	}
+
+	return writeReport(w, report, opts.json)
+}
+
+// runBenefits writes the child benefits receivable by the household
+func runBenefits(opts *options, w io.Writer) error {
+
+	h := opts.household
+	finances := h.Finances()
+	dependents, err := h.DependentPeople()
+	if err != nil {
+		return err
+	}
+
+	regions := h.benefitRegions(opts.registry)
+	if len(regions) == 0 {
+		return errors.Errorf("no child benefits for %v in %d", h.Regions, h.Year)
+	}
+
+	report := &benefitsReport{Year: h.Year}
+	for _, region := range regions {
+
+		calculator, err := factory.NewChildBenefitFactoryWithRegistry(opts.registry, h.Year, region).NewCalculator()
+		if err != nil {
+			return err
+		}
+		calculator.SetFinances(finances)
+		calculator.SetBeneficiaries(dependents)
+
+		amount := calculator.BenefitRecievable()
+		report.Benefits = append(report.Benefits, regionAmount{Region: region, Amount: amount})
+		report.Total += amount
+	}
+
+	return writeReport(w, report, opts.json)
+}
+
+// runRRSPRefund writes the tax refund of contributing the given amount to the
+// RRSP of the given spouse
+func runRRSPRefund(opts *options, w io.Writer) error {
+	return runRRSP(opts, w, true)
+}
+
+// runRRSPWithdraw writes the tax paid on withdrawing the given amount from the
+// RRSP of the given spouse
+func runRRSPWithdraw(opts *options, w io.Writer) error {
+	return runRRSP(opts, w, false)
+}
+
+// runRRSP writes the tax effect of an RRSP contribution or withdrawal
+func runRRSP(opts *options, w io.Writer, isContribution bool) error {
+
+	if opts.amount < 0 {
+		return errors.Wrap(errInvalidArgs, "negative amount")
+	}
+
+	h := opts.household
+	finances := h.Finances()
+	dependents, err := h.DependentPeople()
+	if err != nil {
+		return err
+	}
+
+	config := factory.RRSPFactoryConfig{
+		Year:       h.Year,
+		RRSPRegion: h.RRSPRegion,
+		TaxRegions: h.Regions,
+	}
+	calculator, err := factory.NewRRSPFactoryWithRegistry(opts.registry, config).NewCalculator()
+	if err != nil {
+		return err
+	}
+	calculator.SetFinances(finances, nil)
+	calculator.SetDependents(dependents)
+
+	report := &rrspReport{
+		Year:    h.Year,
+		Regions: h.Regions,
+		Spouse:  "a",
+		Amount:  opts.amount,
+	}
+	if opts.spouseB {
+		calculator.SetTargetSpouseB()
+		report.Spouse = "b"
+	}
+
+	report.ContributionEarned = calculator.ContributionEarned()
+	if isContribution {
+		refund, _ := calculator.TaxRefund(opts.amount)
+		report.Action, report.TaxRefund = "contribution", &refund
+	} else {
+		paid, _ := calculator.TaxPaid(opts.amount)
+		report.Action, report.TaxPaid = "withdrawal", &paid
+	}
+
+	return writeReport(w, report, opts.json)
+}
+
+// newSpouseTax returns the tax report of a single spouse, where the total is
+// the sum of the net payable tax in the given breakdowns
+func newSpouseTax(breakdowns []core.TaxBreakdown) *spouseTax {
+
+	spouse := &spouseTax{}
+	for _, b := range breakdowns {
+
+		spouse.Total += b.NetPayable
+
+		regional := regionTax{
+			Region:         b.Region,
+			NetIncome:      b.NetIncome,
+			GrossTax:       b.GrossTax,
+			CreditsApplied: b.CreditsApplied,
+			NetPayable:     b.NetPayable,
+		}
+		for _, adj := range b.Adjustments {
+			regional.Adjustments = append(regional.Adjustments, adjustment{
+				Description: adj.Description,
+				Amount:      adj.Amount,
+			})
+		}
+
+		spouse.Regions = append(spouse.Regions, regional)
+	}
+	return spouse
+}
+
+// newCreditReports returns the reports of the given tax credits
+func newCreditReports(credits []core.TaxCredit) []creditReport {
+
+	var reports []creditReport
+	for _, cr := range credits {
+		if cr == nil {
+			continue
+		}
+		initial, used, remaining := cr.Amounts()
+		reports = append(reports, creditReport{
+			Region:      cr.Region(),
+			Description: cr.Description(),
+			Initial:     initial,
+			Used:        used,
+			Remaining:   remaining,
+		})
+	}
+	return reports
+}
@@ -0,0 +1,8 @@
+package main
+
+import "errors"
+
+// Errors this command may return
+var (
+	errInvalidArgs = errors.New("invalid arguments")
+)
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// household is the description of a household read by the commands
+type household struct {
+	factory.HouseholdDesc
+	// BenefitRegions are the regions to calculate child benefits for. If not
+	// set, the tax regions that have child benefits for the year are used
+	BenefitRegions []core.Region `json:"benefit_regions,omitempty"`
+	// RRSPRegion is the region of the RRSP rules, which defaults to Canada
+	RRSPRegion core.Region `json:"rrsp_region,omitempty"`
+}
+
+// readHousehold decodes and validates a household description from r
+func readHousehold(r io.Reader) (*household, error) {
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	h := &household{}
+	err := decoder.Decode(h)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding household")
+	}
+
+	err = h.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if h.RRSPRegion == "" {
+		h.RRSPRegion = core.RegionCA
+	}
+
+	return h, nil
+}
+
+// benefitRegions returns the regions to calculate child benefits for
+func (h *household) benefitRegions(registry *history.Registry) []core.Region {
+
+	if len(h.BenefitRegions) > 0 {
+		return h.BenefitRegions
+	}
+
+	var regions []core.Region
+	for _, region := range h.Regions {
+		_, err := registry.ChildBenefitParams(h.Year, region)
+		if err == nil {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// sourceNames returns the names of all financial sources in the order they
+// are declared in package core
+func sourceNames() []string {
+
+	var names []string
+	for _, src := range core.FinancialSources() {
+		name, err := src.MarshalText()
+		if err == nil {
+			names = append(names, string(name))
+		}
+	}
+	return names
+}
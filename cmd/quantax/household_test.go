@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+func TestReadHousehold(t *testing.T) {
+
+	input := `{
+		"year": 2019,
+		"regions": ["Canada", "British Columbia"],
+		"spouse_a": {"earned": 50000, "rrsp-contribution": 1000},
+		"spouse_b": {},
+		"dependents": [
+			{"name": "A", "age_months": 12},
+			{"name": "B", "birth_date": "2018-10-15", "disability_eligible": true, "custody_percent": 50},
+			{"name": "C", "age_months": 240, "student": "full_time", "residency": "part_year", "days_resident": 100}
+		]
+	}`
+
+	h, err := readHousehold(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !h.IsCouple() {
+		t.Error("expected household with an empty spouse_b to be a couple")
+	}
+
+	if h.RRSPRegion != core.RegionCA {
+		t.Errorf("unexpected RRSP region\nwant: %q\n got: %q", core.RegionCA, h.RRSPRegion)
+	}
+
+	actual := h.Finances().SpouseA().TotalAmount(core.IncSrcEarned, core.DeducSrcRRSP)
+	if actual != 51000 {
+		t.Errorf("unexpected amounts\nwant: %.2f\n got: %.2f", 51000.0, actual)
+	}
+
+	expectedDeps := []*human.Person{
+		{Name: "A", AgeMonths: 12},
+		{
+			Name:               "B",
+			BirthDate:          time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC),
+			DisabilityEligible: true,
+			CustodyPercent:     50,
+		},
+		{
+			Name:         "C",
+			AgeMonths:    240,
+			Student:      human.FullTimeStudent,
+			Residency:    human.PartYearResident,
+			DaysResident: 100,
+		},
+	}
+	dependents, err := h.DependentPeople()
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := deep.Equal(dependents, expectedDeps)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	regions := h.benefitRegions(history.DefaultRegistry())
+	diff = deep.Equal(regions, []core.Region{core.RegionCA, core.RegionBC})
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestReadHousehold_Errors(t *testing.T) {
+
+	cases := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "missing-year",
+			input: `{"regions": ["Canada"], "spouse_a": {}}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "missing-regions",
+			input: `{"year": 2019, "spouse_a": {}}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "missing-spouse-a",
+			input: `{"year": 2019, "regions": ["Canada"]}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "unknown-source",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "spouse_b": {"lottery": 1}}`,
+			err:   core.ErrUnknownSource,
+		},
+		{
+			name:  "invalid-birth-date",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"birth_date": "15/10/2018"}]}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "invalid-student",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"student": "sometimes"}]}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "invalid-residency",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"residency": "abroad"}]}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "invalid-custody",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"custody_percent": 101}]}`,
+			err:   factory.ErrInvalidHousehold,
+		},
+		{
+			name:  "unknown-field",
+			input: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "pets": 2}`,
+			err:   nil, // decoding errors are not sentinel errors
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			_, err := readHousehold(strings.NewReader(c.input))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if c.err != nil && errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Command quantax estimates the taxes, child benefits, and RRSP tax effects of
+// a household described in a JSON file.
+//
+// Usage:
+//   quantax <command> [flags]
+//
+// The commands are:
+//   tax            the payable tax of each spouse
+//   benefits       the child benefits receivable by the household
+//   rrsp-refund    the tax refund of an RRSP contribution
+//   rrsp-withdraw  the tax paid on an RRSP withdrawal
+//
+// The household is read from the file given with -in, or from the standard
+// input if not given. For example:
+//   {
+//     "year": 2022,
+//     "regions": ["Canada", "British Columbia"],
+//     "spouse_a": {"earned": 85000, "rrsp-contribution": 5000},
+//     "spouse_b": {"earned": 30000},
+//     "dependents": [{"name": "A", "age_months": 30}]
+//   }
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// command runs a subcommand for the given options and writes its report to w
+type command func(opts *options, w io.Writer) error
+
+// commands maps the names of subcommands to their implementation
+var commands = map[string]command{
+	"tax":           runTax,
+	"benefits":      runBenefits,
+	"rrsp-refund":   runRRSPRefund,
+	"rrsp-withdraw": runRRSPWithdraw,
+}
+
+// options holds the parsed flags and inputs of a subcommand
+type options struct {
+	household *household
+	registry  *history.Registry
+	json      bool
+	amount    float64
+	spouseB   bool
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command line given by args and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	if len(args) == 0 {
+		printUsage(stderr)
+		return 2
+	}
+
+	name := args[0]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		printUsage(stdout)
+		return 0
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "quantax: unknown command %q\n\n", name)
+		printUsage(stderr)
+		return 2
+	}
+
+	opts, err := parseOptions(name, args[1:], stdin, stderr)
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "quantax %s: %v\n", name, err)
+		return 2
+	}
+
+	err = cmd(opts, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "quantax %s: %v\n", name, err)
+		return 1
+	}
+
+	return 0
+}
+
+// parseOptions parses the flags of the given subcommand and reads the
+// household and rules they refer to
+func parseOptions(name string, args []string, stdin io.Reader, stderr io.Writer) (*options, error) {
+
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	in := flags.String("in", "-", "household `file`, or - for the standard input")
+	rules := flags.String("rules", "", "rule `file` (.json, .yaml, or .yml) with additional params")
+	asJSON := flags.Bool("json", false, "print the report as JSON")
+
+	var amount *float64
+	var spouse *string
+	if strings.HasPrefix(name, "rrsp-") {
+		amount = flags.Float64("amount", 0, "the RRSP contribution or withdrawal `amount`")
+		spouse = flags.String("spouse", "a", "the `spouse` (a or b) who contributes or withdraws")
+	}
+
+	err := flags.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flags.NArg() > 0 {
+		return nil, errors.Wrapf(errInvalidArgs, "unexpected arguments %q", flags.Args())
+	}
+
+	opts := &options{
+		registry: history.DefaultRegistry(),
+		json:     *asJSON,
+	}
+
+	if amount != nil {
+		switch *spouse {
+		case "a", "A":
+		case "b", "B":
+			opts.spouseB = true
+		default:
+			return nil, errors.Wrapf(errInvalidArgs, "unknown spouse %q", *spouse)
+		}
+		opts.amount = *amount
+	}
+
+	if *rules != "" {
+		opts.registry = history.DefaultRegistry().Clone()
+		err = opts.registry.LoadRulesFile(*rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opts.household, err = readHouseholdFrom(*in, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.spouseB && !opts.household.IsCouple() {
+		return nil, errors.Wrap(errInvalidArgs, "spouse b is given for a single-person household")
+	}
+
+	return opts, nil
+}
+
+// readHouseholdFrom reads the household from the file at the given path, or
+// from stdin if path is "-"
+func readHouseholdFrom(path string, stdin io.Reader) (*household, error) {
+
+	if path == "-" {
+		return readHousehold(stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening household file")
+	}
+	defer file.Close()
+
+	return readHousehold(file)
+}
+
+// printUsage writes the usage of this command to w
+func printUsage(w io.Writer) {
+	fmt.Fprint(w, `Usage: quantax <command> [flags]
+
+Commands:
+  tax            the payable tax of each spouse
+  benefits       the child benefits receivable by the household
+  rrsp-refund    the tax refund of an RRSP contribution
+  rrsp-withdraw  the tax paid on an RRSP withdrawal
+
+Run 'quantax <command> -h' for the flags of a command.
+
+Financial sources:
+`)
+	for _, name := range sourceNames() {
+		fmt.Fprintf(w, "  %s\n", name)
+	}
+}
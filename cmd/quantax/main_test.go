@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/factory"
+)
+
+const testHousehold = `{
+	"year": 2019,
+	"regions": ["Canada", "British Columbia"],
+	"spouse_a": {"earned": 85000, "capital-gain-ca": 2000},
+	"spouse_b": {"earned": 30000, "rrsp-contribution": 1000},
+	"dependents": [{"name": "A", "age_months": 30}]
+}`
+
+func testFinances() core.HouseholdFinanceMutator {
+	return factory.NewFinanceFactory().NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned:        85000,
+			core.IncSrcCapitalGainCA: 2000,
+		},
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned: 30000,
+			core.DeducSrcRRSP: 1000,
+		},
+	)
+}
+
+func runJSON(t *testing.T, report interface{}, args ...string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	code := run(append(args, "-json"), strings.NewReader(testHousehold), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("unexpected exit code %d: %s", code, stderr.String())
+	}
+
+	err := json.Unmarshal(stdout.Bytes(), report)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun_Tax(t *testing.T) {
+
+	report := &taxReport{}
+	runJSON(t, report, "tax")
+
+	calculator, err := factory.NewTaxFactory(2019, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances(), nil)
+	taxA, taxB, _ := calculator.TaxPayable()
+
+	if report.SpouseA == nil || report.SpouseB == nil {
+		t.Fatal("expected a report for each spouse")
+	}
+	if math.Abs(report.SpouseA.Total-taxA) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxA, report.SpouseA.Total)
+	}
+	if math.Abs(report.SpouseB.Total-taxB) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxB, report.SpouseB.Total)
+	}
+	if math.Abs(report.Total-(taxA+taxB)) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxA+taxB, report.Total)
+	}
+	if len(report.SpouseA.Regions) != 2 {
+		t.Errorf("expected a breakdown for each region, got %d", len(report.SpouseA.Regions))
+	}
+}
+
+func TestRun_Benefits(t *testing.T) {
+
+	report := &benefitsReport{}
+	runJSON(t, report, "benefits")
+
+	calculator, err := factory.NewChildBenefitFactory(2019, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances())
+	calculator.SetBeneficiaries([]*human.Person{{Name: "A", AgeMonths: 30}})
+	expected := calculator.BenefitRecievable()
+
+	if len(report.Benefits) != 2 {
+		t.Errorf("expected benefits for each region, got %d", len(report.Benefits))
+	}
+	if math.Abs(report.Total-expected) > 1e-6 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", expected, report.Total)
+	}
+}
+
+func TestRun_RRSP(t *testing.T) {
+
+	config := factory.RRSPFactoryConfig{
+		Year:       2019,
+		RRSPRegion: core.RegionCA,
+		TaxRegions: []core.Region{core.RegionCA, core.RegionBC},
+	}
+	calculator, err := factory.NewRRSPFactory(config).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances(), nil)
+	calculator.SetTargetSpouseB()
+
+	refund := &rrspReport{}
+	runJSON(t, refund, "rrsp-refund", "-amount", "2000", "-spouse", "b")
+
+	expected, _ := calculator.TaxRefund(2000)
+	if refund.TaxRefund == nil || math.Abs(*refund.TaxRefund-expected) > 1e-6 {
+		t.Errorf("unexpected refund\nwant: %.2f\n got: %v", expected, refund.TaxRefund)
+	}
+	if refund.TaxPaid != nil {
+		t.Errorf("expected no tax paid for a contribution, got %.2f", *refund.TaxPaid)
+	}
+	if refund.ContributionEarned != calculator.ContributionEarned() {
+		t.Errorf(
+			"unexpected contribution room\nwant: %.2f\n got: %.2f",
+			calculator.ContributionEarned(), refund.ContributionEarned,
+		)
+	}
+
+	withdrawal := &rrspReport{}
+	runJSON(t, withdrawal, "rrsp-withdraw", "-amount", "2000", "-spouse", "b")
+
+	expected, _ = calculator.TaxPaid(2000)
+	if withdrawal.TaxPaid == nil || math.Abs(*withdrawal.TaxPaid-expected) > 1e-6 {
+		t.Errorf("unexpected tax paid\nwant: %.2f\n got: %v", expected, withdrawal.TaxPaid)
+	}
+}
+
+func TestRun_Text(t *testing.T) {
+
+	for _, name := range []string{"tax", "benefits", "rrsp-refund", "rrsp-withdraw"} {
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{name}, strings.NewReader(testHousehold), &stdout, &stderr)
+		if code != 0 {
+			t.Fatalf("%s: unexpected exit code %d: %s", name, code, stderr.String())
+		}
+		if stdout.Len() == 0 {
+			t.Errorf("%s: expected a report", name)
+		}
+	}
+}
+
+func TestRun_Files(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "quantax")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rules := `
+version: 1
+tax:
+  - region: Canada
+    year: 2099
+    brackets: [{rate: 0.10, lower: 0}]
+`
+	rulesPath := filepath.Join(dir, "rules.yaml")
+	err = ioutil.WriteFile(rulesPath, []byte(rules), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	householdPath := filepath.Join(dir, "household.json")
+	household := `{"year": 2099, "regions": ["Canada"], "spouse_a": {"earned": 10000}}`
+	err = ioutil.WriteFile(householdPath, []byte(household), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"tax", "-json", "-in", householdPath, "-rules", rulesPath}, nil, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("unexpected exit code %d: %s", code, stderr.String())
+	}
+
+	report := &taxReport{}
+	err = json.Unmarshal(stdout.Bytes(), report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(report.Total-1000) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", 1000.0, report.Total)
+	}
+
+	stdout.Reset()
+	code = run([]string{"tax", "-in", householdPath}, nil, &stdout, &stderr)
+	if code != 1 {
+		t.Errorf("expected rules not to leak into the default registry, got exit code %d", code)
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+
+	cases := []struct {
+		name      string
+		args      []string
+		household string
+		code      int
+	}{
+		{
+			name: "no-command",
+			args: nil,
+			code: 2,
+		},
+		{
+			name: "unknown-command",
+			args: []string{"magic"},
+			code: 2,
+		},
+		{
+			name: "unknown-flag",
+			args: []string{"tax", "-amount", "1"},
+			code: 2,
+		},
+		{
+			name: "extra-arguments",
+			args: []string{"tax", "extra"},
+			code: 2,
+		},
+		{
+			name: "unknown-spouse",
+			args: []string{"rrsp-refund", "-spouse", "c"},
+			code: 2,
+		},
+		{
+			name:      "spouse-b-of-single",
+			args:      []string{"rrsp-refund", "-spouse", "b"},
+			household: `{"year": 2019, "regions": ["Canada"], "spouse_a": {}}`,
+			code:      2,
+		},
+		{
+			name:      "invalid-household",
+			args:      []string{"tax"},
+			household: `{"year": 2019}`,
+			code:      2,
+		},
+		{
+			name: "missing-household-file",
+			args: []string{"tax", "-in", filepath.Join("does", "not", "exist.json")},
+			code: 2,
+		},
+		{
+			name: "missing-rules-file",
+			args: []string{"tax", "-rules", filepath.Join("does", "not", "exist.json")},
+			code: 2,
+		},
+		{
+			name:      "unknown-year",
+			args:      []string{"tax"},
+			household: `{"year": 1000, "regions": ["Canada"], "spouse_a": {}}`,
+			code:      1,
+		},
+		{
+			name:      "no-benefit-regions",
+			args:      []string{"benefits"},
+			household: `{"year": 2019, "regions": ["Alberta"], "spouse_a": {}}`,
+			code:      1,
+		},
+		{
+			name: "negative-amount",
+			args: []string{"rrsp-withdraw", "-amount", "-1"},
+			code: 1,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			household := c.household
+			if household == "" {
+				household = testHousehold
+			}
+
+			var stdout, stderr bytes.Buffer
+			code := run(c.args, strings.NewReader(household), &stdout, &stderr)
+			if code != c.code {
+				t.Errorf("unexpected exit code\nwant: %d\n got: %d", c.code, code)
+			}
+			if stderr.Len() == 0 {
+				t.Error("expected an error message")
+			}
+		})
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"help"}, nil, &stdout, &stderr)
+	if code != 0 {
+		t.Errorf("unexpected exit code\nwant: %d\n got: %d", 0, code)
+	}
+	if !strings.Contains(stdout.String(), "earned") {
+		t.Error("expected usage to list the financial sources")
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/malkhamis/quantax/core"
+)
+
+// report is the result of a command that can be written as text or as JSON
+type report interface {
+	writeText(w io.Writer)
+}
+
+// taxReport is the report of the tax command
+type taxReport struct {
+	Year    uint           `json:"year"`
+	Regions []core.Region  `json:"regions"`
+	SpouseA *spouseTax     `json:"spouse_a"`
+	SpouseB *spouseTax     `json:"spouse_b,omitempty"`
+	Credits []creditReport `json:"credits,omitempty"`
+	Total   float64        `json:"total"`
+}
+
+// spouseTax is the payable tax of a single spouse
+type spouseTax struct {
+	Regions []regionTax `json:"regions"`
+	Total   float64     `json:"total"`
+}
+
+// regionTax is the payable tax of a single spouse in a single region
+type regionTax struct {
+	Region         core.Region  `json:"region"`
+	NetIncome      float64      `json:"net_income"`
+	GrossTax       float64      `json:"gross_tax"`
+	CreditsApplied float64      `json:"credits_applied"`
+	Adjustments    []adjustment `json:"adjustments,omitempty"`
+	NetPayable     float64      `json:"net_payable"`
+}
+
+// adjustment is a post-credit adjustment of the payable tax
+type adjustment struct {
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// creditReport is the amounts of a tax credit after calculating the tax
+type creditReport struct {
+	Region      core.Region `json:"region"`
+	Description string      `json:"description"`
+	Initial     float64     `json:"initial"`
+	Used        float64     `json:"used"`
+	Remaining   float64     `json:"remaining"`
+}
+
+// benefitsReport is the report of the benefits command
+type benefitsReport struct {
+	Year     uint           `json:"year"`
+	Benefits []regionAmount `json:"benefits"`
+	Total    float64        `json:"total"`
+}
+
+// regionAmount is an amount for a single region
+type regionAmount struct {
+	Region core.Region `json:"region"`
+	Amount float64     `json:"amount"`
+}
+
+// rrspReport is the report of the rrsp-refund and rrsp-withdraw commands
+type rrspReport struct {
+	Year               uint          `json:"year"`
+	Regions            []core.Region `json:"regions"`
+	Spouse             string        `json:"spouse"`
+	Action             string        `json:"action"`
+	Amount             float64       `json:"amount"`
+	TaxRefund          *float64      `json:"tax_refund,omitempty"`
+	TaxPaid            *float64      `json:"tax_paid,omitempty"`
+	ContributionEarned float64       `json:"contribution_earned"`
+}
+
+// writeReport writes the given report to w as indented JSON if asJSON is
+// true, or as human-readable text otherwise
+func writeReport(w io.Writer, r report, asJSON bool) error {
+
+	if asJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	r.writeText(tw)
+	return tw.Flush()
+}
+
+func (r *taxReport) writeText(w io.Writer) {
+
+	fmt.Fprintf(w, "Tax year %d\n", r.Year)
+	writeSpouseTax(w, "Spouse A", r.SpouseA)
+	if r.SpouseB != nil {
+		writeSpouseTax(w, "Spouse B", r.SpouseB)
+	}
+
+	if len(r.Credits) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Tax credits\t%12s\t%12s\t%12s\n", "initial", "used", "remaining")
+		for _, cr := range r.Credits {
+			fmt.Fprintf(
+				w, "  %s (%s)\t%12.2f\t%12.2f\t%12.2f\n",
+				cr.Description, cr.Region, cr.Initial, cr.Used, cr.Remaining,
+			)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Total payable\t%12.2f\n", r.Total)
+}
+
+// writeSpouseTax writes the payable tax of a single spouse as text
+func writeSpouseTax(w io.Writer, title string, s *spouseTax) {
+
+	for _, rt := range s.Regions {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s: %s\n", title, rt.Region)
+		fmt.Fprintf(w, "  net income\t%12.2f\n", rt.NetIncome)
+		fmt.Fprintf(w, "  gross tax\t%12.2f\n", rt.GrossTax)
+		fmt.Fprintf(w, "  credits applied\t%12.2f\n", -rt.CreditsApplied)
+		for _, adj := range rt.Adjustments {
+			fmt.Fprintf(w, "  %s\t%12.2f\n", adj.Description, adj.Amount)
+		}
+		fmt.Fprintf(w, "  net payable\t%12.2f\n", rt.NetPayable)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s payable\t%12.2f\n", title, s.Total)
+}
+
+func (r *benefitsReport) writeText(w io.Writer) {
+
+	fmt.Fprintf(w, "Child benefits %d\n", r.Year)
+	for _, b := range r.Benefits {
+		fmt.Fprintf(w, "  %s\t%12.2f\n", b.Region, b.Amount)
+	}
+	fmt.Fprintf(w, "Total receivable\t%12.2f\n", r.Total)
+}
+
+func (r *rrspReport) writeText(w io.Writer) {
+
+	fmt.Fprintf(w, "RRSP %s %d\n", r.Action, r.Year)
+	fmt.Fprintf(w, "  spouse\t%12s\n", r.Spouse)
+	fmt.Fprintf(w, "  amount\t%12.2f\n", r.Amount)
+	if r.TaxRefund != nil {
+		fmt.Fprintf(w, "  tax refund\t%12.2f\n", *r.TaxRefund)
+	}
+	if r.TaxPaid != nil {
+		fmt.Fprintf(w, "  tax paid\t%12.2f\n", *r.TaxPaid)
+	}
+	fmt.Fprintf(w, "  contribution room earned\t%12.2f\n", r.ContributionEarned)
+}
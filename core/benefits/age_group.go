@@ -69,6 +69,55 @@ func (ma multiAgeGroupBenefits) MinAnnualAmount(child *human.Person) float64 {
 
 }
 
+// MonthlyAmounts returns the min/max monthly amounts for the given child at its
+// current age
+func (ma multiAgeGroupBenefits) MonthlyAmounts(child *human.Person) (min, max float64) {
+
+	for _, ageGroup := range ma {
+		if ageGroup.IsInAgeGroup(child) {
+			min += ageGroup.AmountsPerMonth.Lower()
+			max += ageGroup.AmountsPerMonth.Upper()
+		}
+	}
+	return min, max
+}
+
+// IsEligible returns true if the given child belongs to any of the age groups
+// at its current age
+func (ma multiAgeGroupBenefits) IsEligible(child *human.Person) bool {
+
+	for _, ageGroup := range ma {
+		if ageGroup.IsInAgeGroup(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// EligibleMonths returns the number of months in the year, starting at the
+// child's current age, in which the given child belongs to any of the age
+// groups
+func (ma multiAgeGroupBenefits) EligibleMonths(child *human.Person) int {
+
+	if child == nil {
+		return 0
+	}
+
+	var (
+		childClone = *child
+		months     int
+	)
+
+	for range make([]struct{}, 12) {
+		if ma.IsEligible(&childClone) {
+			months++
+		}
+		childClone.AgeMonths++
+	}
+
+	return months
+}
+
 // NewAgeGroupBenefits returns a new age group benefit instance. The age range
 // is expected to be in months (not years). If the given arguments are invalid,
 // an error is returned
@@ -3,34 +3,153 @@
 package benefits
 
 import (
+	"time"
+
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
 
 	"github.com/pkg/errors"
 )
 
 // Sentinel errors that can be wrapped and returned by this package
 var (
-	ErrNoFormula = errors.New("no formula given/set")
-	ErrNoIncCalc = errors.New("no income calculator given")
-	ErrNoCalc    = errors.New("no benefit calculator given")
+	ErrNoFormula      = errors.New("no formula given/set")
+	ErrNoIncCalc      = errors.New("no income calculator given")
+	ErrNoCalc         = errors.New("no benefit calculator given")
+	ErrInvalidFormula = errors.New("invalid formula")
 )
 
 // ChildBenefitFormula represents a method for calculating child benefits
 type ChildBenefitFormula interface {
 	// Apply returns the sum of benefits for all beneficiaries
 	Apply(netIncome float64, children ...*human.Person) float64
+	// ApplyMonth returns the sum of benefits for all beneficiaries in the
+	// given month of the benefit year, starting at zero, where the children
+	// are of their ages in that month
+	ApplyMonth(netIncome float64, month uint, children ...*human.Person) float64
 	// Validate checks if the formula is valid for use
 	Validate() error
 	// Clone returns a copy of the formula
 	Clone() ChildBenefitFormula
 }
 
+// SingleParentFormula is implemented by child benefit formulas which provide
+// additional benefits for single-parent families
+type SingleParentFormula interface {
+	ChildBenefitFormula
+	// ApplySingleParent is like Apply, except that the benefits are for a
+	// single-parent family
+	ApplySingleParent(netIncome float64, children ...*human.Person) float64
+	// ApplySingleParentMonth is like ApplyMonth, except that the benefits are
+	// for a single-parent family
+	ApplySingleParentMonth(netIncome float64, month uint, children ...*human.Person) float64
+}
+
+// LumpSumFormula is implemented by child benefit formulas whose benefits are
+// paid in a single payment at the start of the benefit year if the annual
+// benefits are small, e.g. the Canada child benefit
+type LumpSumFormula interface {
+	ChildBenefitFormula
+	// LumpSumThreshold returns the annual benefits below which the benefits
+	// are paid in a single payment
+	LumpSumThreshold() float64
+}
+
+// Household represents the members of a household that a benefit is computed
+// for. The finances of spouse B are nil if the household has no spouse
+type Household struct {
+	Finances   core.HouseholdFinances
+	NetIncomeA float64
+	NetIncomeB float64
+	Dependents []*human.Person
+}
+
+// HasSpouse returns true if the household has a spouse
+func (h *Household) HasSpouse() bool {
+	return h.Finances != nil && h.Finances.SpouseB() != nil
+}
+
+// HouseholdBenefitFormula represents a method for calculating benefits for
+// households
+type HouseholdBenefitFormula interface {
+	// Apply returns the benefits for the given household
+	Apply(*Household) float64
+	// Validate checks if the formula is valid for use
+	Validate() error
+	// Clone returns a copy of the formula
+	Clone() HouseholdBenefitFormula
+}
+
+// InstalmentFormula is implemented by household benefit formulas whose annual
+// benefits are not paid in equal monthly instalments, e.g. the GST/HST credit
+// is paid quarterly
+type InstalmentFormula interface {
+	HouseholdBenefitFormula
+	// Schedule returns the payments of the given annual benefits in the
+	// benefit year
+	Schedule(annual float64) core.PaymentSchedule
+}
+
+// PensionFormula represents a method for calculating a public pension and the
+// recovery tax on the pension
+type PensionFormula interface {
+	// Apply returns the annual pension of the given pensioner
+	Apply(pensioner *human.Pensioner) float64
+	// RecoveryAdjuster returns the tax adjuster that recovers the pension
+	// from pensioners with high net income
+	RecoveryAdjuster() tax.RecoveryTaxAdjuster
+	// Validate checks if the formula is valid for use
+	Validate() error
+	// Clone returns a copy of the formula
+	Clone() PensionFormula
+}
+
+// SupplementFormula represents a method for calculating income-tested
+// supplements of low-income seniors and their spouses
+type SupplementFormula interface {
+	// Apply returns the annual supplement of the given pensioner for the
+	// given income. The spouse is nil for pensioners without a spouse, and
+	// the income is the combined income of the pensioner and their spouse
+	Apply(income float64, pensioner, spouse *human.Pensioner) float64
+	// Validate checks if the formula is valid for use
+	Validate() error
+	// Clone returns a copy of the formula
+	Clone() SupplementFormula
+}
+
+// Earner represents a member of a household who may recieve in-work benefits
+type Earner struct {
+	// WorkingIncome is the employment and self-employment income
+	WorkingIncome float64
+	// NetIncome is the adjusted net income
+	NetIncome float64
+	// Disabled is true if the earner is eligible for the disability tax
+	// credit
+	Disabled bool
+}
+
+// WorkersBenefitFormula represents a method for calculating in-work benefits
+// of low-income workers
+type WorkersBenefitFormula interface {
+	// Apply returns the annual benefit of each spouse, where spouse B is nil
+	// for earners without a spouse
+	Apply(spouseA, spouseB *Earner) (float64, float64)
+	// Validate checks if the formula is valid for use
+	Validate() error
+	// Clone returns a copy of the formula
+	Clone() WorkersBenefitFormula
+}
+
 // CalcConfigCB is used to pass configurations to create new child benefit
 // calculator
 type CalcConfigCB struct {
 	Formula    ChildBenefitFormula
 	IncomeCalc core.IncomeCalculator
+	// ReferenceDate is the first day of the benefit year. If set, the ages of
+	// children with birth dates are their ages at this date, or at the first
+	// day of the month for monthly calculations
+	ReferenceDate time.Time
 }
 
 // validate checks if the configurations are valid for use by calc constructors
@@ -52,6 +171,121 @@ func (cfg CalcConfigCB) validate() error {
 	return nil
 }
 
+// CalcConfigHB is used to pass configurations to create new household benefit
+// calculator
+type CalcConfigHB struct {
+	Formula    HouseholdBenefitFormula
+	IncomeCalc core.IncomeCalculator
+	// ReferenceDate is the date at which the ages of dependents with birth
+	// dates are computed if set
+	ReferenceDate time.Time
+}
+
+// validate checks if the configurations are valid for use by calc constructors
+func (cfg CalcConfigHB) validate() error {
+
+	if cfg.Formula == nil {
+		return ErrNoFormula
+	}
+
+	err := cfg.Formula.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula")
+	}
+
+	if cfg.IncomeCalc == nil {
+		return ErrNoIncCalc
+	}
+
+	return nil
+}
+
+// CalcConfigOAS is used to pass configurations to create new OAS calculator
+type CalcConfigOAS struct {
+	Formula    PensionFormula
+	IncomeCalc core.IncomeCalculator
+	// ReferenceDate is the first day of the year. If set, the ages of
+	// pensioners with birth dates are their ages at this date
+	ReferenceDate time.Time
+}
+
+// validate checks if the configurations are valid for use by calc constructors
+func (cfg CalcConfigOAS) validate() error {
+
+	if cfg.Formula == nil {
+		return ErrNoFormula
+	}
+
+	err := cfg.Formula.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula")
+	}
+
+	if cfg.IncomeCalc == nil {
+		return ErrNoIncCalc
+	}
+
+	return nil
+}
+
+// CalcConfigGIS is used to pass configurations to create new GIS calculator
+type CalcConfigGIS struct {
+	Formula    SupplementFormula
+	IncomeCalc core.IncomeCalculator
+	// ReferenceDate is the first day of the year. If set, the ages of
+	// pensioners with birth dates are their ages at this date
+	ReferenceDate time.Time
+}
+
+// validate checks if the configurations are valid for use by calc constructors
+func (cfg CalcConfigGIS) validate() error {
+
+	if cfg.Formula == nil {
+		return ErrNoFormula
+	}
+
+	err := cfg.Formula.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula")
+	}
+
+	if cfg.IncomeCalc == nil {
+		return ErrNoIncCalc
+	}
+
+	return nil
+}
+
+// CalcConfigCWB is used to pass configurations to create new CWB calculator
+type CalcConfigCWB struct {
+	Formula WorkersBenefitFormula
+	// IncomeCalc computes the adjusted net income that the benefit is
+	// reduced by
+	IncomeCalc core.IncomeCalculator
+	// WorkingIncomeCalc computes the working income, as the total income,
+	// that the benefit is phased in with
+	WorkingIncomeCalc core.IncomeCalculator
+}
+
+// validate checks if the configurations are valid for use by calc constructors
+func (cfg CalcConfigCWB) validate() error {
+
+	if cfg.Formula == nil {
+		return ErrNoFormula
+	}
+
+	err := cfg.Formula.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula")
+	}
+
+	if cfg.IncomeCalc == nil || cfg.WorkingIncomeCalc == nil {
+		return ErrNoIncCalc
+	}
+
+	return nil
+}
+
 func getChildCount(children []*human.Person) int {
 	childCount := len(children)
 	for _, c := range children {
@@ -61,3 +295,66 @@ func getChildCount(children []*human.Person) int {
 	}
 	return childCount
 }
+
+// custodyBenefits returns the benefits that apply computes for the given
+// children, where non-resident children and children who live with the
+// household less than the shared-custody minimum are excluded. The household
+// recieves its custody share of the additional benefits for children under
+// shared custody, i.e. the benefits for all eligible children less those for
+// the children under full custody
+func custodyBenefits(children []*human.Person, apply func([]*human.Person) float64) float64 {
+
+	var (
+		fullCustody, eligible []*human.Person
+		excluded              bool
+	)
+
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+
+		share := child.CustodyShare()
+		switch {
+		case child.Residency == human.NonResident || share == 0.0:
+			excluded = true
+		case share < 1.0:
+			excluded = true
+			eligible = append(eligible, child)
+		default:
+			fullCustody = append(fullCustody, child)
+			eligible = append(eligible, child)
+		}
+	}
+
+	if !excluded {
+		return apply(children)
+	}
+
+	benefits := apply(fullCustody)
+	if len(eligible) == len(fullCustody) {
+		return benefits
+	}
+	return benefits + human.SharedCustodyShare*(apply(eligible)-benefits)
+}
+
+// peopleAtDate returns copies of the given people of their ages at the given
+// date. If the date is zero, the people are returned as is
+func peopleAtDate(people []*human.Person, date time.Time) []*human.Person {
+
+	if date.IsZero() {
+		return people
+	}
+	return human.PeopleAtDate(people, date)
+}
+
+// pensionerAtDate returns a copy of the given pensioner of their age at the
+// given date. If the date is zero or the pensioner is nil, the pensioner is
+// returned as is
+func pensionerAtDate(pensioner *human.Pensioner, date time.Time) *human.Pensioner {
+
+	if date.IsZero() || pensioner == nil {
+		return pensioner
+	}
+	return pensioner.AtDate(date)
+}
@@ -49,6 +49,31 @@ func (agg *ChildBenfitAggregator) BenefitRecievable() float64 {
 	return total
 }
 
+// MonthlyBenefitRecievable returns the aggregate recievable amount of child
+// benefits in the given month of the benefit year
+func (agg *ChildBenfitAggregator) MonthlyBenefitRecievable(month uint) float64 {
+
+	var total float64
+	for _, c := range agg.calculators {
+		agg.setupChildBenefitCalculator(c)
+		total += c.MonthlyBenefitRecievable(month)
+	}
+	return total
+}
+
+// PaymentSchedule returns the aggregate payments of child benefits in each month
+// of the benefit year, where each calculator pays small benefits according to
+// its own formula
+func (agg *ChildBenfitAggregator) PaymentSchedule() core.PaymentSchedule {
+
+	var schedule core.PaymentSchedule
+	for _, c := range agg.calculators {
+		agg.setupChildBenefitCalculator(c)
+		schedule = schedule.Add(c.PaymentSchedule())
+	}
+	return schedule
+}
+
 // SetBeneficiaries sets the children which the calculator will compute the
 // benefits for in subsequent calls to BenefitRecievable()
 func (agg *ChildBenfitAggregator) SetBeneficiaries(children []*human.Person) {
@@ -30,17 +30,17 @@ func TestCalculatorAgg_Calc(t *testing.T) {
 	incCalc := testIncomeCalculator{onTotalIncome: 3000.0}
 	formula := testCBFormula{onApply: incCalc.TotalIncome() / 2.0}
 
-	c0, err := NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	c0, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	c1, err := NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	c1, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	c2, err := NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	c2, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -59,6 +59,59 @@ func TestCalculatorAgg_Calc(t *testing.T) {
 
 }
 
+func TestCalculatorAgg_MonthlyBenefitRecievable(t *testing.T) {
+
+	incCalc := testIncomeCalculator{}
+	c0, err := NewChildBenefitCalculator(CalcConfigCB{Formula: testCBFormula{onApply: 1200.0}, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1, err := NewChildBenefitCalculator(CalcConfigCB{Formula: testCBFormula{onApply: 2400.0}, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aggregator, err := NewChildBenefitAggregator(c0, c1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aggregator.SetFinances(core.NewHouseholdFinancesNop())
+	actual := aggregator.MonthlyBenefitRecievable(5)
+	expected := 100.0 + 200.0
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
+
+func TestCalculatorAgg_PaymentSchedule(t *testing.T) {
+
+	c0 := &testChildBenefitCalculator{onMonthlyBenefitRecievable: 100}
+	c1, err := NewChildBenefitCalculator(CalcConfigCB{Formula: testCBFormula{onApply: 2400.0}, IncomeCalc: testIncomeCalculator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aggregator, err := NewChildBenefitAggregator(c0, c1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finances := core.NewHouseholdFinancesNop()
+	aggregator.SetFinances(finances)
+
+	actual := aggregator.PaymentSchedule()
+	expected := core.PaymentSchedule{300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+
+	if c0.finances != finances {
+		t.Error("expected the aggregator to set up its calculators before computing schedules")
+	}
+}
+
 // func TestAggregator_SetBeneficiaries(t *testing.T) {
 //
 // 	c0, c1, c2 := &ChildBenfitCalculator{}, &ChildBenfitCalculator{}, &ChildBenfitCalculator{}
@@ -1,6 +1,8 @@
 package benefits
 
 import (
+	"time"
+
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
 
@@ -15,6 +17,7 @@ type ChildBenfitCalculator struct {
 	incomeCalculator core.IncomeCalculator
 	children         []*human.Person
 	finances         core.HouseholdFinances
+	referenceDate    time.Time
 }
 
 // compile-time check for interface implementation
@@ -32,16 +35,49 @@ func NewChildBenefitCalculator(cfg CalcConfigCB) (*ChildBenfitCalculator, error)
 	c := &ChildBenfitCalculator{
 		formula:          cfg.Formula.Clone(),
 		incomeCalculator: cfg.IncomeCalc,
+		referenceDate:    cfg.ReferenceDate,
 	}
 	return c, nil
 }
 
-// BenefitRecievable returns the recievable amount of child benefits
+// BenefitRecievable returns the recievable amount of child benefits. If the
+// finances of spouse B are nil and the formula provides additional benefits
+// for single parents, the benefits are computed for a single-parent family.
+// Non-resident children are not eligible, and the household recieves half of
+// the benefits for children under shared custody
 func (c *ChildBenfitCalculator) BenefitRecievable() float64 {
 
 	netIncome := c.householdNetIncome()
-	benefits := c.formula.Apply(netIncome, c.children...)
-	return benefits
+	children := c.childrenIn(0, c.children)
+
+	return custodyBenefits(children, func(children []*human.Person) float64 {
+		return c.annualBenefits(netIncome, children)
+	})
+}
+
+// MonthlyBenefitRecievable returns the recievable amount of child benefits in
+// the given month of the benefit year, starting at zero, where the set children
+// are of their ages in July and are aged like in PaymentSchedule(). Single-parent
+// families and children who are non-residents or under shared custody are
+// handled like in BenefitRecievable()
+func (c *ChildBenfitCalculator) MonthlyBenefitRecievable(month uint) float64 {
+	return c.monthlyBenefits(c.householdNetIncome(), month, c.childrenInMonth(month))
+}
+
+// PaymentSchedule returns the payments of child benefits in each month of the
+// benefit year, where the set children are of their ages in July and age by a
+// month in each subsequent month. If the formula pays small benefits in a lump
+// sum and the annual benefits are below its threshold, the benefits are paid
+// in July
+func (c *ChildBenfitCalculator) PaymentSchedule() core.PaymentSchedule {
+
+	schedule := c.monthlySchedule()
+
+	lumpSumFormula, ok := c.formula.(LumpSumFormula)
+	if ok {
+		return schedule.LumpSum(lumpSumFormula.LumpSumThreshold())
+	}
+	return schedule
 }
 
 // SetBeneficiaries sets the children which the calculator will compute the
@@ -63,6 +99,64 @@ func (c *ChildBenfitCalculator) SetFinances(finances core.HouseholdFinances) {
 	c.finances = finances
 }
 
+// annualBenefits returns the annual benefits for the given children
+func (c *ChildBenfitCalculator) annualBenefits(netIncome float64, children []*human.Person) float64 {
+
+	if c.finances.SpouseB() == nil {
+		return applySingleParent(c.formula, netIncome, children)
+	}
+
+	benefits := c.formula.Apply(netIncome, children...)
+	return benefits
+}
+
+// monthlySchedule returns the benefits in each month of the benefit year before
+// any lump sum payment
+func (c *ChildBenfitCalculator) monthlySchedule() core.PaymentSchedule {
+
+	netIncome := c.householdNetIncome()
+
+	var schedule core.PaymentSchedule
+	for month := range schedule {
+		schedule[month] = c.monthlyBenefits(netIncome, uint(month), c.childrenInMonth(uint(month)))
+	}
+	return schedule
+}
+
+// monthlyBenefits returns the benefits for the given children in the given month
+// of the benefit year, where the children are of their ages in that month
+func (c *ChildBenfitCalculator) monthlyBenefits(netIncome float64, month uint, children []*human.Person) float64 {
+
+	return custodyBenefits(children, func(children []*human.Person) float64 {
+
+		if c.finances.SpouseB() == nil {
+			return applySingleParentMonth(c.formula, netIncome, month, children)
+		}
+
+		benefits := c.formula.ApplyMonth(netIncome, month, children...)
+		return benefits
+	})
+}
+
+// childrenInMonth returns the set children of their ages in the given month of
+// the benefit year, where children without birth dates age by a month in each
+// month after July
+func (c *ChildBenfitCalculator) childrenInMonth(month uint) []*human.Person {
+	return c.childrenIn(month, agedChildren(c.children, month))
+}
+
+// childrenIn returns the given children of their ages in the given month of the
+// benefit year. Children with birth dates are of their ages at the first day
+// of that month if the reference date is set, whereas the ages of other
+// children are unchanged
+func (c *ChildBenfitCalculator) childrenIn(month uint, children []*human.Person) []*human.Person {
+
+	if c.referenceDate.IsZero() {
+		return children
+	}
+	return peopleAtDate(children, c.referenceDate.AddDate(0, int(month), 0))
+}
+
 // householdNetIncome calculates the net income of the stored household finances
 func (c *ChildBenfitCalculator) householdNetIncome() float64 {
 
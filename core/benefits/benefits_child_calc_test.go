@@ -1,7 +1,10 @@
 package benefits
 
 import (
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
@@ -11,25 +14,25 @@ import (
 func TestCalcCOnfigCB_validate(t *testing.T) {
 
 	formula := testCBFormula{}
-	err := CalcConfigCB{formula, nil}.validate()
+	err := CalcConfigCB{Formula: formula, IncomeCalc: nil}.validate()
 	if errors.Cause(err) != ErrNoIncCalc {
 		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
 	}
 
 	incCalc := testIncomeCalculator{}
-	_, err = NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	_, err = NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	err = CalcConfigCB{nil, nil}.validate()
+	err = CalcConfigCB{Formula: nil, IncomeCalc: nil}.validate()
 	if errors.Cause(err) != ErrNoFormula {
 		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
 	}
 
 	simulatedErr := errors.New("test error")
 	formula = testCBFormula{onValidate: simulatedErr}
-	err = CalcConfigCB{formula, nil}.validate()
+	err = CalcConfigCB{Formula: formula, IncomeCalc: nil}.validate()
 	if errors.Cause(err) != simulatedErr {
 		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
 	}
@@ -38,7 +41,7 @@ func TestCalcCOnfigCB_validate(t *testing.T) {
 
 func TestNewChildBenefitCalculator(t *testing.T) {
 
-	_, err := NewChildBenefitCalculator(CalcConfigCB{nil, nil})
+	_, err := NewChildBenefitCalculator(CalcConfigCB{Formula: nil, IncomeCalc: nil})
 	if errors.Cause(err) != ErrNoFormula {
 		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
 	}
@@ -50,7 +53,7 @@ func TestCalculator_Calc_NilFinances(t *testing.T) {
 	incCalc := testIncomeCalculator{}
 	formula := testCBFormula{}
 
-	calculator, err := NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -69,7 +72,7 @@ func TestCalculator_Calc(t *testing.T) {
 	incCalc := testIncomeCalculator{onTotalIncome: 3000.0}
 	formula := testCBFormula{onApply: incCalc.TotalIncome() / 2.0}
 
-	calculator, err := NewChildBenefitCalculator(CalcConfigCB{formula, incCalc})
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -83,6 +86,223 @@ func TestCalculator_Calc(t *testing.T) {
 
 }
 
+func TestCalculator_Calc_SingleParent(t *testing.T) {
+
+	formula := testSingleParentFormula{
+		testCBFormula:       testCBFormula{onApply: 1000.0},
+		onApplySingleParent: 1500.0,
+	}
+
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: testIncomeCalculator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+	actual := calculator.BenefitRecievable()
+	if actual != 1500.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 1500.0, actual)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}, onSpouseB: &testFinancer{}})
+	actual = calculator.BenefitRecievable()
+	if math.Abs(actual-1000.0) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 1000.0, actual)
+	}
+}
+
+func TestCalculator_MonthlyBenefitRecievable(t *testing.T) {
+
+	formula := testSingleParentFormula{
+		testCBFormula:       testCBFormula{onApply: 1200.0},
+		onApplySingleParent: 1800.0,
+	}
+
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: testIncomeCalculator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+	actual := calculator.MonthlyBenefitRecievable(0)
+	if actual != 150.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 150.0, actual)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}, onSpouseB: &testFinancer{}})
+	actual = calculator.MonthlyBenefitRecievable(0)
+	if actual != 100.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 100.0, actual)
+	}
+}
+
+func TestCalculator_PaymentSchedule(t *testing.T) {
+
+	formula := &CCBMaxReducer{
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 11},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+			{
+				AgesMonths:      human.AgeRange{12, 23},
+				AmountsPerMonth: core.Bracket{0, 250},
+			},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.125: core.Bracket{10000, math.Inf(1)}},
+		},
+		SmallPaymentThreshold: 240,
+	}
+
+	cases := []struct {
+		name      string
+		netIncome float64
+		ageMonths uint
+		expected  core.PaymentSchedule
+	}{
+		{
+			name:      "new-age-group",
+			netIncome: 10000,
+			ageMonths: 9,
+			expected:  core.PaymentSchedule{500, 500, 500, 250, 250, 250, 250, 250, 250, 250, 250, 250},
+		},
+		{
+			name:      "ineligible",
+			netIncome: 10000,
+			ageMonths: 18,
+			expected:  core.PaymentSchedule{250, 250, 250, 250, 250, 250, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:      "lump-sum",
+			netIncome: 10000 + 47040,
+			ageMonths: 0,
+			expected:  core.PaymentSchedule{12 * (500 - 0.125*47040/12)},
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			// the test income calculator returns the same net income for
+			// both spouses, including the missing one
+			incCalc := testIncomeCalculator{onNetIncome: c.netIncome / 2.0}
+			calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
+			if err != nil {
+				t.Fatal(err)
+			}
+			calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+			calculator.SetBeneficiaries([]*human.Person{{AgeMonths: c.ageMonths}})
+
+			actual := calculator.PaymentSchedule()
+			if actual != c.expected {
+				t.Errorf("unexpected results\nwant: %v\n got: %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCalculator_BirthDates(t *testing.T) {
+
+	formula := &CCBMaxReducer{
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 6*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+			{
+				AgesMonths:      human.AgeRange{6 * 12, 18*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 250},
+			},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.10: core.Bracket{10000, math.Inf(1)}},
+		},
+	}
+
+	cfg := CalcConfigCB{
+		Formula:       formula,
+		IncomeCalc:    testIncomeCalculator{},
+		ReferenceDate: human.BenefitYearStart(2024),
+	}
+	calculator, err := NewChildBenefitCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(core.NewHouseholdFinancesNop())
+
+	// turns 6 on October 15 and is 6 from November 1, which is the 5th month
+	// of the benefit year. The age in months is ignored
+	birthDate := time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC)
+	calculator.SetBeneficiaries([]*human.Person{{AgeMonths: 1, BirthDate: birthDate}})
+
+	actual := calculator.BenefitRecievable()
+	expected := 4*500.0 + 8*250.0
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = calculator.MonthlyBenefitRecievable(4)
+	if actual != 250.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 250.0, actual)
+	}
+
+	schedule := calculator.PaymentSchedule()
+	expectedSchedule := core.PaymentSchedule{500, 500, 500, 500, 250, 250, 250, 250, 250, 250, 250, 250}
+	if schedule != expectedSchedule {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expectedSchedule, schedule)
+	}
+}
+
+func TestCalculator_SharedCustody(t *testing.T) {
+
+	formula := &CCBMaxReducer{
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 18*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.10: core.Bracket{10000, math.Inf(1)}}, // 1 child
+			{0.20: core.Bracket{10000, math.Inf(1)}}, // 2+ children
+		},
+	}
+
+	incCalc := testIncomeCalculator{onNetIncome: 15000}
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+	calculator.SetBeneficiaries([]*human.Person{
+		{AgeMonths: 12},
+		{AgeMonths: 12, CustodyPercent: 50},
+		{AgeMonths: 12, Residency: human.NonResident},
+	})
+
+	// full custody: 6000 - 2000, all eligible children: 12000 - 4000
+	actual := calculator.BenefitRecievable()
+	expected := 4000.0 + 0.5*(8000.0-4000.0)
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = calculator.MonthlyBenefitRecievable(3)
+	if math.Abs(actual-expected/12.0) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected/12.0, actual)
+	}
+
+	total := calculator.PaymentSchedule().Total()
+	if math.Abs(total-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, total)
+	}
+}
+
 func TestCalculator_SetBeneficiaries(t *testing.T) {
 
 	c := &ChildBenfitCalculator{}
@@ -107,3 +327,92 @@ func TestCalculator_SetBeneficiaries(t *testing.T) {
 		t.Errorf("expected no children, got: %d", len(c.children))
 	}
 }
+
+func TestCalculator_PaymentSchedule_AgingOut(t *testing.T) {
+
+	formula := &CCBMaxReducer{
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 18*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.10: core.Bracket{10000, math.Inf(1)}}, // 1 child
+			{0.20: core.Bracket{10000, math.Inf(1)}}, // 2+ children
+		},
+	}
+
+	incCalc := testIncomeCalculator{onNetIncome: 20000} // for each spouse
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+	// ages out in January
+	calculator.SetBeneficiaries([]*human.Person{{AgeMonths: 18*12 - 6}})
+
+	schedule := calculator.PaymentSchedule()
+	for month := range schedule {
+		actual := calculator.MonthlyBenefitRecievable(uint(month))
+		if actual != schedule[month] {
+			t.Errorf("month %d: unexpected results\nwant: %.2f\n got: %.2f", month, schedule[month], actual)
+		}
+	}
+
+	// the reduction of 3000 is spread over the months the child is eligible
+	expected := 6 * (500.0 - 3000.0/12.0)
+	if math.Abs(schedule.Total()-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, schedule.Total())
+	}
+
+	// the annual benefits are those of the formula
+	expected = formula.Apply(40000, &human.Person{AgeMonths: 18*12 - 6})
+	actual := calculator.BenefitRecievable()
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
+
+func TestCalculator_PaymentSchedule_SiblingAgingOut(t *testing.T) {
+
+	formula := &CCBMaxReducer{
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 18*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.10: core.Bracket{10000, math.Inf(1)}}, // 1 child
+			{0.20: core.Bracket{10000, math.Inf(1)}}, // 2+ children
+		},
+	}
+
+	incCalc := testIncomeCalculator{onNetIncome: 20000} // for each spouse
+	calculator, err := NewChildBenefitCalculator(CalcConfigCB{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+	// the older child ages out in January, after which the one-child
+	// reduction applies to the younger child
+	calculator.SetBeneficiaries([]*human.Person{{AgeMonths: 18*12 - 6}, {AgeMonths: 3 * 12}})
+
+	schedule := calculator.PaymentSchedule()
+	for month := range schedule {
+		expected := 2*500.0 - 6000.0/12.0
+		if month >= 6 {
+			expected = 500.0 - 3000.0/12.0
+		}
+		if math.Abs(schedule[month]-expected) > 1e-9 {
+			t.Errorf("month %d: unexpected results\nwant: %.2f\n got: %.2f", month, expected, schedule[month])
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package benefits
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// monthsBeforeJuly is the number of months in a calendar year before the
+// benefit year starts
+const monthsBeforeJuly = 6
+
+// ChildBenefitCalendar is used to calculate the child benefits paid in each
+// month of a calendar year. The payments from January to June belong to the
+// benefit year that started in the previous July and the payments from July to
+// December belong to the benefit year that starts in the calendar year. This
+// type implements the following interface: 'core.CalendarChildBenefitCalculator'
+type ChildBenefitCalendar struct {
+	previous         core.ChildBenefitCalculator
+	current          core.ChildBenefitCalculator
+	previousFinances core.HouseholdFinances
+	currentFinances  core.HouseholdFinances
+	children         []*human.Person
+}
+
+// compile-time check for interface implementation
+var _ core.CalendarChildBenefitCalculator = (*ChildBenefitCalendar)(nil)
+
+// NewChildBenefitCalendar returns a new calendar year child benefit calculator
+// from the calculators of the benefit years that start in July of the previous
+// year and of the calendar year. If one calculator is nil, it returns
+// wrapped(ErrNoCalc)
+func NewChildBenefitCalendar(previous, current core.ChildBenefitCalculator) (*ChildBenefitCalendar, error) {
+
+	if previous == nil {
+		return nil, errors.Wrap(ErrNoCalc, "invalid calculator for the previous benefit year")
+	}
+	if current == nil {
+		return nil, errors.Wrap(ErrNoCalc, "invalid calculator for the current benefit year")
+	}
+
+	c := &ChildBenefitCalendar{
+		previous:         previous,
+		current:          current,
+		previousFinances: core.NewHouseholdFinancesNop(),
+		currentFinances:  core.NewHouseholdFinancesNop(),
+	}
+	return c, nil
+}
+
+// MonthlyBenefits returns the recievable amount of child benefits in each month
+// of the calendar year, starting in January. Each month is computed with the
+// params of the benefit year it belongs to, the finances that benefit year is
+// based on and the ages of the children in that month. Since the calculators
+// age the children from July, children younger than six months in January are
+// set as newborns in July of the previous benefit year
+func (c *ChildBenefitCalendar) MonthlyBenefits() [12]float64 {
+
+	previousChildren := youngerChildren(c.children, monthsBeforeJuly)
+	currentChildren := agedChildren(c.children, monthsBeforeJuly)
+
+	var benefits [12]float64
+	for month := uint(0); month < 12; month++ {
+
+		calculator, finances, children := c.previous, c.previousFinances, previousChildren
+		benefitMonth := month + monthsBeforeJuly
+		if month >= monthsBeforeJuly {
+			calculator, finances, children = c.current, c.currentFinances, currentChildren
+			benefitMonth = month - monthsBeforeJuly
+		}
+
+		calculator.SetFinances(finances)
+		calculator.SetBeneficiaries(children)
+		benefits[month] = calculator.MonthlyBenefitRecievable(benefitMonth)
+	}
+
+	return benefits
+}
+
+// SetFinances stores the given financial data in this calculator, where the
+// previous base finances are of two years before the calendar year and the
+// current base finances are of the year before. Changes to the given finances
+// after calling this function will affect future calculations. If finances are
+// nil, non-nil, empty finances are set
+func (c *ChildBenefitCalendar) SetFinances(previousBase, currentBase core.HouseholdFinances) {
+
+	if previousBase == nil {
+		previousBase = core.NewHouseholdFinancesNop()
+	}
+	if currentBase == nil {
+		currentBase = core.NewHouseholdFinancesNop()
+	}
+
+	c.previousFinances, c.currentFinances = previousBase, currentBase
+}
+
+// SetBeneficiaries sets the children which the calculator will compute the
+// benefits for in subsequent calls to MonthlyBenefits(), where the children
+// are of their ages in January
+func (c *ChildBenefitCalendar) SetBeneficiaries(children []*human.Person) {
+	c.children = children
+}
+
+// agedChildren returns copies of the given children who are older by the given
+// number of months
+func agedChildren(children []*human.Person, months uint) []*human.Person {
+
+	aged := make([]*human.Person, len(children))
+	for i, child := range children {
+		if child == nil {
+			continue
+		}
+		agedChild := *child
+		agedChild.AgeMonths += months
+		aged[i] = &agedChild
+	}
+	return aged
+}
+
+// youngerChildren returns copies of the given children who are younger by the
+// given number of months, where the ages cannot be less than zero
+func youngerChildren(children []*human.Person, months uint) []*human.Person {
+
+	younger := make([]*human.Person, len(children))
+	for i, child := range children {
+		if child == nil {
+			continue
+		}
+		youngerChild := *child
+		youngerChild.AgeMonths -= months
+		if child.AgeMonths < months {
+			youngerChild.AgeMonths = 0
+		}
+		younger[i] = &youngerChild
+	}
+	return younger
+}
@@ -0,0 +1,114 @@
+package benefits
+
+import (
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func TestNewChildBenefitCalendar(t *testing.T) {
+
+	_, err := NewChildBenefitCalendar(nil, &testChildBenefitCalculator{})
+	if errors.Cause(err) != ErrNoCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoCalc, err)
+	}
+
+	_, err = NewChildBenefitCalendar(&testChildBenefitCalculator{}, nil)
+	if errors.Cause(err) != ErrNoCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoCalc, err)
+	}
+
+	_, err = NewChildBenefitCalendar(&testChildBenefitCalculator{}, &testChildBenefitCalculator{})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestChildBenefitCalendar_MonthlyBenefits(t *testing.T) {
+
+	previous := &testChildBenefitCalculator{onMonthlyBenefitRecievable: 100}
+	current := &testChildBenefitCalculator{onMonthlyBenefitRecievable: 200}
+
+	calendar, err := NewChildBenefitCalendar(previous, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previousBase, currentBase := core.NewHouseholdFinancesNop(), core.NewHouseholdFinancesNop()
+	calendar.SetFinances(previousBase, currentBase)
+
+	child := &human.Person{Name: "child", AgeMonths: 10}
+	calendar.SetBeneficiaries([]*human.Person{child, nil})
+
+	actual := calendar.MonthlyBenefits()
+	expected := [12]float64{100, 100, 100, 100, 100, 100, 200, 200, 200, 200, 200, 200}
+	if diff := deep.Equal(actual, expected); diff != nil {
+		t.Fatal(diff)
+	}
+
+	expectedMonths := []uint{6, 7, 8, 9, 10, 11}
+	if diff := deep.Equal(previous.monthsOnMonth, expectedMonths); diff != nil {
+		t.Errorf("unexpected benefit months of the previous benefit year\n%v", diff)
+	}
+
+	expectedMonths = []uint{0, 1, 2, 3, 4, 5}
+	if diff := deep.Equal(current.monthsOnMonth, expectedMonths); diff != nil {
+		t.Errorf("unexpected benefit months of the current benefit year\n%v", diff)
+	}
+
+	for i := range previous.financesOnMonth {
+		if previous.financesOnMonth[i] != previousBase {
+			t.Errorf("month %d: expected the finances of the previous base year", i)
+		}
+		if current.financesOnMonth[i] != currentBase {
+			t.Errorf("month %d: expected the finances of the current base year", i+6)
+		}
+	}
+
+	// the calculators age the children from their ages in July
+	for i, children := range append(previous.childrenOnMonth, current.childrenOnMonth...) {
+		expectedChildren := []*human.Person{{Name: "child", AgeMonths: 4}, nil}
+		if i >= 6 {
+			expectedChildren[0].AgeMonths = 16
+		}
+		if diff := deep.Equal(children, expectedChildren); diff != nil {
+			t.Errorf("month %d: unexpected children\n%v", i, diff)
+		}
+	}
+
+	if child.AgeMonths != 10 {
+		t.Errorf("expected the set children to be unchanged, got age: %d", child.AgeMonths)
+	}
+}
+
+func TestYoungerChildren(t *testing.T) {
+
+	children := []*human.Person{{Name: "older", AgeMonths: 10}, nil, {Name: "newborn", AgeMonths: 2}}
+
+	actual := youngerChildren(children, 6)
+	expected := []*human.Person{{Name: "older", AgeMonths: 4}, nil, {Name: "newborn", AgeMonths: 0}}
+	if diff := deep.Equal(actual, expected); diff != nil {
+		t.Error(diff)
+	}
+
+	if children[0].AgeMonths != 10 || children[2].AgeMonths != 2 {
+		t.Error("expected the given children to be unchanged")
+	}
+}
+
+func TestChildBenefitCalendar_SetFinances_Nil(t *testing.T) {
+
+	calendar, err := NewChildBenefitCalendar(&testChildBenefitCalculator{}, &testChildBenefitCalculator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calendar.SetFinances(nil, nil)
+	if calendar.previousFinances == nil || calendar.currentFinances == nil {
+		t.Error("expected nil finances to be replaced by empty finances")
+	}
+}
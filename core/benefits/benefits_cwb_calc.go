@@ -0,0 +1,91 @@
+package benefits
+
+import (
+	"github.com/malkhamis/quantax/core"
+
+	"github.com/pkg/errors"
+)
+
+// CWBCalculator is used to calculate the Canada Workers Benefit of low-income
+// workers. This type implements the following interface: 'core.CWBCalculator'
+type CWBCalculator struct {
+	formula           WorkersBenefitFormula
+	incomeCalculator  core.IncomeCalculator
+	workingCalculator core.IncomeCalculator
+	finances          core.HouseholdFinances
+	disabledA         bool
+	disabledB         bool
+}
+
+// compile-time check for interface implementation
+var _ core.CWBCalculator = (*CWBCalculator)(nil)
+
+// NewCWBCalculator returns a new CWB calculator for the given formula and the
+// income calculators, which compute the adjusted net income and the working
+// income of each spouse
+func NewCWBCalculator(cfg CalcConfigCWB) (*CWBCalculator, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	c := &CWBCalculator{
+		formula:           cfg.Formula.Clone(),
+		incomeCalculator:  cfg.IncomeCalc,
+		workingCalculator: cfg.WorkingIncomeCalc,
+		finances:          core.NewHouseholdFinancesNop(),
+	}
+	return c, nil
+}
+
+// BenefitRecievable returns the annual benefit of each spouse for the set
+// finances. If the finances of spouse B are nil, spouse A is considered to
+// have no spouse
+func (c *CWBCalculator) BenefitRecievable() (spouseA, spouseB float64) {
+
+	earnerA := c.earner(c.finances.SpouseA(), c.disabledA)
+	earnerB := c.earner(c.finances.SpouseB(), c.disabledB)
+	if earnerA == nil {
+		earnerA = &Earner{}
+	}
+
+	return c.formula.Apply(earnerA, earnerB)
+}
+
+// SetFinances stores the given financial data in this calculator. Subsequent
+// calls to other calculator functions will be based on the the given finances.
+// Changes to the given finances after calling this function will affect future
+// calculations. If finances is nil, a non-nil, empty finances is set
+func (c *CWBCalculator) SetFinances(finances core.HouseholdFinances) {
+
+	if finances == nil {
+		finances = core.NewHouseholdFinancesNop()
+	}
+
+	c.finances = finances
+}
+
+// SetDisabilities sets whether each spouse is eligible for the disability
+// supplement in subsequent calls to BenefitRecievable()
+func (c *CWBCalculator) SetDisabilities(spouseA, spouseB bool) {
+	c.disabledA, c.disabledB = spouseA, spouseB
+}
+
+// earner returns the earner of the given finances, or nil if the finances are
+// nil
+func (c *CWBCalculator) earner(finances core.Financer, disabled bool) *Earner {
+
+	if finances == nil {
+		return nil
+	}
+
+	c.incomeCalculator.SetFinances(finances)
+	c.workingCalculator.SetFinances(finances)
+
+	return &Earner{
+		WorkingIncome: c.workingCalculator.TotalIncome(),
+		NetIncome:     c.incomeCalculator.NetIncome(),
+		Disabled:      disabled,
+	}
+}
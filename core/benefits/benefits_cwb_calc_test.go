@@ -0,0 +1,108 @@
+package benefits
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func TestCalcConfigCWB_validate(t *testing.T) {
+
+	err := CalcConfigCWB{nil, testIncomeCalculator{}, testIncomeCalculator{}}.validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	simulatedErr := errors.New("test error")
+	err = CalcConfigCWB{&testWorkersBenefitFormula{onValidate: simulatedErr}, nil, nil}.validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+
+	err = CalcConfigCWB{&testWorkersBenefitFormula{}, nil, testIncomeCalculator{}}.validate()
+	if errors.Cause(err) != ErrNoIncCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
+	}
+
+	err = CalcConfigCWB{&testWorkersBenefitFormula{}, testIncomeCalculator{}, nil}.validate()
+	if errors.Cause(err) != ErrNoIncCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
+	}
+
+	_, err = NewCWBCalculator(CalcConfigCWB{&testWorkersBenefitFormula{}, testIncomeCalculator{}, testIncomeCalculator{}})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewCWBCalculator(t *testing.T) {
+
+	_, err := NewCWBCalculator(CalcConfigCWB{nil, nil, nil})
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+}
+
+func TestCWBCalculator_BenefitRecievable_Single(t *testing.T) {
+
+	formula := &testWorkersBenefitFormula{}
+	incCalc := testIncomeCalculator{onNetIncome: 5000}
+	workingCalc := testIncomeCalculator{onTotalIncome: 8000}
+	calculator, err := NewCWBCalculator(CalcConfigCWB{formula, incCalc, workingCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calculator.SetDisabilities(true, true)
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+
+	actualA, actualB := calculator.BenefitRecievable()
+	if actualA != 800.0 || actualB != 500.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 800.0, 500.0, actualA, actualB)
+	}
+
+	expected := [2]*Earner{{WorkingIncome: 8000, NetIncome: 5000, Disabled: true}, nil}
+	diff := deep.Equal(formula.earnersOnApply, expected)
+	if diff != nil {
+		t.Errorf("unexpected earners\n%v", diff)
+	}
+}
+
+func TestCWBCalculator_BenefitRecievable_Couple(t *testing.T) {
+
+	formula := &testWorkersBenefitFormula{}
+	incCalc := testIncomeCalculator{onNetIncome: 5000}
+	workingCalc := testIncomeCalculator{onTotalIncome: 8000}
+	calculator, err := NewCWBCalculator(CalcConfigCWB{formula, incCalc, workingCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calculator.SetDisabilities(false, true)
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+	calculator.BenefitRecievable()
+
+	expected := [2]*Earner{
+		{WorkingIncome: 8000, NetIncome: 5000, Disabled: false},
+		{WorkingIncome: 8000, NetIncome: 5000, Disabled: true},
+	}
+	diff := deep.Equal(formula.earnersOnApply, expected)
+	if diff != nil {
+		t.Errorf("unexpected earners\n%v", diff)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseB: &testFinancer{}})
+	calculator.BenefitRecievable()
+	if formula.earnersOnApply[0] == nil {
+		t.Error("expected an empty earner for nil finances of spouse A")
+	}
+
+	calculator.SetFinances(nil)
+	if calculator.finances == nil {
+		t.Error("expected nil finances to be replaced with empty finances")
+	}
+}
@@ -0,0 +1,105 @@
+package benefits
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// GISCalculator is used to calculate the Guaranteed Income Supplement of
+// low-income seniors and the Allowance of their spouses. This type implements
+// the following interface: 'core.GISCalculator'
+type GISCalculator struct {
+	formula          SupplementFormula
+	incomeCalculator core.IncomeCalculator
+	finances         core.HouseholdFinances
+	pensionerA       *human.Pensioner
+	pensionerB       *human.Pensioner
+	referenceDate    time.Time
+}
+
+// compile-time check for interface implementation
+var _ core.GISCalculator = (*GISCalculator)(nil)
+
+// NewGISCalculator returns a new GIS calculator for the given formula and the
+// income calculator, which computes the income that the supplement is tested
+// against
+func NewGISCalculator(cfg CalcConfigGIS) (*GISCalculator, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	c := &GISCalculator{
+		formula:          cfg.Formula.Clone(),
+		incomeCalculator: cfg.IncomeCalc,
+		finances:         core.NewHouseholdFinancesNop(),
+		referenceDate:    cfg.ReferenceDate,
+	}
+	return c, nil
+}
+
+// BenefitRecievable returns the annual supplement of each spouse set as a
+// pensioner in this calculator. If the finances of both spouses are set, the
+// supplement of each spouse is based on their combined income, and a spouse
+// who is not set as a pensioner is considered to recieve neither the OAS
+// pension nor the Allowance. Pensioners with birth dates are of their ages at
+// the reference date if set
+func (c *GISCalculator) BenefitRecievable() (spouseA, spouseB float64) {
+
+	income := c.income(c.finances.SpouseA())
+	setPensionerA := pensionerAtDate(c.pensionerA, c.referenceDate)
+	setPensionerB := pensionerAtDate(c.pensionerB, c.referenceDate)
+
+	if c.finances.SpouseB() == nil {
+		return c.formula.Apply(income, setPensionerA, nil), 0.0
+	}
+
+	income += c.income(c.finances.SpouseB())
+
+	pensionerA, pensionerB := setPensionerA, setPensionerB
+	if pensionerA == nil {
+		pensionerA = &human.Pensioner{}
+	}
+	if pensionerB == nil {
+		pensionerB = &human.Pensioner{}
+	}
+
+	spouseA = c.formula.Apply(income, setPensionerA, pensionerB)
+	spouseB = c.formula.Apply(income, setPensionerB, pensionerA)
+	return spouseA, spouseB
+}
+
+// SetFinances stores the given financial data in this calculator. Subsequent
+// calls to other calculator functions will be based on the the given finances.
+// Changes to the given finances after calling this function will affect future
+// calculations. If finances is nil, a non-nil, empty finances is set
+func (c *GISCalculator) SetFinances(finances core.HouseholdFinances) {
+
+	if finances == nil {
+		finances = core.NewHouseholdFinancesNop()
+	}
+
+	c.finances = finances
+}
+
+// SetPensioners sets the spouses which the calculator will compute the
+// supplement for in subsequent calls to BenefitRecievable()
+func (c *GISCalculator) SetPensioners(spouseA, spouseB *human.Pensioner) {
+	c.pensionerA, c.pensionerB = spouseA, spouseB
+}
+
+// income returns the income of the given finances
+func (c *GISCalculator) income(finances core.Financer) float64 {
+
+	if finances == nil {
+		return 0.0
+	}
+
+	c.incomeCalculator.SetFinances(finances)
+	return c.incomeCalculator.NetIncome()
+}
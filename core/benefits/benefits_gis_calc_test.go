@@ -0,0 +1,131 @@
+package benefits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func TestCalcConfigGIS_validate(t *testing.T) {
+
+	err := CalcConfigGIS{Formula: nil, IncomeCalc: testIncomeCalculator{}}.validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	simulatedErr := errors.New("test error")
+	err = CalcConfigGIS{Formula: &testSupplementFormula{onValidate: simulatedErr}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+
+	err = CalcConfigGIS{Formula: &testSupplementFormula{}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != ErrNoIncCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
+	}
+
+	_, err = NewGISCalculator(CalcConfigGIS{Formula: &testSupplementFormula{}, IncomeCalc: testIncomeCalculator{}})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewGISCalculator(t *testing.T) {
+
+	_, err := NewGISCalculator(CalcConfigGIS{Formula: nil, IncomeCalc: nil})
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+}
+
+func TestGISCalculator_BenefitRecievable_Single(t *testing.T) {
+
+	formula := &testSupplementFormula{}
+	incCalc := testIncomeCalculator{onNetIncome: 5000}
+	calculator, err := NewGISCalculator(CalcConfigGIS{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pensioner := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}}
+	calculator.SetPensioners(pensioner, nil)
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+
+	actualA, actualB := calculator.BenefitRecievable()
+	if actualA != 500.0 || actualB != 0.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 500.0, 0.0, actualA, actualB)
+	}
+
+	if len(formula.spousesOnApply) != 1 || formula.spousesOnApply[0] != nil {
+		t.Errorf("expected a single pensioner, got spouses: %v", formula.spousesOnApply)
+	}
+}
+
+func TestGISCalculator_BenefitRecievable_Couple(t *testing.T) {
+
+	formula := &testSupplementFormula{}
+	incCalc := testIncomeCalculator{onNetIncome: 5000}
+	calculator, err := NewGISCalculator(CalcConfigGIS{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pensioner := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}}
+	calculator.SetPensioners(pensioner, nil)
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+
+	actualA, actualB := calculator.BenefitRecievable()
+	if actualA != 1000.0 || actualB != 0.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 1000.0, 0.0, actualA, actualB)
+	}
+
+	diff := deep.Equal(formula.spousesOnApply, []*human.Pensioner{{}})
+	if diff != nil {
+		t.Errorf("expected a spouse who is not a pensioner\n%v", diff)
+	}
+
+	calculator.SetFinances(nil)
+	if calculator.finances == nil {
+		t.Error("expected nil finances to be replaced with empty finances")
+	}
+}
+
+func TestGISCalculator_BenefitRecievable_BirthDates(t *testing.T) {
+
+	formula := &testSupplementFormula{}
+	cfg := CalcConfigGIS{
+		Formula:       formula,
+		IncomeCalc:    testIncomeCalculator{},
+		ReferenceDate: human.TaxYearStart(2024),
+	}
+	calculator, err := NewGISCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	birthDate := time.Date(1959, time.March, 10, 0, 0, 0, 0, time.UTC)
+	pensionerA := &human.Pensioner{Person: human.Person{BirthDate: birthDate}}
+	pensionerB := &human.Pensioner{Person: human.Person{AgeMonths: 60 * 12}}
+	calculator.SetPensioners(pensionerA, pensionerB)
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{},
+		onSpouseB: &testFinancer{},
+	})
+	calculator.BenefitRecievable()
+
+	expected := []*human.Pensioner{
+		pensionerB,
+		{Person: human.Person{AgeMonths: 64*12 + 9, BirthDate: birthDate}},
+	}
+	diff := deep.Equal(formula.spousesOnApply, expected)
+	if diff != nil {
+		t.Errorf("expected the pensioners to be of their ages at the reference date\n%v", diff)
+	}
+}
@@ -0,0 +1,105 @@
+package benefits
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// HouseholdBenefitCalculator is used to calculate recievable benefits for
+// households. This type implements the following interface:
+// 'core.BenefitCalculator'
+type HouseholdBenefitCalculator struct {
+	formula          HouseholdBenefitFormula
+	incomeCalculator core.IncomeCalculator
+	finances         core.HouseholdFinances
+	dependents       []*human.Person
+	referenceDate    time.Time
+}
+
+// compile-time check for interface implementation
+var _ core.BenefitCalculator = (*HouseholdBenefitCalculator)(nil)
+
+// NewHouseholdBenefitCalculator returns a new household benefit calculator for
+// the given formula and the income calculator
+func NewHouseholdBenefitCalculator(cfg CalcConfigHB) (*HouseholdBenefitCalculator, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	c := &HouseholdBenefitCalculator{
+		formula:          cfg.Formula.Clone(),
+		incomeCalculator: cfg.IncomeCalc,
+		finances:         core.NewHouseholdFinancesNop(),
+		referenceDate:    cfg.ReferenceDate,
+	}
+	return c, nil
+}
+
+// BenefitRecievable returns the recievable amount of benefits for the set
+// finances and dependents
+func (c *HouseholdBenefitCalculator) BenefitRecievable() float64 {
+
+	household := &Household{
+		Finances:   c.finances,
+		NetIncomeA: c.netIncome(c.finances.SpouseA()),
+		NetIncomeB: c.netIncome(c.finances.SpouseB()),
+		Dependents: peopleAtDate(c.dependents, c.referenceDate),
+	}
+
+	return c.formula.Apply(household)
+}
+
+// PaymentSchedule returns the payments of the recievable benefits in each month
+// of the benefit year. The benefits are paid in equal monthly instalments
+// unless the formula specifies otherwise
+func (c *HouseholdBenefitCalculator) PaymentSchedule() core.PaymentSchedule {
+
+	annual := c.BenefitRecievable()
+
+	instalmentFormula, ok := c.formula.(InstalmentFormula)
+	if ok {
+		return instalmentFormula.Schedule(annual)
+	}
+
+	var schedule core.PaymentSchedule
+	for month := range schedule {
+		schedule[month] = annual / core.MonthsInBenefitYear
+	}
+	return schedule
+}
+
+// SetFinances stores the given financial data in this calculator. Subsequent
+// calls to other calculator functions will be based on the the given finances.
+// Changes to the given finances after calling this function will affect future
+// calculations. If finances is nil, a non-nil, empty finances is set
+func (c *HouseholdBenefitCalculator) SetFinances(finances core.HouseholdFinances) {
+
+	if finances == nil {
+		finances = core.NewHouseholdFinancesNop()
+	}
+
+	c.finances = finances
+}
+
+// SetDependents sets the dependents which the calculator will compute the
+// benefits for in subsequent calls to BenefitRecievable()
+func (c *HouseholdBenefitCalculator) SetDependents(dependents []*human.Person) {
+	c.dependents = dependents
+}
+
+// netIncome returns the net income of the given finances
+func (c *HouseholdBenefitCalculator) netIncome(finances core.Financer) float64 {
+
+	if finances == nil {
+		return 0.0
+	}
+
+	c.incomeCalculator.SetFinances(finances)
+	return c.incomeCalculator.NetIncome()
+}
@@ -0,0 +1,134 @@
+package benefits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+func TestCalcConfigHB_validate(t *testing.T) {
+
+	err := CalcConfigHB{Formula: nil, IncomeCalc: testIncomeCalculator{}}.validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	simulatedErr := errors.New("test error")
+	err = CalcConfigHB{Formula: &testHouseholdBenefitFormula{onValidate: simulatedErr}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+
+	err = CalcConfigHB{Formula: &testHouseholdBenefitFormula{}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != ErrNoIncCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
+	}
+
+	err = CalcConfigHB{Formula: &testHouseholdBenefitFormula{}, IncomeCalc: testIncomeCalculator{}}.validate()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewHouseholdBenefitCalculator(t *testing.T) {
+
+	_, err := NewHouseholdBenefitCalculator(CalcConfigHB{Formula: nil, IncomeCalc: nil})
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+}
+
+func TestHouseholdBenefitCalculator_BenefitRecievable(t *testing.T) {
+
+	formula := &testHouseholdBenefitFormula{}
+	incCalc := testIncomeCalculator{onNetIncome: 1000}
+	calculator, err := NewHouseholdBenefitCalculator(CalcConfigHB{Formula: formula, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finances := &testHouseholdFinances{onSpouseA: &testFinancer{}}
+	dependents := []*human.Person{{AgeMonths: 12}}
+	calculator.SetFinances(finances)
+	calculator.SetDependents(dependents)
+
+	actual := calculator.BenefitRecievable()
+	if actual != 1000.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 1000.0, actual)
+	}
+
+	household := formula.householdOnApply
+	if household.Finances != finances || household.HasSpouse() {
+		t.Error("expected the household to have the set finances of a single tax payer")
+	}
+	if len(household.Dependents) != 1 || household.Dependents[0] != dependents[0] {
+		t.Errorf("expected the household to have the set dependents, got: %v", household.Dependents)
+	}
+
+	finances.onSpouseB = &testFinancer{}
+	actual = calculator.BenefitRecievable()
+	if actual != 2000.0 || !formula.householdOnApply.HasSpouse() {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 2000.0, actual)
+	}
+
+	calculator.SetFinances(nil)
+	if calculator.finances == nil {
+		t.Error("expected nil finances to be replaced with empty finances")
+	}
+}
+
+func TestHouseholdBenefitCalculator_PaymentSchedule(t *testing.T) {
+
+	incCalc := testIncomeCalculator{onNetIncome: 1200}
+	calculator, err := NewHouseholdBenefitCalculator(CalcConfigHB{Formula: &testHouseholdBenefitFormula{}, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+
+	actual := calculator.PaymentSchedule()
+	expected := core.PaymentSchedule{100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+
+	incCalc = testIncomeCalculator{onNetIncome: 8000}
+	calculator, err = NewHouseholdBenefitCalculator(CalcConfigHB{Formula: testGSTCreditFormula(), IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{}})
+
+	actual = calculator.PaymentSchedule()
+	expected = core.PaymentSchedule{75, 0, 0, 75, 0, 0, 75, 0, 0, 75, 0, 0}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+}
+
+func TestHouseholdBenefitCalculator_BenefitRecievable_BirthDates(t *testing.T) {
+
+	formula := &testHouseholdBenefitFormula{}
+	cfg := CalcConfigHB{
+		Formula:       formula,
+		IncomeCalc:    testIncomeCalculator{},
+		ReferenceDate: human.BenefitYearStart(2024),
+	}
+	calculator, err := NewHouseholdBenefitCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	birthDate := time.Date(2005, time.August, 1, 0, 0, 0, 0, time.UTC)
+	calculator.SetDependents([]*human.Person{{BirthDate: birthDate}, nil})
+	calculator.BenefitRecievable()
+
+	dependents := formula.householdOnApply.Dependents
+	if len(dependents) != 2 || dependents[0].AgeMonths != 19*12-1 || dependents[1] != nil {
+		t.Errorf("expected the dependents to be of their ages at the reference date, got: %v", dependents)
+	}
+}
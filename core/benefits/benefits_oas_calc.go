@@ -0,0 +1,97 @@
+package benefits
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+
+	"github.com/pkg/errors"
+)
+
+// OASCalculator is used to calculate the Old Age Security pension of seniors
+// and its recovery tax. This type implements the following interface:
+// 'core.OASCalculator'
+type OASCalculator struct {
+	formula          PensionFormula
+	incomeCalculator core.IncomeCalculator
+	finances         core.HouseholdFinances
+	pensionerA       *human.Pensioner
+	pensionerB       *human.Pensioner
+	referenceDate    time.Time
+}
+
+// compile-time check for interface implementation
+var _ core.OASCalculator = (*OASCalculator)(nil)
+
+// NewOASCalculator returns a new OAS calculator for the given formula and the
+// income calculator
+func NewOASCalculator(cfg CalcConfigOAS) (*OASCalculator, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	c := &OASCalculator{
+		formula:          cfg.Formula.Clone(),
+		incomeCalculator: cfg.IncomeCalc,
+		finances:         core.NewHouseholdFinancesNop(),
+		referenceDate:    cfg.ReferenceDate,
+	}
+	return c, nil
+}
+
+// PensionRecievable returns the annual pension of each spouse set as a
+// pensioner in this calculator, where pensioners with birth dates are of their
+// ages at the reference date if set
+func (c *OASCalculator) PensionRecievable() (spouseA, spouseB float64) {
+	pensionerA := pensionerAtDate(c.pensionerA, c.referenceDate)
+	pensionerB := pensionerAtDate(c.pensionerB, c.referenceDate)
+	return c.formula.Apply(pensionerA), c.formula.Apply(pensionerB)
+}
+
+// RecoveryTax returns the recovery tax of each spouse, which is based on the
+// net income of the spouse as computed by the income calculator. The pension
+// that is recovered is the amount recorded in the spouse's finances under
+// 'core.IncSrcOAS', and the tax is computed by the formula's recovery adjuster
+// which is also part of the federal tax adjustments
+func (c *OASCalculator) RecoveryTax() (spouseA, spouseB float64) {
+	return c.recoveryTax(c.finances.SpouseA()), c.recoveryTax(c.finances.SpouseB())
+}
+
+// SetFinances stores the given financial data in this calculator. Subsequent
+// calls to other calculator functions will be based on the the given finances.
+// Changes to the given finances after calling this function will affect future
+// calculations. If finances is nil, a non-nil, empty finances is set
+func (c *OASCalculator) SetFinances(finances core.HouseholdFinances) {
+
+	if finances == nil {
+		finances = core.NewHouseholdFinancesNop()
+	}
+
+	c.finances = finances
+}
+
+// SetPensioners sets the spouses which the calculator will compute the pension
+// for in subsequent calls to PensionRecievable()
+func (c *OASCalculator) SetPensioners(spouseA, spouseB *human.Pensioner) {
+	c.pensionerA, c.pensionerB = spouseA, spouseB
+}
+
+// recoveryTax returns the recovery tax for the given finances
+func (c *OASCalculator) recoveryTax(finances core.Financer) float64 {
+
+	if finances == nil {
+		return 0.0
+	}
+
+	c.incomeCalculator.SetFinances(finances)
+	taxPayer := &tax.TaxPayer{
+		Finances:  finances,
+		NetIncome: c.incomeCalculator.NetIncome(),
+	}
+
+	return c.formula.RecoveryAdjuster().Adjustment(taxPayer, 0.0)
+}
@@ -0,0 +1,122 @@
+package benefits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+func TestCalcConfigOAS_validate(t *testing.T) {
+
+	err := CalcConfigOAS{Formula: nil, IncomeCalc: testIncomeCalculator{}}.validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	simulatedErr := errors.New("test error")
+	err = CalcConfigOAS{Formula: testPensionFormula{onValidate: simulatedErr}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+
+	err = CalcConfigOAS{Formula: testPensionFormula{}, IncomeCalc: nil}.validate()
+	if errors.Cause(err) != ErrNoIncCalc {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoIncCalc, err)
+	}
+
+	err = CalcConfigOAS{Formula: testPensionFormula{}, IncomeCalc: testIncomeCalculator{}}.validate()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewOASCalculator(t *testing.T) {
+
+	_, err := NewOASCalculator(CalcConfigOAS{Formula: nil, IncomeCalc: nil})
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+}
+
+func TestOASCalculator_PensionRecievable(t *testing.T) {
+
+	calculator, err := NewOASCalculator(CalcConfigOAS{Formula: testOASFormula(), IncomeCalc: testIncomeCalculator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pensionA, pensionB := calculator.PensionRecievable()
+	if pensionA != 0.0 || pensionB != 0.0 {
+		t.Errorf("expected no pension without pensioners, got: %.2f, %.2f", pensionA, pensionB)
+	}
+
+	calculator.SetPensioners(
+		&human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 40},
+		nil,
+	)
+	pensionA, pensionB = calculator.PensionRecievable()
+	if pensionA != 12*600 || pensionB != 0.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 12*600.0, 0.0, pensionA, pensionB)
+	}
+}
+
+func TestOASCalculator_PensionRecievable_BirthDates(t *testing.T) {
+
+	cfg := CalcConfigOAS{
+		Formula:       testOASFormula(),
+		IncomeCalc:    testIncomeCalculator{},
+		ReferenceDate: human.TaxYearStart(2024),
+	}
+	calculator, err := NewOASCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// turns 65 on March 10 and is 65 from April 1
+	birthDate := time.Date(1959, time.March, 10, 0, 0, 0, 0, time.UTC)
+	calculator.SetPensioners(
+		&human.Pensioner{Person: human.Person{AgeMonths: 70 * 12, BirthDate: birthDate}, ResidenceYears: 40},
+		&human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 40},
+	)
+	pensionA, pensionB := calculator.PensionRecievable()
+	if pensionA != 9*600 || pensionB != 12*600 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 9*600.0, 12*600.0, pensionA, pensionB)
+	}
+}
+
+func TestOASCalculator_RecoveryTax(t *testing.T) {
+
+	incCalc := testIncomeCalculator{onNetIncome: 50000}
+	calculator, err := NewOASCalculator(CalcConfigOAS{Formula: testPensionFormula{}, IncomeCalc: incCalc})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recoveryA, recoveryB := calculator.RecoveryTax()
+	if recoveryA != 0.0 || recoveryB != 0.0 {
+		t.Errorf("expected no recovery tax without pension, got: %.2f, %.2f", recoveryA, recoveryB)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{
+		onSpouseA: &testFinancer{onTotalAmount: 7000},
+		onSpouseB: &testFinancer{onTotalAmount: 2000},
+	})
+	recoveryA, recoveryB = calculator.RecoveryTax()
+	if recoveryA != 5000.0 || recoveryB != 2000.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 5000.0, 2000.0, recoveryA, recoveryB)
+	}
+
+	calculator.SetFinances(&testHouseholdFinances{onSpouseA: &testFinancer{onTotalAmount: 7000}})
+	recoveryA, recoveryB = calculator.RecoveryTax()
+	if recoveryA != 5000.0 || recoveryB != 0.0 {
+		t.Errorf("unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f", 5000.0, 0.0, recoveryA, recoveryB)
+	}
+
+	calculator.SetFinances(nil)
+	if calculator.finances == nil {
+		t.Error("expected nil finances to be replaced with empty finances")
+	}
+}
@@ -1,6 +1,7 @@
 package benefits
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/malkhamis/quantax/core/human"
@@ -18,3 +19,48 @@ func Test_getChildCount(t *testing.T) {
 		t.Fatalf("expected nil child to not be counted, got: %d", actual)
 	}
 }
+
+func Test_custodyBenefits(t *testing.T) {
+
+	apply := func(children []*human.Person) float64 {
+		return 100.0 * float64(len(children))
+	}
+
+	cases := []struct {
+		name     string
+		children []*human.Person
+		expected float64
+	}{
+		{
+			name:     "full-custody",
+			children: []*human.Person{{}, nil, {CustodyPercent: 70}},
+			expected: 300,
+		},
+		{
+			name:     "shared-custody",
+			children: []*human.Person{{}, {CustodyPercent: 50}},
+			expected: 100 + 0.5*100,
+		},
+		{
+			name:     "shared-custody-only",
+			children: []*human.Person{{CustodyPercent: 40}, {CustodyPercent: 60}},
+			expected: 0.5 * 200,
+		},
+		{
+			name:     "excluded",
+			children: []*human.Person{{}, {CustodyPercent: 30}, {Residency: human.NonResident}},
+			expected: 100,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := custodyBenefits(c.children, apply)
+			if actual != c.expected {
+				t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
@@ -57,6 +57,32 @@ func (mr *BCECTBMaxReducer) Apply(netIncome float64, children ...*human.Person)
 	return reducedBenefits
 }
 
+// ApplyMonth returns the total benefits for the children in the given month of
+// the benefit year given the net income, where the annual reduction is spread
+// evenly over the months of the year
+func (mr *BCECTBMaxReducer) ApplyMonth(netIncome float64, _ uint, children ...*human.Person) float64 {
+
+	childCount := getChildCount(children)
+	if childCount == 0 {
+		return 0.0
+	}
+
+	var maxBenefits, minBenefits float64
+	for _, child := range children {
+		min, max := multiAgeGroupBenefits(mr.BeneficiaryClasses).MonthlyAmounts(child)
+		minBenefits += min
+		maxBenefits += max
+	}
+	reduction := float64(childCount) * mr.ReducerFormula.Apply(netIncome) / 12.0
+
+	reducedBenefits := maxBenefits - reduction
+	if reducedBenefits < minBenefits {
+		return minBenefits
+	}
+
+	return reducedBenefits
+}
+
 // Validate ensures that this instance is valid for use. Users need to call this
 // method before use only if the instance was manually created/modified
 func (mr *BCECTBMaxReducer) Validate() error {
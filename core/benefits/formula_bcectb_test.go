@@ -159,3 +159,40 @@ func TestBCECTBReducer_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestBCECTBMaxReducer_ApplyMonth(t *testing.T) {
+
+	mr := &BCECTBMaxReducer{
+		ReducerFormula: core.WeightedBrackets{
+			0.0132: core.Bracket{100000, math.Inf(1)},
+		},
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 6*12 - 1},
+				AmountsPerMonth: core.Bracket{0, 55},
+			},
+		},
+	}
+
+	child1, child2 := &human.Person{AgeMonths: 0}, &human.Person{AgeMonths: 6 * 12}
+
+	income := 110000.0
+	expected := 55.0 - (2 * 0.0132 * 10000 / 12.0)
+	actual := mr.ApplyMonth(income, 3, child1, child2)
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	expected = 0.0
+	actual = mr.ApplyMonth(income, 3) // no children
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	income = 500000.0
+	expected = 0.0
+	actual = mr.ApplyMonth(income, 3, child1)
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
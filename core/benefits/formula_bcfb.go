@@ -0,0 +1,179 @@
+package benefits
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ SingleParentFormula = (*BCFamilyBenefitFormula)(nil)
+
+// BCFamilyBenefitFormula computes the British Columbia Family Benefit, which
+// replaced the BC Early Childhood Tax Benefit in October 2020 and was known as
+// the BC Child Opportunity Benefit until 2023. Each eligible child has a
+// maximum and a minimum amount by birth order. The maximum benefits are reduced
+// by the income above the lower threshold until they reach the minimum
+// benefits, which are in turn reduced by the income above the upper threshold.
+// Single-parent families also recieve a supplement that is added to the
+// maximum benefits. The benefits are computed monthly for the 12 months that
+// start at the children's ages
+type BCFamilyBenefitFormula struct {
+	// MaxAmounts are the maximum annual amounts by birth order, where the last
+	// amount applies to each additional child
+	MaxAmounts []float64
+	// MinAmounts are the minimum annual amounts by birth order, where the last
+	// amount applies to each additional child
+	MinAmounts []float64
+	// MaxChildAgeMonths is the maximum age of eligible children
+	MaxChildAgeMonths uint
+	// ReductionRate is the percentage of income above each threshold that the
+	// benefits are reduced by
+	ReductionRate float64
+	// LowerThreshold is the income above which the maximum benefits are
+	// reduced
+	LowerThreshold float64
+	// UpperThreshold is the income above which the minimum benefits are
+	// reduced
+	UpperThreshold float64
+	// SingleParentSupplement is the maximum annual supplement of single-parent
+	// families
+	SingleParentSupplement float64
+}
+
+// Apply returns the total annual benefits for the children given the net income
+func (f *BCFamilyBenefitFormula) Apply(netIncome float64, children ...*human.Person) float64 {
+	return f.apply(netIncome, 0.0, children)
+}
+
+// ApplySingleParent is like Apply, except that the single-parent supplement is
+// added to the maximum benefits
+func (f *BCFamilyBenefitFormula) ApplySingleParent(netIncome float64, children ...*human.Person) float64 {
+	return f.apply(netIncome, f.SingleParentSupplement, children)
+}
+
+// ApplyMonth returns the total benefits for the children in the given month of
+// the benefit year given the net income
+func (f *BCFamilyBenefitFormula) ApplyMonth(netIncome float64, _ uint, children ...*human.Person) float64 {
+	return f.monthly(netIncome, 0.0, f.eligibleCount(0, children))
+}
+
+// ApplySingleParentMonth is like ApplyMonth, except that the single-parent
+// supplement is added to the maximum benefits
+func (f *BCFamilyBenefitFormula) ApplySingleParentMonth(netIncome float64, _ uint, children ...*human.Person) float64 {
+	return f.monthly(netIncome, f.SingleParentSupplement, f.eligibleCount(0, children))
+}
+
+// Validate ensures that this instance is valid for use. Users need to call this
+// method before use only if the instance was manually created/modified
+func (f *BCFamilyBenefitFormula) Validate() error {
+
+	if len(f.MaxAmounts) == 0 || len(f.MaxAmounts) != len(f.MinAmounts) {
+		return errors.Wrap(ErrInvalidFormula, "expected as many minimum amounts as maximum amounts")
+	}
+
+	for i := range f.MaxAmounts {
+		if f.MinAmounts[i] < 0.0 || f.MinAmounts[i] > f.MaxAmounts[i] {
+			return errors.Wrapf(
+				ErrInvalidFormula,
+				"minimum amount %.2f is not within [0, %.2f]", f.MinAmounts[i], f.MaxAmounts[i],
+			)
+		}
+	}
+
+	if f.ReductionRate < 0.0 || f.ReductionRate > 1.0 {
+		return errors.Wrapf(ErrInvalidFormula, "rate %.4f is not within [0, 1]", f.ReductionRate)
+	}
+
+	if f.LowerThreshold < 0.0 || f.LowerThreshold > f.UpperThreshold {
+		return errors.Wrapf(
+			ErrInvalidFormula,
+			"thresholds [%.2f, %.2f] are not ordered", f.LowerThreshold, f.UpperThreshold,
+		)
+	}
+
+	if f.SingleParentSupplement < 0.0 {
+		return errors.Wrapf(ErrInvalidFormula, "negative amount: %.2f", f.SingleParentSupplement)
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this instance
+func (f *BCFamilyBenefitFormula) Clone() ChildBenefitFormula {
+
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+
+	if f.MaxAmounts != nil {
+		clone.MaxAmounts = make([]float64, len(f.MaxAmounts))
+		copy(clone.MaxAmounts, f.MaxAmounts)
+	}
+
+	if f.MinAmounts != nil {
+		clone.MinAmounts = make([]float64, len(f.MinAmounts))
+		copy(clone.MinAmounts, f.MinAmounts)
+	}
+
+	return &clone
+}
+
+// apply returns the total annual benefits for the children given the net
+// income, where the given supplement is added to the maximum benefits
+func (f *BCFamilyBenefitFormula) apply(netIncome, supplement float64, children []*human.Person) float64 {
+
+	var benefits float64
+	for month := uint(0); month < 12; month++ {
+		benefits += f.monthly(netIncome, supplement, f.eligibleCount(month, children))
+	}
+
+	return benefits
+}
+
+// monthly returns the benefits for a month in which the given number of
+// children are eligible, where the given supplement is added to the maximum
+// benefits
+func (f *BCFamilyBenefitFormula) monthly(netIncome, supplement float64, childCount int) float64 {
+
+	if childCount == 0 {
+		return 0.0
+	}
+
+	max, min := supplement, 0.0
+	for order := 0; order < childCount; order++ {
+		max += f.amount(f.MaxAmounts, order)
+		min += f.amount(f.MinAmounts, order)
+	}
+
+	floor := math.Max(0.0, min-f.ReductionRate*math.Max(0.0, netIncome-f.UpperThreshold))
+	reduced := max - f.ReductionRate*math.Max(0.0, netIncome-f.LowerThreshold)
+	return math.Max(reduced, floor) / 12.0
+}
+
+// amount returns the amount of the child of the given birth order, where the
+// last amount applies to each additional child
+func (f *BCFamilyBenefitFormula) amount(amounts []float64, order int) float64 {
+
+	if order >= len(amounts) {
+		order = len(amounts) - 1
+	}
+	return amounts[order]
+}
+
+// eligibleCount returns the number of the given children who are eligible in
+// the given month, starting at their ages
+func (f *BCFamilyBenefitFormula) eligibleCount(month uint, children []*human.Person) int {
+
+	var count int
+	for _, child := range children {
+		if child != nil && child.AgeMonths+month <= f.MaxChildAgeMonths {
+			count++
+		}
+	}
+	return count
+}
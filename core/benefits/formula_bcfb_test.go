@@ -0,0 +1,186 @@
+package benefits
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func testBCFamilyBenefitFormula() *BCFamilyBenefitFormula {
+	return &BCFamilyBenefitFormula{
+		MaxAmounts:             []float64{1600, 1000, 800},
+		MinAmounts:             []float64{700, 680, 660},
+		MaxChildAgeMonths:      18*12 - 1,
+		ReductionRate:          0.04,
+		LowerThreshold:         25000,
+		UpperThreshold:         80000,
+		SingleParentSupplement: 500,
+	}
+}
+
+func TestBCFamilyBenefitFormula_Apply(t *testing.T) {
+
+	child := &human.Person{AgeMonths: 5 * 12}
+	cases := []struct {
+		name      string
+		netIncome float64
+		children  []*human.Person
+		expected  float64
+	}{
+		{
+			name:      "no-children",
+			netIncome: 20000,
+			expected:  0,
+		},
+		{
+			name:      "one-child-max",
+			netIncome: 20000,
+			children:  []*human.Person{child, nil},
+			expected:  1600,
+		},
+		{
+			name:      "two-children-reduced",
+			netIncome: 30000,
+			children:  []*human.Person{child, child},
+			expected:  1600 + 1000 - 200,
+		},
+		{
+			name:      "three-children-min",
+			netIncome: 60000,
+			children:  []*human.Person{child, child, child},
+			expected:  700 + 680 + 660,
+		},
+		{
+			name:      "four-children-min-reduced",
+			netIncome: 90000,
+			children:  []*human.Person{child, child, child, child},
+			expected:  700 + 680 + 660 + 660 - 400,
+		},
+		{
+			name:      "one-child-fully-reduced",
+			netIncome: 100000,
+			children:  []*human.Person{child},
+			expected:  0,
+		},
+		{
+			name:      "child-ages-out",
+			netIncome: 20000,
+			children:  []*human.Person{{AgeMonths: 18*12 - 3}},
+			expected:  1600 * 3 / 12,
+		},
+	}
+
+	formula := testBCFamilyBenefitFormula()
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := formula.Apply(c.netIncome, c.children...)
+			if math.Abs(actual-c.expected) > 1e-9 {
+				t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBCFamilyBenefitFormula_ApplySingleParent(t *testing.T) {
+
+	formula := testBCFamilyBenefitFormula()
+	child := &human.Person{AgeMonths: 5 * 12}
+
+	actual := formula.ApplySingleParent(30000, child)
+	expected := 1600.0 + 500.0 - 200.0
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = formula.ApplySingleParent(30000)
+	if actual != 0.0 {
+		t.Errorf("expected no supplement without children, got: %.2f", actual)
+	}
+}
+
+func TestBCFamilyBenefitFormula_ApplyMonth(t *testing.T) {
+
+	formula := testBCFamilyBenefitFormula()
+	child, adult := &human.Person{AgeMonths: 5 * 12}, &human.Person{AgeMonths: 18 * 12}
+
+	actual := formula.ApplyMonth(30000, 4, child, adult)
+	expected := (1600.0 - 200.0) / 12.0
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = formula.ApplySingleParentMonth(30000, 4, child, adult)
+	expected = (1600.0 + 500.0 - 200.0) / 12.0
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = formula.ApplySingleParentMonth(30000, 4, adult)
+	if actual != 0.0 {
+		t.Errorf("expected no benefits without eligible children, got: %.2f", actual)
+	}
+}
+
+func TestBCFamilyBenefitFormula_Validate(t *testing.T) {
+
+	err := testBCFamilyBenefitFormula().Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*BCFamilyBenefitFormula)
+	}{
+		{name: "no-amounts", modify: func(f *BCFamilyBenefitFormula) { f.MaxAmounts, f.MinAmounts = nil, nil }},
+		{name: "missing-min-amount", modify: func(f *BCFamilyBenefitFormula) { f.MinAmounts = f.MinAmounts[:2] }},
+		{name: "negative-min-amount", modify: func(f *BCFamilyBenefitFormula) { f.MinAmounts[0] = -1 }},
+		{name: "min-above-max", modify: func(f *BCFamilyBenefitFormula) { f.MinAmounts[2] = 900 }},
+		{name: "rate-above-one", modify: func(f *BCFamilyBenefitFormula) { f.ReductionRate = 4 }},
+		{name: "unordered-thresholds", modify: func(f *BCFamilyBenefitFormula) { f.UpperThreshold = 20000 }},
+		{name: "negative-supplement", modify: func(f *BCFamilyBenefitFormula) { f.SingleParentSupplement = -1 }},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			formula := testBCFamilyBenefitFormula()
+			c.modify(formula)
+			err := formula.Validate()
+			if errors.Cause(err) != ErrInvalidFormula {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+			}
+		})
+	}
+}
+
+func TestBCFamilyBenefitFormula_Clone(t *testing.T) {
+
+	original := testBCFamilyBenefitFormula()
+	clone := original.Clone()
+
+	diff := deep.Equal(original, clone)
+	if diff != nil {
+		t.Fatal("clone does not match the original\n", diff)
+	}
+
+	original.MaxAmounts[0] = 1
+	original.MinAmounts[0] = 1
+	if diff := deep.Equal(original, clone); diff == nil {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
@@ -1,18 +1,22 @@
 package benefits
 
 import (
+	"math"
+
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
 
 	"github.com/pkg/errors"
 )
 
-var _ ChildBenefitFormula = (*CCBMaxReducer)(nil)
+var _ LumpSumFormula = (*CCBMaxReducer)(nil)
 
 // CCBMaxReducer computes Canada Child Benefits as a function of income, number
 // of children, and children's ages. The formula calculates the maximum
 // entitlement for all children, then the max is reduced based on the income,
-// where reduction is calculated according to multi-tier, rated brackets
+// where reduction is calculated according to multi-tier, rated brackets. The
+// Child Disability Benefit is added for children who are eligible for the
+// disability tax credit, and it is reduced independently of the base benefits
 type CCBMaxReducer struct {
 	// the [min, max] dollar amounts for given age groups (bound-inclusive)
 	BeneficiaryClasses []AgeGroupBenefits
@@ -21,6 +25,16 @@ type CCBMaxReducer struct {
 	// If the number of children is greater than the number of formulas,
 	// the last formula is used
 	Reducers []core.WeightedBrackets
+	// SmallPaymentThreshold is the annual benefits below which the benefits
+	// are paid in a single payment in July instead of monthly
+	SmallPaymentThreshold float64
+	// DisabilityAmount is the maximum annual Child Disability Benefit for each
+	// child who is eligible for the disability tax credit
+	DisabilityAmount float64
+	// DisabilityReducers are the formulas that reduce the Child Disability
+	// Benefit, which are mapped to the number of eligible children like
+	// Reducers. If there are no formulas, the benefit is not reduced
+	DisabilityReducers []core.WeightedBrackets
 }
 
 // Apply returns the total annual benefits for the children given the net income
@@ -52,10 +66,50 @@ func (mr *CCBMaxReducer) Apply(netIncome float64, children ...*human.Person) flo
 
 	reducedBenefits := maxBenefits - reduction
 	if reducedBenefits < minBenefits {
-		return minBenefits
+		reducedBenefits = minBenefits
+	}
+
+	return reducedBenefits + mr.disabilityBenefits(netIncome, children)
+}
+
+// ApplyMonth returns the total benefits for the children in the given month of
+// the benefit year given the net income, where the annual reduction is spread
+// evenly over the months of the year. The reduction is based on the number of
+// children who belong to a beneficiary class in that month
+func (mr *CCBMaxReducer) ApplyMonth(netIncome float64, _ uint, children ...*human.Person) float64 {
+
+	var (
+		childCount               int
+		maxBenefits, minBenefits float64
+	)
+	for _, child := range children {
+		if child == nil || !multiAgeGroupBenefits(mr.BeneficiaryClasses).IsEligible(child) {
+			continue
+		}
+		childCount++
+
+		min, max := multiAgeGroupBenefits(mr.BeneficiaryClasses).MonthlyAmounts(child)
+		minBenefits += min
+		maxBenefits += max
+	}
+
+	if childCount == 0 {
+		return 0.0
+	}
+	reduction := mr.reducerFormula(childCount).Apply(netIncome) / 12.0
+
+	reducedBenefits := maxBenefits - reduction
+	if reducedBenefits < minBenefits {
+		reducedBenefits = minBenefits
 	}
 
-	return reducedBenefits
+	return reducedBenefits + mr.monthlyDisabilityBenefits(netIncome, children)
+}
+
+// LumpSumThreshold returns the annual benefits below which the benefits are
+// paid in a single payment
+func (mr *CCBMaxReducer) LumpSumThreshold() float64 {
+	return mr.SmallPaymentThreshold
 }
 
 // Validate ensures that this instance is valid for use. Users need to call this
@@ -72,6 +126,12 @@ func (mr *CCBMaxReducer) Validate() error {
 		return ErrNoFormula
 	}
 
+	for _, amount := range []float64{mr.SmallPaymentThreshold, mr.DisabilityAmount} {
+		if amount < 0.0 {
+			return errors.Wrapf(ErrInvalidFormula, "negative amount: %.2f", amount)
+		}
+	}
+
 	for _, formula := range mr.Reducers {
 
 		if formula == nil {
@@ -84,6 +144,18 @@ func (mr *CCBMaxReducer) Validate() error {
 
 	}
 
+	for _, formula := range mr.DisabilityReducers {
+
+		if formula == nil {
+			return ErrNoFormula
+		}
+
+		if err := formula.Validate(); err != nil {
+			return errors.Wrap(err, "invalid disability reducer")
+		}
+
+	}
+
 	return nil
 }
 
@@ -94,7 +166,10 @@ func (mr *CCBMaxReducer) Clone() ChildBenefitFormula {
 		return nil
 	}
 
-	clone := &CCBMaxReducer{}
+	clone := &CCBMaxReducer{
+		SmallPaymentThreshold: mr.SmallPaymentThreshold,
+		DisabilityAmount:      mr.DisabilityAmount,
+	}
 
 	if mr.Reducers != nil {
 		clone.Reducers = make([]core.WeightedBrackets, len(mr.Reducers))
@@ -103,6 +178,13 @@ func (mr *CCBMaxReducer) Clone() ChildBenefitFormula {
 		}
 	}
 
+	if mr.DisabilityReducers != nil {
+		clone.DisabilityReducers = make([]core.WeightedBrackets, len(mr.DisabilityReducers))
+		for i, reducer := range mr.DisabilityReducers {
+			clone.DisabilityReducers[i] = reducer.Clone()
+		}
+	}
+
 	if mr.BeneficiaryClasses != nil {
 		clone.BeneficiaryClasses = make([]AgeGroupBenefits, len(mr.BeneficiaryClasses))
 		copy(clone.BeneficiaryClasses, mr.BeneficiaryClasses)
@@ -111,17 +193,79 @@ func (mr *CCBMaxReducer) Clone() ChildBenefitFormula {
 	return clone
 }
 
+// disabilityBenefits returns the annual Child Disability Benefit for the given
+// children, where each eligible child recieves the monthly amount for each
+// month in which they belong to a beneficiary class
+func (mr *CCBMaxReducer) disabilityBenefits(netIncome float64, children []*human.Person) float64 {
+
+	var eligibleCount, eligibleMonths int
+	for _, child := range children {
+
+		if child == nil || !child.DisabilityEligible {
+			continue
+		}
+
+		months := multiAgeGroupBenefits(mr.BeneficiaryClasses).EligibleMonths(child)
+		if months > 0 {
+			eligibleCount++
+			eligibleMonths += months
+		}
+	}
+
+	if eligibleCount == 0 {
+		return 0.0
+	}
+
+	benefits := float64(eligibleMonths) * mr.DisabilityAmount / 12.0
+	reduction := reducerFor(mr.DisabilityReducers, eligibleCount).Apply(netIncome)
+	return math.Max(0.0, benefits-reduction)
+}
+
+// monthlyDisabilityBenefits returns the Child Disability Benefit for the given
+// children in a single month, where the annual reduction is spread evenly
+// over the months of the year
+func (mr *CCBMaxReducer) monthlyDisabilityBenefits(netIncome float64, children []*human.Person) float64 {
+
+	var eligibleCount int
+	for _, child := range children {
+		if child != nil && child.DisabilityEligible {
+			if multiAgeGroupBenefits(mr.BeneficiaryClasses).IsEligible(child) {
+				eligibleCount++
+			}
+		}
+	}
+
+	if eligibleCount == 0 {
+		return 0.0
+	}
+
+	benefits := float64(eligibleCount) * mr.DisabilityAmount / 12.0
+	reduction := reducerFor(mr.DisabilityReducers, eligibleCount).Apply(netIncome) / 12.0
+	return math.Max(0.0, benefits-reduction)
+}
+
 // reducerFormula returns the reduction formula based on the child count
 func (mr *CCBMaxReducer) reducerFormula(childCount int) core.WeightedBrackets {
+	return reducerFor(mr.Reducers, childCount)
+}
+
+// reducerFor returns the reduction formula of the given formulas based on the
+// child count, where the last formula is used for larger child counts. If
+// there are no formulas, it returns nil, which reduces nothing
+func reducerFor(reducers []core.WeightedBrackets, childCount int) core.WeightedBrackets {
+
+	if len(reducers) == 0 {
+		return nil
+	}
 
 	var reducerIndex int
 
-	if childCount >= len(mr.Reducers) {
-		reducerIndex = len(mr.Reducers)
+	if childCount >= len(reducers) {
+		reducerIndex = len(reducers)
 	} else {
 		reducerIndex = childCount
 	}
 
 	reducerIndex--
-	return mr.Reducers[reducerIndex]
+	return reducers[reducerIndex]
 }
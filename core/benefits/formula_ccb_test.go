@@ -68,6 +68,93 @@ func TestCCBMaxReducer_Apply(t *testing.T) {
 	}
 }
 
+func TestCCBMaxReducer_Apply_Disability(t *testing.T) {
+
+	mr := &CCBMaxReducer{
+		Reducers: []core.WeightedBrackets{
+			{0.070: core.Bracket{50000, math.Inf(1)}},
+		},
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 23},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+		},
+		DisabilityAmount: 1200,
+		DisabilityReducers: []core.WeightedBrackets{
+			{0.010: core.Bracket{60000, math.Inf(1)}}, // 1 child
+			{0.020: core.Bracket{60000, math.Inf(1)}}, // 2+ children
+		},
+	}
+
+	err := mr.Validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		income   float64
+		children []*human.Person
+		expected float64
+	}{
+		{
+			name:     "not-eligible",
+			income:   50000,
+			children: []*human.Person{{AgeMonths: 0}},
+			expected: 6000,
+		},
+		{
+			name:     "unreduced",
+			income:   50000,
+			children: []*human.Person{{AgeMonths: 0, DisabilityEligible: true}},
+			expected: 6000 + 1200,
+		},
+		{
+			name:     "partial-year",
+			income:   50000,
+			children: []*human.Person{{AgeMonths: 18, DisabilityEligible: true}},
+			expected: 6*500 + 6*100,
+		},
+		{
+			name:   "reduced-two-children",
+			income: 70000,
+			children: []*human.Person{
+				{AgeMonths: 0, DisabilityEligible: true},
+				{AgeMonths: 0, DisabilityEligible: true},
+			},
+			expected: 12000 - 0.07*20000 + 2400 - 0.02*10000,
+		},
+		{
+			name:     "fully-reduced",
+			income:   200000,
+			children: []*human.Person{{AgeMonths: 0, DisabilityEligible: true}},
+			expected: 0,
+		},
+	}
+
+	for i, c := range cases {
+		actual := mr.Apply(c.income, c.children...)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("case%d-%s: unexpected results\nwant: %.2f\n got: %.2f", i, c.name, c.expected, actual)
+		}
+	}
+
+	child := &human.Person{AgeMonths: 6, DisabilityEligible: true}
+	expected := 500.0 + 100.0 - 0.07*20000/12.0 - 0.01*10000/12.0
+	actual := mr.ApplyMonth(70000, 0, child)
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	mr.DisabilityReducers = nil
+	expected = 500.0 + 100.0 - 0.07*20000/12.0
+	actual = mr.ApplyMonth(70000, 0, child)
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
+
 func TestCCBMaxReducer_Validate_InvalidAgeRanges(t *testing.T) {
 
 	formula := CCBMaxReducer{
@@ -107,6 +194,16 @@ func TestCCBMaxReducer_Validate_NilFormula(t *testing.T) {
 		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
 	}
 
+	formula = CCBMaxReducer{
+		Reducers:           []core.WeightedBrackets{{0.07: core.Bracket{30000, 60000}}},
+		DisabilityReducers: []core.WeightedBrackets{nil},
+	}
+
+	err = formula.Validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
 }
 
 func TestCCBMaxReducer_Validate_InvalidFormula(t *testing.T) {
@@ -121,6 +218,37 @@ func TestCCBMaxReducer_Validate_InvalidFormula(t *testing.T) {
 	if errors.Cause(err) != core.ErrBoundsReversed {
 		t.Fatalf("unexpected error\nwant: %v\n got: %v", core.ErrBoundsReversed, err)
 	}
+
+	formula = CCBMaxReducer{
+		Reducers:           []core.WeightedBrackets{{0.07: core.Bracket{30000, 60000}}},
+		DisabilityReducers: []core.WeightedBrackets{{0.032: core.Bracket{100000, 1}}},
+	}
+
+	err = formula.Validate()
+	if errors.Cause(err) != core.ErrBoundsReversed {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", core.ErrBoundsReversed, err)
+	}
+}
+
+func TestCCBMaxReducer_Validate_NegativeThreshold(t *testing.T) {
+
+	formula := CCBMaxReducer{
+		Reducers:              []core.WeightedBrackets{{0.07: core.Bracket{30000, 60000}}},
+		SmallPaymentThreshold: -1,
+	}
+
+	err := formula.Validate()
+	if errors.Cause(err) != ErrInvalidFormula {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+	}
+
+	formula.SmallPaymentThreshold = 0
+	formula.DisabilityAmount = -1
+
+	err = formula.Validate()
+	if errors.Cause(err) != ErrInvalidFormula {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+	}
 }
 
 func TestCCBMaxReducer_Clone(t *testing.T) {
@@ -148,6 +276,9 @@ func TestCCBMaxReducer_Clone(t *testing.T) {
 				AmountsPerMonth: core.Bracket{0, 250},
 			},
 		},
+		SmallPaymentThreshold: 240,
+		DisabilityAmount:      1200,
+		DisabilityReducers:    []core.WeightedBrackets{{0.01: core.Bracket{50000, math.Inf(1)}}},
 	}
 
 	err := originalFormula.Validate()
@@ -156,18 +287,25 @@ func TestCCBMaxReducer_Clone(t *testing.T) {
 	}
 
 	income := 100000.0
-	child1, child2 := &human.Person{AgeMonths: 0}, &human.Person{AgeMonths: 6}
+	child1 := &human.Person{AgeMonths: 0}
+	child2 := &human.Person{AgeMonths: 6, DisabilityEligible: true}
 	originalResults := originalFormula.Apply(income, child1, child2)
 
 	clone := originalFormula.Clone()
 	originalFormula.BeneficiaryClasses = nil
 	originalFormula.Reducers = nil
+	originalFormula.DisabilityReducers[0][0.01] = core.Bracket{0, math.Inf(1)}
 
 	actualResults := clone.Apply(income, child1, child2)
 	if actualResults != originalResults {
 		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", originalResults, actualResults)
 	}
 
+	threshold := clone.(*CCBMaxReducer).LumpSumThreshold()
+	if threshold != 240.0 {
+		t.Errorf("unexpected threshold\nwant: %.2f\n got: %.2f", 240.0, threshold)
+	}
+
 }
 
 func TestCCBMaxReducer_Clone_Nil(t *testing.T) {
@@ -183,7 +321,7 @@ func TestCCBMaxReducer_NumFieldsUnchanged(t *testing.T) {
 
 	dummy := CCBMaxReducer{}
 	s := reflect.ValueOf(&dummy).Elem()
-	if s.NumField() != 2 {
+	if s.NumField() != 5 {
 		t.Fatal(
 			"number of struct fields changed. Please update the constructor and the " +
 				"clone method of this type as well as associated test. Next, update " +
@@ -191,3 +329,62 @@ func TestCCBMaxReducer_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestCCBMaxReducer_ApplyMonth(t *testing.T) {
+
+	mr := &CCBMaxReducer{
+		Reducers: []core.WeightedBrackets{
+			{
+				0.000: core.Bracket{0, 10000},
+				0.030: core.Bracket{10000, 50000},
+				0.070: core.Bracket{50000, math.Inf(1)},
+			},
+			{
+				0.000: core.Bracket{0, 10000},
+				0.050: core.Bracket{10000, 50000},
+				0.100: core.Bracket{50000, math.Inf(1)},
+			},
+		},
+		BeneficiaryClasses: []AgeGroupBenefits{
+			{
+				AgesMonths:      human.AgeRange{0, 11},
+				AmountsPerMonth: core.Bracket{0, 500},
+			},
+			{
+				AgesMonths:      human.AgeRange{12, 23},
+				AmountsPerMonth: core.Bracket{0, 250},
+			},
+		},
+	}
+
+	child1, child2 := &human.Person{AgeMonths: 0}, &human.Person{AgeMonths: 12}
+
+	income := 100000.0
+	expected := (500.0 + 250.0) - ((0.050*40000)+(0.100*50000))/12.0
+	actual := mr.ApplyMonth(income, 6, child1, child2)
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	expected = 0.0
+	actual = mr.ApplyMonth(income, 6) // no children
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	income = 500000.0
+	expected = 0.0
+	actual = mr.ApplyMonth(income, 0, child1)
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	// a child who aged out is not counted for the reduction
+	agedOut := &human.Person{AgeMonths: 24}
+	income = 100000.0
+	expected = 500.0 - ((0.030*40000)+(0.070*50000))/12.0
+	actual = mr.ApplyMonth(income, 6, child1, agedOut)
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
@@ -0,0 +1,151 @@
+package benefits
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ WorkersBenefitFormula = (*CWBFormula)(nil)
+
+// CWBPhases represents an amount that is phased in with working income up to a
+// maximum and then phased out with adjusted net income
+type CWBPhases struct {
+	// MaxAmount is the maximum amount after it is fully phased in
+	MaxAmount float64
+	// PhaseInRate is the percentage of working income above the phase-in
+	// threshold that the amount is phased in with
+	PhaseInRate float64
+	// PhaseInThreshold is the working income above which the amount is
+	// phased in
+	PhaseInThreshold float64
+	// PhaseOutRate is the percentage of adjusted net income above the
+	// phase-out threshold that the amount is reduced by
+	PhaseOutRate float64
+	// PhaseOutThreshold is the adjusted net income above which the amount is
+	// reduced
+	PhaseOutThreshold float64
+}
+
+// apply returns the amount for the given working income and adjusted net
+// income
+func (p CWBPhases) apply(workingIncome, netIncome float64) float64 {
+	phasedIn := p.PhaseInRate * math.Max(0.0, workingIncome-p.PhaseInThreshold)
+	reduction := p.PhaseOutRate * math.Max(0.0, netIncome-p.PhaseOutThreshold)
+	return math.Max(0.0, math.Min(phasedIn, p.MaxAmount)-reduction)
+}
+
+// validate checks if the phases are valid for use
+func (p CWBPhases) validate() error {
+
+	for _, amount := range []float64{p.MaxAmount, p.PhaseInThreshold, p.PhaseOutThreshold} {
+		if amount < 0.0 {
+			return errors.Wrapf(ErrInvalidFormula, "negative amount: %.2f", amount)
+		}
+	}
+
+	for _, rate := range []float64{p.PhaseInRate, p.PhaseOutRate} {
+		if rate < 0.0 || rate > 1.0 {
+			return errors.Wrapf(ErrInvalidFormula, "rate %.4f is not within [0, 1]", rate)
+		}
+	}
+
+	return nil
+}
+
+// CWBFormula computes the Canada Workers Benefit, which consists of a basic
+// amount and a disability supplement for workers who are eligible for the
+// disability tax credit. The basic amount of a family is phased in with the
+// family working income and only one spouse may claim it. If only one spouse is
+// eligible for the disability supplement, that spouse is designated to claim
+// the basic amount. Otherwise, spouse A is designated. The disability
+// supplement is phased in with the working income of each eligible spouse, and
+// if both spouses are eligible, the supplement of each is reduced at half the
+// phase-out rate. All amounts are phased out with the adjusted family net
+// income
+type CWBFormula struct {
+	// Single is the basic amount of workers without a spouse
+	Single CWBPhases
+	// Family is the basic amount of workers with a spouse
+	Family CWBPhases
+	// DisabilitySingle is the disability supplement of workers without a
+	// spouse
+	DisabilitySingle CWBPhases
+	// DisabilityFamily is the disability supplement of workers with a spouse
+	DisabilityFamily CWBPhases
+	// SecondaryEarnerExemption is the maximum working income of the spouse
+	// with the lower working income that is excluded from the adjusted family
+	// net income
+	SecondaryEarnerExemption float64
+}
+
+// Apply returns the annual benefit of each spouse. If spouse A is nil, it
+// returns zeros
+func (f *CWBFormula) Apply(spouseA, spouseB *Earner) (float64, float64) {
+
+	if spouseA == nil {
+		return 0.0, 0.0
+	}
+
+	if spouseB == nil {
+		benefit := f.Single.apply(spouseA.WorkingIncome, spouseA.NetIncome)
+		if spouseA.Disabled {
+			benefit += f.DisabilitySingle.apply(spouseA.WorkingIncome, spouseA.NetIncome)
+		}
+		return benefit, 0.0
+	}
+
+	exemption := math.Min(spouseA.WorkingIncome, spouseB.WorkingIncome)
+	exemption = math.Min(math.Max(0.0, exemption), f.SecondaryEarnerExemption)
+	familyNetIncome := spouseA.NetIncome + spouseB.NetIncome - exemption
+	familyWorkingIncome := spouseA.WorkingIncome + spouseB.WorkingIncome
+
+	disability := f.DisabilityFamily
+	if spouseA.Disabled && spouseB.Disabled {
+		disability.PhaseOutRate /= 2.0
+	}
+
+	var benefitA, benefitB float64
+	if spouseA.Disabled {
+		benefitA = disability.apply(spouseA.WorkingIncome, familyNetIncome)
+	}
+	if spouseB.Disabled {
+		benefitB = disability.apply(spouseB.WorkingIncome, familyNetIncome)
+	}
+
+	basic := f.Family.apply(familyWorkingIncome, familyNetIncome)
+	if spouseB.Disabled && !spouseA.Disabled {
+		return benefitA, benefitB + basic
+	}
+	return benefitA + basic, benefitB
+}
+
+// Validate checks if the formula is valid for use
+func (f *CWBFormula) Validate() error {
+
+	all := []CWBPhases{f.Single, f.Family, f.DisabilitySingle, f.DisabilityFamily}
+	for _, phases := range all {
+		err := phases.validate()
+		if err != nil {
+			return errors.Wrap(err, "invalid phases")
+		}
+	}
+
+	if f.SecondaryEarnerExemption < 0.0 {
+		return errors.Wrapf(ErrInvalidFormula, "negative amount: %.2f", f.SecondaryEarnerExemption)
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this formula
+func (f *CWBFormula) Clone() WorkersBenefitFormula {
+
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+	return &clone
+}
@@ -0,0 +1,164 @@
+package benefits
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func testCWBFormula() *CWBFormula {
+	return &CWBFormula{
+		Single: CWBPhases{
+			MaxAmount: 1000, PhaseInRate: 0.25, PhaseInThreshold: 3000, PhaseOutRate: 0.15, PhaseOutThreshold: 20000,
+		},
+		Family: CWBPhases{
+			MaxAmount: 2000, PhaseInRate: 0.25, PhaseInThreshold: 3000, PhaseOutRate: 0.15, PhaseOutThreshold: 25000,
+		},
+		DisabilitySingle: CWBPhases{
+			MaxAmount: 500, PhaseInRate: 0.25, PhaseInThreshold: 1000, PhaseOutRate: 0.15, PhaseOutThreshold: 30000,
+		},
+		DisabilityFamily: CWBPhases{
+			MaxAmount: 500, PhaseInRate: 0.25, PhaseInThreshold: 1000, PhaseOutRate: 0.15, PhaseOutThreshold: 40000,
+		},
+		SecondaryEarnerExemption: 10000,
+	}
+}
+
+func TestCWBFormula_Apply(t *testing.T) {
+
+	cases := []struct {
+		name      string
+		spouseA   *Earner
+		spouseB   *Earner
+		expectedA float64
+		expectedB float64
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "single-no-working-income",
+			spouseA:   &Earner{WorkingIncome: 0, NetIncome: 5000},
+			expectedA: 0,
+		},
+		{
+			name:      "single-phase-in",
+			spouseA:   &Earner{WorkingIncome: 5000, NetIncome: 5000},
+			expectedA: 500,
+		},
+		{
+			name:      "single-max",
+			spouseA:   &Earner{WorkingIncome: 15000, NetIncome: 15000},
+			expectedA: 1000,
+		},
+		{
+			name:      "single-reduced",
+			spouseA:   &Earner{WorkingIncome: 24000, NetIncome: 24000},
+			expectedA: 400,
+		},
+		{
+			name:      "single-fully-reduced",
+			spouseA:   &Earner{WorkingIncome: 40000, NetIncome: 40000},
+			expectedA: 0,
+		},
+		{
+			name:      "single-disabled",
+			spouseA:   &Earner{WorkingIncome: 15000, NetIncome: 15000, Disabled: true},
+			expectedA: 1000 + 500,
+		},
+		{
+			name:      "couple-phase-in",
+			spouseA:   &Earner{WorkingIncome: 6000, NetIncome: 6000},
+			spouseB:   &Earner{WorkingIncome: 4000, NetIncome: 4000},
+			expectedA: 1750,
+		},
+		{
+			name:      "couple-secondary-earner-exemption",
+			spouseA:   &Earner{WorkingIncome: 30000, NetIncome: 30000},
+			spouseB:   &Earner{WorkingIncome: 12000, NetIncome: 12000},
+			expectedA: 2000 - 0.15*7000,
+		},
+		{
+			name:      "couple-spouse-b-designated",
+			spouseA:   &Earner{WorkingIncome: 20000, NetIncome: 20000},
+			spouseB:   &Earner{WorkingIncome: 5000, NetIncome: 5000, Disabled: true},
+			expectedB: 2000 + 500,
+		},
+		{
+			name:      "couple-both-disabled",
+			spouseA:   &Earner{WorkingIncome: 35000, NetIncome: 35000, Disabled: true},
+			spouseB:   &Earner{WorkingIncome: 20000, NetIncome: 20000, Disabled: true},
+			expectedA: 500 - 0.075*5000,
+			expectedB: 500 - 0.075*5000,
+		},
+	}
+
+	formula := testCWBFormula()
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actualA, actualB := formula.Apply(c.spouseA, c.spouseB)
+			if math.Abs(actualA-c.expectedA) > 1e-9 || math.Abs(actualB-c.expectedB) > 1e-9 {
+				t.Errorf(
+					"unexpected results\nwant: %.2f, %.2f\n got: %.2f, %.2f",
+					c.expectedA, c.expectedB, actualA, actualB,
+				)
+			}
+		})
+	}
+}
+
+func TestCWBFormula_Validate(t *testing.T) {
+
+	err := testCWBFormula().Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*CWBFormula)
+	}{
+		{name: "negative-amount", modify: func(f *CWBFormula) { f.Family.MaxAmount = -1 }},
+		{name: "negative-threshold", modify: func(f *CWBFormula) { f.DisabilitySingle.PhaseOutThreshold = -1 }},
+		{name: "negative-rate", modify: func(f *CWBFormula) { f.Single.PhaseInRate = -0.25 }},
+		{name: "rate-above-one", modify: func(f *CWBFormula) { f.DisabilityFamily.PhaseOutRate = 15 }},
+		{name: "negative-exemption", modify: func(f *CWBFormula) { f.SecondaryEarnerExemption = -1 }},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			formula := testCWBFormula()
+			c.modify(formula)
+			err := formula.Validate()
+			if errors.Cause(err) != ErrInvalidFormula {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+			}
+		})
+	}
+}
+
+func TestCWBFormula_Clone(t *testing.T) {
+
+	original := testCWBFormula()
+	clone := original.Clone()
+	if *clone.(*CWBFormula) != *original {
+		t.Fatal("clone does not match the original")
+	}
+
+	original.Single.MaxAmount = 1
+	if clone.(*CWBFormula).Single.MaxAmount == 1 {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
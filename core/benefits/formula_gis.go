@@ -0,0 +1,184 @@
+package benefits
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ SupplementFormula = (*GISFormula)(nil)
+
+// quartersInYear is the number of quarters for which supplement rates are set
+const quartersInYear = 4
+
+// GISRates represents the maximum supplement of a class of recipients and how
+// it is reduced by their income
+type GISRates struct {
+	// the maximum monthly supplement in each quarter of the year
+	AmountsPerMonth [quartersInYear]float64
+	// the reduction of the annual supplement, which is applied on the income
+	Reducers core.WeightedBrackets
+}
+
+// monthly returns the supplement for a month in the given quarter after it is
+// reduced by the given annual reduction
+func (r GISRates) monthly(quarter int, annualReduction float64) float64 {
+	return math.Max(0.0, r.AmountsPerMonth[quarter]-annualReduction/12.0)
+}
+
+// validate checks if the rates are valid for use
+func (r GISRates) validate() error {
+
+	for _, amount := range r.AmountsPerMonth {
+		if amount < 0.0 {
+			return errors.Wrapf(ErrInvalidFormula, "negative supplement amount: %.2f", amount)
+		}
+	}
+
+	return r.Reducers.Validate()
+}
+
+// clone returns a copy of the rates
+func (r GISRates) clone() GISRates {
+	clone := r
+	clone.Reducers = r.Reducers.Clone()
+	return clone
+}
+
+// GISFormula computes the Guaranteed Income Supplement, which is a monthly
+// supplement paid to low-income pensioners who recieve the OAS pension, and
+// the Allowance, which is paid to spouses of such pensioners who are too young
+// for the OAS pension. The maximum supplement depends on whether the pensioner
+// has a spouse and whether the spouse recieves the OAS pension or the
+// Allowance. The supplement is reduced by the combined income of the pensioner
+// and their spouse. Maximum amounts are set quarterly, where the first quarter
+// starts in January
+type GISFormula struct {
+	// Single are the rates of pensioners without a spouse
+	Single GISRates
+	// SpouseOAS are the rates of pensioners whose spouse recieves the OAS
+	// pension
+	SpouseOAS GISRates
+	// SpouseAllowance are the rates of pensioners whose spouse recieves the
+	// Allowance
+	SpouseAllowance GISRates
+	// SpouseNoOAS are the rates of pensioners whose spouse recieves neither
+	// the OAS pension nor the Allowance
+	SpouseNoOAS GISRates
+	// Allowance are the rates of spouses who recieve the Allowance
+	Allowance GISRates
+	// OASAgeMonths is the age at which the OAS pension may start
+	OASAgeMonths uint
+	// AllowanceAgesMonths is the age group of spouses who may recieve the
+	// Allowance (bound-inclusive)
+	AllowanceAgesMonths human.AgeRange
+}
+
+// Apply returns the annual supplement of the given pensioner for the 12 months
+// that start at the pensioner's age. A pensioner recieves the supplement in
+// the months they recieve the OAS pension, which starts at the OAS age plus the
+// months they deferred it. Otherwise, they recieve the Allowance in the months
+// their age is within the Allowance age group and their spouse recieves the
+// OAS pension. If the pensioner is nil, it returns zero
+func (f *GISFormula) Apply(income float64, pensioner, spouse *human.Pensioner) float64 {
+
+	if pensioner == nil {
+		return 0.0
+	}
+
+	var supplement float64
+	for month := uint(0); month < 12; month++ {
+
+		rates, ok := f.rates(month, pensioner, spouse)
+		if !ok {
+			continue
+		}
+
+		quarter := int(month) / (12 / quartersInYear)
+		supplement += rates.monthly(quarter, rates.Reducers.Apply(income))
+	}
+
+	return supplement
+}
+
+// Validate checks if the formula is valid for use
+func (f *GISFormula) Validate() error {
+
+	all := []GISRates{f.Single, f.SpouseOAS, f.SpouseAllowance, f.SpouseNoOAS, f.Allowance}
+	for _, rates := range all {
+		err := rates.validate()
+		if err != nil {
+			return errors.Wrap(err, "invalid rates")
+		}
+	}
+
+	err := f.AllowanceAgesMonths.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid Allowance age group")
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this formula
+func (f *GISFormula) Clone() SupplementFormula {
+
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+	clone.Single = f.Single.clone()
+	clone.SpouseOAS = f.SpouseOAS.clone()
+	clone.SpouseAllowance = f.SpouseAllowance.clone()
+	clone.SpouseNoOAS = f.SpouseNoOAS.clone()
+	clone.Allowance = f.Allowance.clone()
+
+	return &clone
+}
+
+// rates returns the rates that apply to the given pensioner in the given month
+// of the year. It returns false if the pensioner recieves no supplement
+func (f *GISFormula) rates(month uint, pensioner, spouse *human.Pensioner) (GISRates, bool) {
+
+	if !f.recievesOAS(pensioner, month) {
+		if f.recievesAllowance(pensioner, spouse, month) {
+			return f.Allowance, true
+		}
+		return GISRates{}, false
+	}
+
+	switch {
+	case spouse == nil:
+		return f.Single, true
+	case f.recievesOAS(spouse, month):
+		return f.SpouseOAS, true
+	case f.recievesAllowance(spouse, pensioner, month):
+		return f.SpouseAllowance, true
+	default:
+		return f.SpouseNoOAS, true
+	}
+}
+
+// recievesOAS returns true if the given pensioner recieves the OAS pension in
+// the given month of the year
+func (f *GISFormula) recievesOAS(pensioner *human.Pensioner, month uint) bool {
+	return pensioner.AgeMonths+month >= f.OASAgeMonths+pensioner.DeferralMonths
+}
+
+// recievesAllowance returns true if the given pensioner recieves the Allowance
+// in the given month of the year, which requires their spouse to recieve the
+// OAS pension
+func (f *GISFormula) recievesAllowance(pensioner, spouse *human.Pensioner, month uint) bool {
+
+	if spouse == nil || !f.recievesOAS(spouse, month) {
+		return false
+	}
+
+	age := pensioner.AgeMonths + month
+	return age >= f.AllowanceAgesMonths.Min() && age <= f.AllowanceAgesMonths.Max()
+}
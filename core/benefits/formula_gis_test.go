@@ -0,0 +1,206 @@
+package benefits
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func testGISFormula() *GISFormula {
+
+	rates := func(amount, rate float64) GISRates {
+		return GISRates{
+			AmountsPerMonth: [quartersInYear]float64{amount, amount, amount, amount + 12},
+			Reducers:        core.WeightedBrackets{rate: core.Bracket{0, math.Inf(1)}},
+		}
+	}
+
+	return &GISFormula{
+		Single:              rates(1000, 0.50),
+		SpouseOAS:           rates(600, 0.25),
+		SpouseAllowance:     rates(600, 0.20),
+		SpouseNoOAS:         rates(1000, 0.10),
+		Allowance:           rates(1300, 0.75),
+		OASAgeMonths:        65 * 12,
+		AllowanceAgesMonths: human.AgeRange{60 * 12, 65*12 - 1},
+	}
+}
+
+func TestGISFormula_Apply(t *testing.T) {
+
+	senior := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}}
+	turns65 := &human.Pensioner{Person: human.Person{AgeMonths: 65*12 - 6}}
+	deferred := &human.Pensioner{Person: human.Person{AgeMonths: 65 * 12}, DeferralMonths: 24}
+	nearSenior := &human.Pensioner{Person: human.Person{AgeMonths: 62 * 12}}
+	young := &human.Pensioner{Person: human.Person{AgeMonths: 40 * 12}}
+
+	cases := []struct {
+		name      string
+		income    float64
+		pensioner *human.Pensioner
+		spouse    *human.Pensioner
+		expected  float64
+	}{
+		{
+			name:      "nil-pensioner",
+			pensioner: nil,
+			expected:  0.0,
+		},
+		{
+			name:      "single",
+			income:    2400,
+			pensioner: senior,
+			expected:  9*(1000-100) + 3*(1012-100),
+		},
+		{
+			name:      "single-high-income",
+			income:    100000,
+			pensioner: senior,
+			expected:  0.0,
+		},
+		{
+			name:      "single-turns-65",
+			income:    2400,
+			pensioner: turns65,
+			expected:  3*(1000-100) + 3*(1012-100),
+		},
+		{
+			name:      "single-deferred",
+			income:    2400,
+			pensioner: deferred,
+			expected:  0.0,
+		},
+		{
+			name:      "spouse-oas",
+			income:    4800,
+			pensioner: senior,
+			spouse:    senior,
+			expected:  9*(600-100) + 3*(612-100),
+		},
+		{
+			name:      "spouse-allowance",
+			income:    6000,
+			pensioner: senior,
+			spouse:    nearSenior,
+			expected:  9*(600-100) + 3*(612-100),
+		},
+		{
+			name:      "spouse-no-oas",
+			income:    12000,
+			pensioner: senior,
+			spouse:    young,
+			expected:  9*(1000-100) + 3*(1012-100),
+		},
+		{
+			name:      "allowance",
+			income:    1600,
+			pensioner: nearSenior,
+			spouse:    senior,
+			expected:  9*(1300-100) + 3*(1312-100),
+		},
+		{
+			name:      "allowance-spouse-no-oas",
+			income:    1600,
+			pensioner: nearSenior,
+			spouse:    young,
+			expected:  0.0,
+		},
+		{
+			name:      "allowance-single",
+			income:    1600,
+			pensioner: nearSenior,
+			expected:  0.0,
+		},
+		{
+			name:      "allowance-turns-65",
+			income:    4800,
+			pensioner: turns65,
+			spouse:    senior,
+			expected:  6*(1300-300) + 3*(600-100) + 3*(612-100),
+		},
+	}
+
+	formula := testGISFormula()
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := formula.Apply(c.income, c.pensioner, c.spouse)
+			if math.Abs(actual-c.expected) > 1e-6 {
+				t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGISFormula_Validate(t *testing.T) {
+
+	err := testGISFormula().Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*GISFormula)
+		err    error
+	}{
+		{
+			name:   "negative-amount",
+			modify: func(f *GISFormula) { f.SpouseNoOAS.AmountsPerMonth[2] = -1 },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name:   "invalid-reducers",
+			modify: func(f *GISFormula) { f.Allowance.Reducers = core.WeightedBrackets{0.75: {10, 0}} },
+			err:    core.ErrBoundsReversed,
+		},
+		{
+			name:   "invalid-allowance-ages",
+			modify: func(f *GISFormula) { f.AllowanceAgesMonths = human.AgeRange{65, 60} },
+			err:    human.ErrInvalidAgeRange,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			formula := testGISFormula()
+			c.modify(formula)
+			err := formula.Validate()
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestGISFormula_Clone(t *testing.T) {
+
+	original := testGISFormula()
+	clone := original.Clone()
+
+	diff := deep.Equal(original, clone)
+	if diff != nil {
+		t.Fatal("clone does not match the original\n", diff)
+	}
+
+	original.Single.Reducers[0.50] = core.Bracket{0, 10}
+	diff = deep.Equal(original, clone)
+	if diff == nil {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
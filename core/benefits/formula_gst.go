@@ -0,0 +1,149 @@
+package benefits
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ InstalmentFormula = (*GSTCreditFormula)(nil)
+
+// GSTCreditFormula computes the GST/HST credit, which is an annual credit for
+// low and modest income households. The credit consists of a base amount for
+// the tax payer and their spouse, an amount for each child, and a supplement
+// for tax payers without a spouse. Single parents recieve the base amount for
+// their first child instead of the child amount, as well as the full single
+// supplement. Otherwise, the single supplement is phased in with the tax
+// payer's net income. The credit is then reduced by the family net income.
+// Non-resident children are not eligible, and the amounts for children under
+// shared custody are prorated by the household's custody share
+type GSTCreditFormula struct {
+	// AdultAmount is the amount for the tax payer and their spouse
+	AdultAmount float64
+	// ChildAmount is the amount for each child
+	ChildAmount float64
+	// MaxChildAgeMonths is the maximum age of children who are eligible for
+	// the child amount
+	MaxChildAgeMonths uint
+	// SingleSupplement is the maximum supplement for tax payers without a
+	// spouse
+	SingleSupplement float64
+	// SupplementRate is the percentage of net income above the supplement
+	// threshold that the supplement is phased in with
+	SupplementRate float64
+	// SupplementThreshold is the net income above which the supplement is
+	// phased in
+	SupplementThreshold float64
+	// ReductionRate is the percentage of family net income above the
+	// reduction threshold that the credit is reduced by
+	ReductionRate float64
+	// ReductionThreshold is the family net income above which the credit is
+	// reduced
+	ReductionThreshold float64
+	// SmallPaymentThreshold is the annual credit below which the credit is
+	// paid in a single payment in July instead of quarterly
+	SmallPaymentThreshold float64
+}
+
+// Apply returns the annual GST/HST credit for the given household. If the
+// household is nil, it returns zero
+func (f *GSTCreditFormula) Apply(household *Household) float64 {
+
+	if household == nil {
+		return 0.0
+	}
+
+	children, firstChild := f.childShares(household)
+	credit := f.AdultAmount
+
+	switch {
+	case household.HasSpouse():
+		credit += f.AdultAmount + children*f.ChildAmount
+	case children > 0:
+		credit += firstChild*(f.AdultAmount+f.SingleSupplement) + (children-firstChild)*f.ChildAmount
+		credit += (1.0 - firstChild) * f.supplement(household.NetIncomeA)
+	default:
+		credit += f.supplement(household.NetIncomeA)
+	}
+
+	familyIncome := household.NetIncomeA + household.NetIncomeB
+	reduction := f.ReductionRate * math.Max(0.0, familyIncome-f.ReductionThreshold)
+
+	return math.Max(0.0, credit-reduction)
+}
+
+// Schedule returns the payments of the given annual credit, which is paid in
+// four equal instalments in July, October, January, and April. If the annual
+// credit is below the small-payment threshold, it is paid in July
+func (f *GSTCreditFormula) Schedule(annual float64) core.PaymentSchedule {
+
+	var schedule core.PaymentSchedule
+	for month := 0; month < core.MonthsInBenefitYear; month += 3 {
+		schedule[month] = annual / 4.0
+	}
+	return schedule.LumpSum(f.SmallPaymentThreshold)
+}
+
+// Validate checks if the formula is valid for use
+func (f *GSTCreditFormula) Validate() error {
+
+	amounts := []float64{
+		f.AdultAmount, f.ChildAmount, f.SingleSupplement,
+		f.SupplementThreshold, f.ReductionThreshold, f.SmallPaymentThreshold,
+	}
+	for _, amount := range amounts {
+		if amount < 0.0 {
+			return errors.Wrapf(ErrInvalidFormula, "negative amount: %.2f", amount)
+		}
+	}
+
+	for _, rate := range []float64{f.SupplementRate, f.ReductionRate} {
+		if rate < 0.0 || rate > 1.0 {
+			return errors.Wrapf(ErrInvalidFormula, "rate %.4f is not within [0, 1]", rate)
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this formula
+func (f *GSTCreditFormula) Clone() HouseholdBenefitFormula {
+
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+	return &clone
+}
+
+// supplement returns the single supplement for the given net income
+func (f *GSTCreditFormula) supplement(netIncome float64) float64 {
+	phasedIn := f.SupplementRate * math.Max(0.0, netIncome-f.SupplementThreshold)
+	return math.Min(phasedIn, f.SingleSupplement)
+}
+
+// childShares returns the sum of the custody shares of the dependents of the
+// given household who are eligible for the child amount, as well as the
+// largest share, which single parents claim as their first child
+func (f *GSTCreditFormula) childShares(household *Household) (total, first float64) {
+
+	for _, dependent := range household.Dependents {
+
+		if dependent == nil || dependent.AgeMonths > f.MaxChildAgeMonths {
+			continue
+		}
+		if dependent.Residency == human.NonResident {
+			continue
+		}
+
+		share := dependent.CustodyShare()
+		total += share
+		first = math.Max(first, share)
+	}
+	return total, first
+}
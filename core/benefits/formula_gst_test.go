@@ -0,0 +1,186 @@
+package benefits
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+func testGSTCreditFormula() *GSTCreditFormula {
+	return &GSTCreditFormula{
+		AdultAmount:           300,
+		ChildAmount:           150,
+		MaxChildAgeMonths:     19*12 - 1,
+		SingleSupplement:      150,
+		SupplementRate:        0.02,
+		SupplementThreshold:   10000,
+		ReductionRate:         0.05,
+		ReductionThreshold:    40000,
+		SmallPaymentThreshold: 200,
+	}
+}
+
+func TestGSTCreditFormula_Apply(t *testing.T) {
+
+	single := &testHouseholdFinances{onSpouseA: &testFinancer{}}
+	couple := &testHouseholdFinances{onSpouseA: &testFinancer{}, onSpouseB: &testFinancer{}}
+	children := []*human.Person{{AgeMonths: 12}, nil, {AgeMonths: 18 * 12}, {AgeMonths: 19 * 12}}
+
+	cases := []struct {
+		name      string
+		household *Household
+		expected  float64
+	}{
+		{
+			name:      "nil",
+			household: nil,
+			expected:  0.0,
+		},
+		{
+			name:      "single-no-supplement",
+			household: &Household{Finances: single, NetIncomeA: 8000},
+			expected:  300,
+		},
+		{
+			name:      "single-partial-supplement",
+			household: &Household{Finances: single, NetIncomeA: 13000},
+			expected:  300 + 60,
+		},
+		{
+			name:      "single-full-supplement",
+			household: &Household{Finances: single, NetIncomeA: 30000},
+			expected:  300 + 150,
+		},
+		{
+			name:      "single-reduced",
+			household: &Household{Finances: single, NetIncomeA: 45000},
+			expected:  300 + 150 - 250,
+		},
+		{
+			name:      "single-parent",
+			household: &Household{Finances: single, NetIncomeA: 5000, Dependents: children},
+			expected:  300 + 300 + 150 + 150,
+		},
+		{
+			name:      "couple",
+			household: &Household{Finances: couple, NetIncomeA: 5000, NetIncomeB: 5000, Dependents: children},
+			expected:  300 + 300 + 2*150,
+		},
+		{
+			name:      "single-parent-shared-custody",
+			household: &Household{Finances: single, NetIncomeA: 13000, Dependents: []*human.Person{{CustodyPercent: 50}}},
+			expected:  300 + 0.5*(300+150) + 0.5*60,
+		},
+		{
+			name:      "couple-shared-custody",
+			household: &Household{Finances: couple, Dependents: []*human.Person{{CustodyPercent: 50}, {}}},
+			expected:  300 + 300 + 1.5*150,
+		},
+		{
+			name:      "couple-non-resident-child",
+			household: &Household{Finances: couple, Dependents: []*human.Person{{Residency: human.NonResident}}},
+			expected:  300 + 300,
+		},
+		{
+			name:      "couple-reduced",
+			household: &Household{Finances: couple, NetIncomeA: 30000, NetIncomeB: 20000},
+			expected:  300 + 300 - 500,
+		},
+		{
+			name:      "couple-fully-reduced",
+			household: &Household{Finances: couple, NetIncomeA: 60000, NetIncomeB: 20000},
+			expected:  0.0,
+		},
+	}
+
+	formula := testGSTCreditFormula()
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := formula.Apply(c.household)
+			if math.Abs(actual-c.expected) > 1e-9 {
+				t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGSTCreditFormula_Schedule(t *testing.T) {
+
+	formula := testGSTCreditFormula()
+
+	actual := formula.Schedule(400)
+	expected := core.PaymentSchedule{100, 0, 0, 100, 0, 0, 100, 0, 0, 100, 0, 0}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+
+	actual = formula.Schedule(150)
+	expected = core.PaymentSchedule{150}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+
+	actual = formula.Schedule(0)
+	expected = core.PaymentSchedule{}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+}
+
+func TestGSTCreditFormula_Validate(t *testing.T) {
+
+	err := testGSTCreditFormula().Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*GSTCreditFormula)
+	}{
+		{name: "negative-amount", modify: func(f *GSTCreditFormula) { f.ChildAmount = -1 }},
+		{name: "negative-threshold", modify: func(f *GSTCreditFormula) { f.ReductionThreshold = -1 }},
+		{name: "negative-rate", modify: func(f *GSTCreditFormula) { f.SupplementRate = -0.02 }},
+		{name: "rate-above-one", modify: func(f *GSTCreditFormula) { f.ReductionRate = 5 }},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			formula := testGSTCreditFormula()
+			c.modify(formula)
+			err := formula.Validate()
+			if errors.Cause(err) != ErrInvalidFormula {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+			}
+		})
+	}
+}
+
+func TestGSTCreditFormula_Clone(t *testing.T) {
+
+	original := testGSTCreditFormula()
+	clone := original.Clone()
+	if *clone.(*GSTCreditFormula) != *original {
+		t.Fatal("clone does not match the original")
+	}
+
+	original.AdultAmount = 1
+	if clone.(*GSTCreditFormula).AdultAmount == 1 {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
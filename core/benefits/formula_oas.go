@@ -0,0 +1,174 @@
+package benefits
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ PensionFormula = (*OASFormula)(nil)
+
+// OASAgeGroup represents the full monthly pension of pensioners whose age is
+// within an age group
+type OASAgeGroup struct {
+	// the age group in months (bound-inclusive)
+	AgesMonths human.AgeRange
+	// the full monthly pension of the age group
+	AmountPerMonth float64
+}
+
+// OASFormula computes the Old Age Security pension, which is a monthly pension
+// paid to seniors according to their age. The pension is increased for each
+// month the pensioner deferred its start, and it is reduced for pensioners who
+// resided in Canada for less than the years required for a full pension. The
+// pension of pensioners with a net income above a threshold is recovered
+// through a recovery tax, which is also known as the OAS clawback
+type OASFormula struct {
+	// AgeGroups are the full monthly pension amounts for age groups, e.g. 65
+	// to 74 and 75 or older
+	AgeGroups []OASAgeGroup
+	// EligibilityAgeMonths is the earliest age at which the pension may start
+	EligibilityAgeMonths uint
+	// DeferralRate is the increase of the pension for each month of deferral
+	DeferralRate float64
+	// MaxDeferralMonths is the maximum number of months of deferral which
+	// increase the pension
+	MaxDeferralMonths uint
+	// FullResidenceYears is the number of years of residence in Canada after
+	// the age of 18 that is required for a full pension
+	FullResidenceYears uint
+	// MinResidenceYears is the number of years of residence in Canada after
+	// the age of 18 that is required for a partial pension
+	MinResidenceYears uint
+	// RecoveryRate is the percentage of net income above the recovery
+	// threshold that is recovered from the pension
+	RecoveryRate float64
+	// RecoveryThreshold is the net income above which the pension is recovered
+	RecoveryThreshold float64
+}
+
+// Apply returns the annual pension of the given pensioner for the 12 months
+// that start at the pensioner's age. If the pensioner is nil, it returns zero
+func (f *OASFormula) Apply(pensioner *human.Pensioner) float64 {
+
+	if pensioner == nil {
+		return 0.0
+	}
+
+	residency := f.residencyFraction(pensioner.ResidenceYears)
+	if residency == 0.0 {
+		return 0.0
+	}
+
+	deferral := pensioner.DeferralMonths
+	if deferral > f.MaxDeferralMonths {
+		deferral = f.MaxDeferralMonths
+	}
+	enhancement := 1.0 + f.DeferralRate*float64(deferral)
+	startAge := f.EligibilityAgeMonths + pensioner.DeferralMonths
+
+	var pension float64
+	for month := uint(0); month < 12; month++ {
+
+		age := pensioner.AgeMonths + month
+		if age < startAge {
+			continue
+		}
+
+		for _, group := range f.AgeGroups {
+			if age >= group.AgesMonths.Min() && age <= group.AgesMonths.Max() {
+				pension += group.AmountPerMonth
+			}
+		}
+	}
+
+	return pension * enhancement * residency
+}
+
+// RecoveryAdjuster returns the tax adjuster that recovers the pension, which
+// is recorded under 'core.IncSrcOAS', from pensioners with high net income
+func (f *OASFormula) RecoveryAdjuster() tax.RecoveryTaxAdjuster {
+	return tax.RecoveryTaxAdjuster{
+		Rate:          f.RecoveryRate,
+		Threshold:     f.RecoveryThreshold,
+		BenefitSource: core.IncSrcOAS,
+		Desc:          "OAS recovery tax",
+	}
+}
+
+// Validate checks if the formula is valid for use
+func (f *OASFormula) Validate() error {
+
+	for i, group := range f.AgeGroups {
+
+		err := group.AgesMonths.Validate()
+		if err != nil {
+			return errors.Wrap(err, "invalid age group")
+		}
+
+		if group.AmountPerMonth < 0.0 {
+			return errors.Wrapf(ErrInvalidFormula, "negative pension amount: %.2f", group.AmountPerMonth)
+		}
+
+		for _, other := range f.AgeGroups[:i] {
+			if group.AgesMonths.Min() <= other.AgesMonths.Max() && other.AgesMonths.Min() <= group.AgesMonths.Max() {
+				return errors.Wrapf(
+					ErrInvalidFormula, "overlapping age groups: %v and %v",
+					other.AgesMonths, group.AgesMonths,
+				)
+			}
+		}
+	}
+
+	if f.DeferralRate < 0.0 {
+		return errors.Wrapf(ErrInvalidFormula, "negative deferral rate: %.4f", f.DeferralRate)
+	}
+
+	if f.FullResidenceYears == 0 || f.MinResidenceYears > f.FullResidenceYears {
+		return errors.Wrapf(
+			ErrInvalidFormula, "invalid residence years: [%d, %d]",
+			f.MinResidenceYears, f.FullResidenceYears,
+		)
+	}
+
+	err := f.RecoveryAdjuster().Validate()
+	if err != nil {
+		return errors.Wrapf(ErrInvalidFormula, "invalid recovery tax: %v", err)
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this formula
+func (f *OASFormula) Clone() PensionFormula {
+
+	if f == nil {
+		return nil
+	}
+
+	clone := *f
+	if f.AgeGroups != nil {
+		clone.AgeGroups = make([]OASAgeGroup, len(f.AgeGroups))
+		copy(clone.AgeGroups, f.AgeGroups)
+	}
+
+	return &clone
+}
+
+// residencyFraction returns the fraction of the full pension that is paid for
+// the given years of residence
+func (f *OASFormula) residencyFraction(years uint) float64 {
+
+	if years < f.MinResidenceYears {
+		return 0.0
+	}
+
+	if years > f.FullResidenceYears {
+		years = f.FullResidenceYears
+	}
+
+	return float64(years) / float64(f.FullResidenceYears)
+}
@@ -0,0 +1,209 @@
+package benefits
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func testOASFormula() *OASFormula {
+	return &OASFormula{
+		AgeGroups: []OASAgeGroup{
+			{AgesMonths: human.AgeRange{65 * 12, 75*12 - 1}, AmountPerMonth: 600},
+			{AgesMonths: human.AgeRange{75 * 12, math.MaxUint32}, AmountPerMonth: 660},
+		},
+		EligibilityAgeMonths: 65 * 12,
+		DeferralRate:         0.006,
+		MaxDeferralMonths:    60,
+		FullResidenceYears:   40,
+		MinResidenceYears:    10,
+		RecoveryRate:         0.15,
+		RecoveryThreshold:    80000,
+	}
+}
+
+func TestOASFormula_Apply(t *testing.T) {
+
+	cases := []struct {
+		name      string
+		pensioner *human.Pensioner
+		expected  float64
+	}{
+		{
+			name:      "nil",
+			pensioner: nil,
+			expected:  0.0,
+		},
+		{
+			name:      "too-young",
+			pensioner: &human.Pensioner{Person: human.Person{AgeMonths: 60 * 12}, ResidenceYears: 40},
+			expected:  0.0,
+		},
+		{
+			name:      "turns-65",
+			pensioner: &human.Pensioner{Person: human.Person{AgeMonths: 65*12 - 4}, ResidenceYears: 40},
+			expected:  8 * 600,
+		},
+		{
+			name:      "turns-75",
+			pensioner: &human.Pensioner{Person: human.Person{AgeMonths: 75*12 - 3}, ResidenceYears: 45},
+			expected:  3*600 + 9*660,
+		},
+		{
+			name: "deferred",
+			pensioner: &human.Pensioner{
+				Person:         human.Person{AgeMonths: 66 * 12},
+				DeferralMonths: 12,
+				ResidenceYears: 40,
+			},
+			expected: 12 * 600 * (1 + 12*0.006),
+		},
+		{
+			name: "deferred-beyond-max",
+			pensioner: &human.Pensioner{
+				Person:         human.Person{AgeMonths: 71 * 12},
+				DeferralMonths: 72,
+				ResidenceYears: 40,
+			},
+			expected: 12 * 600 * (1 + 60*0.006),
+		},
+		{
+			name:      "partial-residence",
+			pensioner: &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 20},
+			expected:  12 * 600 * 0.5,
+		},
+		{
+			name:      "insufficient-residence",
+			pensioner: &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 9},
+			expected:  0.0,
+		},
+	}
+
+	formula := testOASFormula()
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := formula.Apply(c.pensioner)
+			if math.Abs(actual-c.expected) > 1e-6 {
+				t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestOASFormula_RecoveryAdjuster(t *testing.T) {
+
+	formula := testOASFormula()
+
+	actual := formula.RecoveryAdjuster()
+	expected := tax.RecoveryTaxAdjuster{
+		Rate:          0.15,
+		Threshold:     80000,
+		BenefitSource: core.IncSrcOAS,
+		Desc:          "OAS recovery tax",
+	}
+	if diff := deep.Equal(actual, expected); diff != nil {
+		t.Error(diff)
+	}
+}
+
+func TestOASFormula_Validate(t *testing.T) {
+
+	err := testOASFormula().Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(*OASFormula)
+		err    error
+	}{
+		{
+			name:   "invalid-age-group",
+			modify: func(f *OASFormula) { f.AgeGroups[0].AgesMonths = human.AgeRange{10, 0} },
+			err:    human.ErrInvalidAgeRange,
+		},
+		{
+			name:   "negative-amount",
+			modify: func(f *OASFormula) { f.AgeGroups[1].AmountPerMonth = -1 },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name:   "negative-deferral-rate",
+			modify: func(f *OASFormula) { f.DeferralRate = -0.006 },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name:   "no-full-residence",
+			modify: func(f *OASFormula) { f.FullResidenceYears, f.MinResidenceYears = 0, 0 },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name:   "reversed-residence",
+			modify: func(f *OASFormula) { f.MinResidenceYears = 41 },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name: "overlapping-age-groups",
+			modify: func(f *OASFormula) {
+				f.AgeGroups[1].AgesMonths = human.AgeRange{74 * 12, math.MaxUint32}
+			},
+			err: ErrInvalidFormula,
+		},
+		{
+			name:   "duplicate-age-groups",
+			modify: func(f *OASFormula) { f.AgeGroups = append(f.AgeGroups, f.AgeGroups[0]) },
+			err:    ErrInvalidFormula,
+		},
+		{
+			name:   "recovery-rate",
+			modify: func(f *OASFormula) { f.RecoveryRate = 1.5 },
+			err:    ErrInvalidFormula,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			formula := testOASFormula()
+			c.modify(formula)
+			err := formula.Validate()
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestOASFormula_Clone(t *testing.T) {
+
+	original := testOASFormula()
+	clone := original.Clone()
+
+	diff := deep.Equal(original, clone)
+	if diff != nil {
+		t.Fatal("clone does not match the original\n", diff)
+	}
+
+	original.AgeGroups[0].AmountPerMonth = 1
+	diff = deep.Equal(original, clone)
+	if diff == nil {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
@@ -0,0 +1,137 @@
+package benefits
+
+import (
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ SingleParentFormula = (*ChildBenefitTransition)(nil)
+
+// ChildBenefitTransition computes child benefits for a benefit year in which
+// one child benefit replaces another, e.g. the BC Family Benefit replaced the
+// BC Early Childhood Tax Benefit in October 2020, which is in the middle of the
+// July to June benefit year. The annual benefits of each formula are prorated
+// by the months of the benefit year in which the formula applies
+type ChildBenefitTransition struct {
+	// Before is the formula that applies before the transition month
+	Before ChildBenefitFormula
+	// After is the formula that applies from the transition month onward
+	After ChildBenefitFormula
+	// TransitionMonth is the month of the benefit year, starting at zero, in
+	// which the after formula starts to apply
+	TransitionMonth uint
+}
+
+// Apply returns the total annual benefits for the children given the net income
+func (t *ChildBenefitTransition) Apply(netIncome float64, children ...*human.Person) float64 {
+	return t.prorate(
+		t.Before.Apply(netIncome, children...),
+		t.After.Apply(netIncome, children...),
+	)
+}
+
+// ApplySingleParent is like Apply, except that the benefits are for a
+// single-parent family. Formulas that provide no additional benefits for
+// single parents are applied as usual
+func (t *ChildBenefitTransition) ApplySingleParent(netIncome float64, children ...*human.Person) float64 {
+	return t.prorate(
+		applySingleParent(t.Before, netIncome, children),
+		applySingleParent(t.After, netIncome, children),
+	)
+}
+
+// ApplyMonth returns the total benefits for the children in the given month of
+// the benefit year given the net income, using the formula that applies in
+// that month
+func (t *ChildBenefitTransition) ApplyMonth(netIncome float64, month uint, children ...*human.Person) float64 {
+	return t.formula(month).ApplyMonth(netIncome, month, children...)
+}
+
+// ApplySingleParentMonth is like ApplyMonth, except that the benefits are for a
+// single-parent family
+func (t *ChildBenefitTransition) ApplySingleParentMonth(netIncome float64, month uint, children ...*human.Person) float64 {
+	return applySingleParentMonth(t.formula(month), netIncome, month, children)
+}
+
+// Validate ensures that this instance is valid for use. Users need to call this
+// method before use only if the instance was manually created/modified
+func (t *ChildBenefitTransition) Validate() error {
+
+	if t.Before == nil || t.After == nil {
+		return ErrNoFormula
+	}
+
+	if t.TransitionMonth > 12 {
+		return errors.Wrapf(ErrInvalidFormula, "transition month %d is not within [0, 12]", t.TransitionMonth)
+	}
+
+	err := t.Before.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula before transition")
+	}
+
+	err = t.After.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid formula after transition")
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this instance
+func (t *ChildBenefitTransition) Clone() ChildBenefitFormula {
+
+	if t == nil {
+		return nil
+	}
+
+	clone := &ChildBenefitTransition{TransitionMonth: t.TransitionMonth}
+	if t.Before != nil {
+		clone.Before = t.Before.Clone()
+	}
+	if t.After != nil {
+		clone.After = t.After.Clone()
+	}
+	return clone
+}
+
+// formula returns the formula that applies in the given month of the benefit
+// year
+func (t *ChildBenefitTransition) formula(month uint) ChildBenefitFormula {
+
+	if month < t.TransitionMonth {
+		return t.Before
+	}
+	return t.After
+}
+
+// prorate returns the sum of the given annual benefits, each prorated by the
+// months in which its formula applies
+func (t *ChildBenefitTransition) prorate(before, after float64) float64 {
+	monthsBefore := float64(t.TransitionMonth)
+	return (before*monthsBefore + after*(12.0-monthsBefore)) / 12.0
+}
+
+// applySingleParent applies the given formula for a single-parent family if it
+// provides additional benefits for single parents
+func applySingleParent(formula ChildBenefitFormula, netIncome float64, children []*human.Person) float64 {
+
+	singleParentFormula, ok := formula.(SingleParentFormula)
+	if ok {
+		return singleParentFormula.ApplySingleParent(netIncome, children...)
+	}
+	return formula.Apply(netIncome, children...)
+}
+
+// applySingleParentMonth is like applySingleParent, except that it applies the
+// given formula for the given month of the benefit year
+func applySingleParentMonth(formula ChildBenefitFormula, netIncome float64, month uint, children []*human.Person) float64 {
+
+	singleParentFormula, ok := formula.(SingleParentFormula)
+	if ok {
+		return singleParentFormula.ApplySingleParentMonth(netIncome, month, children...)
+	}
+	return formula.ApplyMonth(netIncome, month, children...)
+}
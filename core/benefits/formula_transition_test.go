@@ -0,0 +1,116 @@
+package benefits
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/pkg/errors"
+)
+
+func TestChildBenefitTransition_Apply(t *testing.T) {
+
+	transition := &ChildBenefitTransition{
+		Before:          testCBFormula{onApply: 1200},
+		After:           testSingleParentFormula{testCBFormula{onApply: 2400}, 3600},
+		TransitionMonth: 3,
+	}
+
+	actual := transition.Apply(50000)
+	expected := 1200.0*3/12 + 2400.0*9/12
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	actual = transition.ApplySingleParent(50000)
+	expected = 1200.0*3/12 + 3600.0*9/12
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
+
+func TestChildBenefitTransition_ApplyMonth(t *testing.T) {
+
+	transition := &ChildBenefitTransition{
+		Before:          testCBFormula{onApply: 1200},
+		After:           testSingleParentFormula{testCBFormula{onApply: 2400}, 3600},
+		TransitionMonth: 3,
+	}
+
+	cases := []struct {
+		month                uint
+		expected             float64
+		expectedSingleParent float64
+	}{
+		{month: 0, expected: 100, expectedSingleParent: 100},
+		{month: 2, expected: 100, expectedSingleParent: 100},
+		{month: 3, expected: 200, expectedSingleParent: 300},
+		{month: 11, expected: 200, expectedSingleParent: 300},
+	}
+
+	for i, c := range cases {
+		actual := transition.ApplyMonth(50000, c.month)
+		if actual != c.expected {
+			t.Errorf("case %d: unexpected results\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+		actual = transition.ApplySingleParentMonth(50000, c.month)
+		if actual != c.expectedSingleParent {
+			t.Errorf("case %d: unexpected results\nwant: %.2f\n got: %.2f", i, c.expectedSingleParent, actual)
+		}
+	}
+}
+
+func TestChildBenefitTransition_Validate(t *testing.T) {
+
+	transition := &ChildBenefitTransition{testCBFormula{}, testCBFormula{}, 3}
+	err := transition.Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = (&ChildBenefitTransition{testCBFormula{}, nil, 3}).Validate()
+	if errors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	err = (&ChildBenefitTransition{testCBFormula{}, testCBFormula{}, 13}).Validate()
+	if errors.Cause(err) != ErrInvalidFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFormula, err)
+	}
+
+	simulatedErr := errors.New("test error")
+	err = (&ChildBenefitTransition{testCBFormula{onValidate: simulatedErr}, testCBFormula{}, 3}).Validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+
+	err = (&ChildBenefitTransition{testCBFormula{}, testCBFormula{onValidate: simulatedErr}, 3}).Validate()
+	if errors.Cause(err) != simulatedErr {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", simulatedErr, err)
+	}
+}
+
+func TestChildBenefitTransition_Clone(t *testing.T) {
+
+	original := &ChildBenefitTransition{
+		Before:          testCBFormula{onApply: 1200},
+		After:           testBCFamilyBenefitFormula(),
+		TransitionMonth: 3,
+	}
+	clone := original.Clone()
+
+	diff := deep.Equal(original, clone)
+	if diff != nil {
+		t.Fatal("clone does not match the original\n", diff)
+	}
+
+	original.After.(*BCFamilyBenefitFormula).MaxAmounts[0] = 1
+	if diff := deep.Equal(original, clone); diff == nil {
+		t.Error("expected changes to the original to not affect the clone")
+	}
+
+	original = nil
+	clone = original.Clone()
+	if clone != nil {
+		t.Error("expected nil clone for a nil formula")
+	}
+}
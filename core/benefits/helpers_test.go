@@ -3,6 +3,7 @@ package benefits
 import (
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
 )
 
 type testIncomeCalculator struct {
@@ -31,9 +32,166 @@ type testCBFormula struct {
 func (tcb testCBFormula) Apply(_ float64, _ ...*human.Person) float64 {
 	return tcb.onApply
 }
+func (tcb testCBFormula) ApplyMonth(_ float64, _ uint, _ ...*human.Person) float64 {
+	return tcb.onApply / 12.0
+}
 func (tcb testCBFormula) Validate() error {
 	return tcb.onValidate
 }
 func (tcb testCBFormula) Clone() ChildBenefitFormula {
 	return tcb
 }
+
+type testHouseholdFinances struct {
+	onSpouseA core.FinanceMutator
+	onSpouseB core.FinanceMutator
+}
+
+func (thf *testHouseholdFinances) SpouseA() core.Financer {
+	if thf.onSpouseA == nil {
+		return nil
+	}
+	return thf.onSpouseA
+}
+func (thf *testHouseholdFinances) SpouseB() core.Financer {
+	if thf.onSpouseB == nil {
+		return nil
+	}
+	return thf.onSpouseB
+}
+func (thf *testHouseholdFinances) MutableSpouseA() core.FinanceMutator {
+	return thf.onSpouseA
+}
+func (thf *testHouseholdFinances) MutableSpouseB() core.FinanceMutator {
+	return thf.onSpouseB
+}
+func (thf *testHouseholdFinances) Clone() core.HouseholdFinanceMutator {
+	return thf
+}
+
+type testFinancer struct {
+	core.FinanceMutator
+	onTotalAmount float64
+}
+
+func (tf *testFinancer) TotalAmount(_ ...core.FinancialSource) float64 {
+	return tf.onTotalAmount
+}
+
+type testPensionFormula struct {
+	onApply    float64
+	onValidate error
+}
+
+func (tpf testPensionFormula) Apply(_ *human.Pensioner) float64 {
+	return tpf.onApply
+}
+func (tpf testPensionFormula) RecoveryAdjuster() tax.RecoveryTaxAdjuster {
+	return tax.RecoveryTaxAdjuster{Rate: 0.10, BenefitSource: core.IncSrcOAS}
+}
+func (tpf testPensionFormula) Validate() error {
+	return tpf.onValidate
+}
+func (tpf testPensionFormula) Clone() PensionFormula {
+	return tpf
+}
+
+type testSupplementFormula struct {
+	onValidate     error
+	incomeOnApply  []float64
+	spousesOnApply []*human.Pensioner
+}
+
+func (tsf *testSupplementFormula) Apply(income float64, pensioner, spouse *human.Pensioner) float64 {
+	if pensioner == nil {
+		return 0.0
+	}
+	tsf.incomeOnApply = append(tsf.incomeOnApply, income)
+	tsf.spousesOnApply = append(tsf.spousesOnApply, spouse)
+	return income / 10.0
+}
+func (tsf *testSupplementFormula) Validate() error {
+	return tsf.onValidate
+}
+func (tsf *testSupplementFormula) Clone() SupplementFormula {
+	return tsf
+}
+
+type testHouseholdBenefitFormula struct {
+	onValidate       error
+	householdOnApply *Household
+}
+
+func (thb *testHouseholdBenefitFormula) Apply(household *Household) float64 {
+	thb.householdOnApply = household
+	return household.NetIncomeA + household.NetIncomeB
+}
+func (thb *testHouseholdBenefitFormula) Validate() error {
+	return thb.onValidate
+}
+func (thb *testHouseholdBenefitFormula) Clone() HouseholdBenefitFormula {
+	return thb
+}
+
+type testWorkersBenefitFormula struct {
+	onValidate     error
+	earnersOnApply [2]*Earner
+}
+
+func (twb *testWorkersBenefitFormula) Apply(spouseA, spouseB *Earner) (float64, float64) {
+	twb.earnersOnApply = [2]*Earner{spouseA, spouseB}
+	return spouseA.WorkingIncome / 10.0, spouseA.NetIncome / 10.0
+}
+func (twb *testWorkersBenefitFormula) Validate() error {
+	return twb.onValidate
+}
+func (twb *testWorkersBenefitFormula) Clone() WorkersBenefitFormula {
+	return twb
+}
+
+type testSingleParentFormula struct {
+	testCBFormula
+	onApplySingleParent float64
+}
+
+func (tsp testSingleParentFormula) ApplySingleParent(_ float64, _ ...*human.Person) float64 {
+	return tsp.onApplySingleParent
+}
+func (tsp testSingleParentFormula) ApplySingleParentMonth(_ float64, _ uint, _ ...*human.Person) float64 {
+	return tsp.onApplySingleParent / 12.0
+}
+func (tsp testSingleParentFormula) Clone() ChildBenefitFormula {
+	return tsp
+}
+
+type testChildBenefitCalculator struct {
+	onMonthlyBenefitRecievable float64
+	financesOnMonth            []core.HouseholdFinances
+	childrenOnMonth            [][]*human.Person
+	monthsOnMonth              []uint
+	finances                   core.HouseholdFinances
+	children                   []*human.Person
+}
+
+func (tcb *testChildBenefitCalculator) BenefitRecievable() float64 {
+	return tcb.onMonthlyBenefitRecievable * 12.0
+}
+func (tcb *testChildBenefitCalculator) MonthlyBenefitRecievable(month uint) float64 {
+	tcb.financesOnMonth = append(tcb.financesOnMonth, tcb.finances)
+	tcb.childrenOnMonth = append(tcb.childrenOnMonth, tcb.children)
+	tcb.monthsOnMonth = append(tcb.monthsOnMonth, month)
+	return tcb.onMonthlyBenefitRecievable
+}
+func (tcb *testChildBenefitCalculator) PaymentSchedule() core.PaymentSchedule {
+	var schedule core.PaymentSchedule
+	for month := range schedule {
+		schedule[month] = tcb.onMonthlyBenefitRecievable
+	}
+	return schedule
+}
+func (tcb *testChildBenefitCalculator) SetFinances(f core.HouseholdFinances) {
+	tcb.finances = f
+}
+func (tcb *testChildBenefitCalculator) SetBeneficiaries(children []*human.Person) {
+	tcb.children = children
+}
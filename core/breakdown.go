@@ -0,0 +1,45 @@
+package core
+
+// TaxBreakdown itemizes the payable tax of a single tax payer for a single tax
+// region and year
+type TaxBreakdown struct {
+	// Year is the tax year the breakdown was calculated for
+	Year uint
+	// Region is the tax region the breakdown was calculated for
+	Region Region
+	// NetIncome is the net income for tax purposes
+	NetIncome float64
+	// Brackets is the net income sliced into the brackets of the tax formula
+	Brackets []BracketSlice
+	// GrossTax is the tax on the net income before applying any tax credits
+	GrossTax float64
+	// CreditsApplied is the total amount of tax credits used to reduce the
+	// gross tax
+	CreditsApplied float64
+	// Adjustments are the post-credit adjustments of the payable tax, e.g.
+	// surtaxes, premiums, and tax reductions, in the order they were applied
+	Adjustments []TaxAdjustment
+	// NetPayable is the payable tax after applying tax credits and adjustments
+	NetPayable float64
+}
+
+// TaxAdjustment is an amount added to the payable tax after applying tax credits
+type TaxAdjustment struct {
+	// Description is a short description of the reason of the adjustment
+	Description string
+	// Amount is the amount added to the payable tax. A negative amount reduces
+	// the payable tax
+	Amount float64
+}
+
+// TaxRates holds the marginal and average tax rates of a single tax payer
+type TaxRates struct {
+	// Statutory is the marginal rate of the tax brackets at the net income
+	Statutory float64
+	// Effective maps financial sources to the effective marginal tax rate of
+	// each source, which is the change in the household's payable tax due to
+	// an extra dollar from that source after adjustments and tax credits
+	Effective map[FinancialSource]float64
+	// Average is the net payable tax divided by the total income
+	Average float64
+}
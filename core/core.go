@@ -24,6 +24,15 @@ type ChildBenefitCalculator interface {
 	// BenefitRecievable returns the recievable amount of child benefits for the
 	// given finances and the children set in the calculator
 	BenefitRecievable() float64
+	// MonthlyBenefitRecievable returns the recievable amount of child benefits
+	// in the given month of the benefit year, starting at zero for July, where
+	// the children set in the calculator are of their ages in July and are aged
+	// like in PaymentSchedule()
+	MonthlyBenefitRecievable(month uint) float64
+	// PaymentSchedule returns the payments of child benefits in each month of
+	// the benefit year, where the children set in the calculator are of their
+	// ages in July
+	PaymentSchedule() PaymentSchedule
 	// SetFinances makes subsequent calculations based on the given finances
 	SetFinances(HouseholdFinances)
 	// SetBeneficiaries sets the children which the calculator will compute the
@@ -31,6 +40,86 @@ type ChildBenefitCalculator interface {
 	SetBeneficiaries([]*human.Person)
 }
 
+// CalendarChildBenefitCalculator is used to calculate the child benefits paid
+// in each month of a calendar year. Since benefit years run from July to June,
+// the payments of a calendar year span two benefit years, each of which is
+// based on the finances of the calendar year before it starts
+type CalendarChildBenefitCalculator interface {
+	// MonthlyBenefits returns the recievable amount of child benefits in each
+	// month of the calendar year, starting in January
+	MonthlyBenefits() [12]float64
+	// SetFinances makes subsequent calculations based on the given finances,
+	// where the previous base finances are of two years before the calendar
+	// year and the current base finances are of the year before
+	SetFinances(previousBase, currentBase HouseholdFinances)
+	// SetBeneficiaries sets the children which the calculator will compute the
+	// benefits for, where the children are of their ages in January
+	SetBeneficiaries([]*human.Person)
+}
+
+// BenefitCalculator is used to calculate recievable benefits for households,
+// where the benefits depend on the finances of the household members and
+// their dependents, e.g. the GST/HST credit
+type BenefitCalculator interface {
+	// BenefitRecievable returns the recievable amount of benefits for the
+	// given finances and the dependents set in the calculator
+	BenefitRecievable() float64
+	// PaymentSchedule returns the payments of the recievable benefits in each
+	// month of the benefit year
+	PaymentSchedule() PaymentSchedule
+	// SetFinances makes subsequent calculations based on the given finances
+	SetFinances(HouseholdFinances)
+	// SetDependents sets the dependents which the calculator might use for
+	// benefit-related calculations
+	SetDependents([]*human.Person)
+}
+
+// OASCalculator is used to calculate the Old Age Security (OAS) pension of
+// seniors and the recovery tax on the pension of seniors with high income
+type OASCalculator interface {
+	// PensionRecievable returns the annual pension of each spouse set as a
+	// pensioner in the calculator. The pension should be recorded in the
+	// finances of each spouse under 'IncSrcOAS'
+	PensionRecievable() (spouseA, spouseB float64)
+	// RecoveryTax returns the recovery tax of each spouse for the given
+	// finances, which is capped at the pension recorded in their finances
+	RecoveryTax() (spouseA, spouseB float64)
+	// SetFinances makes subsequent calculations based on the given finances
+	SetFinances(HouseholdFinances)
+	// SetPensioners sets the spouses which the calculator will compute the
+	// pension for, where a nil pensioner recieves no pension
+	SetPensioners(spouseA, spouseB *human.Pensioner)
+}
+
+// GISCalculator is used to calculate the Guaranteed Income Supplement (GIS) of
+// low-income seniors who recieve the OAS pension and the Allowance of their
+// spouses who are too young for the OAS pension
+type GISCalculator interface {
+	// BenefitRecievable returns the annual supplement of each spouse set as a
+	// pensioner in the calculator for the given finances
+	BenefitRecievable() (spouseA, spouseB float64)
+	// SetFinances makes subsequent calculations based on the given finances
+	SetFinances(HouseholdFinances)
+	// SetPensioners sets the spouses which the calculator will compute the
+	// supplement for, where a nil pensioner recieves no supplement
+	SetPensioners(spouseA, spouseB *human.Pensioner)
+}
+
+// CWBCalculator is used to calculate the Canada Workers Benefit (CWB) of low
+// income workers, including the disability supplement of workers who are
+// eligible for the disability tax credit
+type CWBCalculator interface {
+	// BenefitRecievable returns the annual benefit of each spouse for the
+	// given finances, where the basic amount of a family is recieved by the
+	// spouse designated to claim it
+	BenefitRecievable() (spouseA, spouseB float64)
+	// SetFinances makes subsequent calculations based on the given finances
+	SetFinances(HouseholdFinances)
+	// SetDisabilities sets whether each spouse is eligible for the disability
+	// supplement in subsequent calls to BenefitRecievable()
+	SetDisabilities(spouseA, spouseB bool)
+}
+
 // RRSPCalculator is used to calculate recievable or payable tax on transactions
 // related to Registered Retirement Saving Plan (RRSP) accounts
 type RRSPCalculator interface {
@@ -56,12 +145,30 @@ type RRSPCalculator interface {
 	SetTargetSpouseB()
 }
 
+// PayrollCalculator is used to calculate employee payroll contributions, e.g.
+// pension plan contributions and employment insurance premiums, on earnings
+type PayrollCalculator interface {
+	// Contributions returns the payroll contributions for the set finances,
+	// where the amounts are keyed by the financial source which they should
+	// be recorded under in the finances of each spouse
+	Contributions() (spouseA, spouseB map[FinancialSource]float64)
+	// SetFinances makes subsequent calculations based on the given finances
+	SetFinances(HouseholdFinances)
+}
+
 // TaxCalculator is used to calculate payable tax on earnings
 type TaxCalculator interface {
 	// TaxPayable returns the payable amount of tax for the set finances.
 	// The tax credit represent any amount owed to the tax payer without
 	// implications for how they might be used.
 	TaxPayable() (spouseA, spouseB float64, combinedCredits []TaxCredit)
+	// TaxBreakdown returns the itemized payable tax for the set finances, with
+	// one breakdown per tax region for each spouse. The breakdowns of a nil
+	// spouse are nil
+	TaxBreakdown() (spouseA, spouseB []TaxBreakdown, combinedCredits []TaxCredit)
+	// TaxRates returns the marginal and average tax rates for the set finances,
+	// where the effective marginal rates are computed for the given sources
+	TaxRates(sources ...FinancialSource) (spouseA, spouseB TaxRates)
 	// SetFinances stores the given financial data in the underlying tax
 	// calculator. Subsequent calls to other functions are based on the
 	// the given finances. Changes to the given finances after calling
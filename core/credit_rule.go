@@ -0,0 +1,70 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// creditRuleTypeNames holds the stable names of the recognized credit rule
+// types, which are used when rule types are encoded as text. Names must never
+// change once added
+var creditRuleTypeNames = map[CreditRuleType]string{
+	CrRuleTypeCashable:        "cashable",
+	CrRuleTypeCanCarryForward: "can-carry-forward",
+	CrRuleTypeNotCarryForward: "not-carry-forward",
+}
+
+// creditRuleTypesByName is the reverse of creditRuleTypeNames
+var creditRuleTypesByName = make(map[string]CreditRuleType)
+
+func init() {
+	for ruleType, name := range creditRuleTypeNames {
+		creditRuleTypesByName[name] = ruleType
+	}
+}
+
+// ParseCreditRuleType returns the credit rule type with the given name. If no
+// rule type has the given name, it returns ErrUnknownCreditRuleType
+func ParseCreditRuleType(name string) (CreditRuleType, error) {
+
+	ruleType, ok := creditRuleTypesByName[name]
+	if !ok {
+		return 0, errors.Wrapf(ErrUnknownCreditRuleType, "%q", name)
+	}
+	return ruleType, nil
+}
+
+// String returns the name of this rule type. Rule types without a name are
+// formatted as CreditRuleType(n)
+func (t CreditRuleType) String() string {
+
+	name, ok := creditRuleTypeNames[t]
+	if !ok {
+		return fmt.Sprintf("CreditRuleType(%d)", int(t))
+	}
+	return name
+}
+
+// MarshalText returns the name of this rule type. If this rule type has no
+// name, it returns ErrUnknownCreditRuleType
+func (t CreditRuleType) MarshalText() ([]byte, error) {
+
+	name, ok := creditRuleTypeNames[t]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownCreditRuleType, "CreditRuleType(%d)", int(t))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText sets this rule type to the rule type with the given name. If
+// no rule type has the given name, it returns ErrUnknownCreditRuleType
+func (t *CreditRuleType) UnmarshalText(text []byte) error {
+
+	ruleType, err := ParseCreditRuleType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = ruleType
+	return nil
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestCreditRuleType_MarshalText(t *testing.T) {
+
+	for _, ruleType := range []CreditRuleType{
+		CrRuleTypeCashable, CrRuleTypeCanCarryForward, CrRuleTypeNotCarryForward,
+	} {
+
+		text, err := ruleType.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var actual CreditRuleType
+		err = actual.UnmarshalText(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != ruleType {
+			t.Errorf("%s: actual '%d' does not match expected '%d'", text, actual, ruleType)
+		}
+		if ruleType.String() != string(text) {
+			t.Errorf("unexpected string\nwant: %q\n got: %q", text, ruleType.String())
+		}
+	}
+}
+
+func TestCreditRuleType_MarshalText_Errors(t *testing.T) {
+
+	_, err := CreditRuleType(0).MarshalText()
+	if errors.Cause(err) != ErrUnknownCreditRuleType {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownCreditRuleType, err)
+	}
+
+	if CreditRuleType(0).String() != "CreditRuleType(0)" {
+		t.Errorf("unexpected string for unnamed rule type: %q", CreditRuleType(0).String())
+	}
+
+	ruleType := CrRuleTypeCashable
+	err = ruleType.UnmarshalText([]byte("magic"))
+	if errors.Cause(err) != ErrUnknownCreditRuleType {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownCreditRuleType, err)
+	}
+	if ruleType != CrRuleTypeCashable {
+		t.Error("expected rule type not to change on errors")
+	}
+}
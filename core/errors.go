@@ -10,4 +10,7 @@ var (
 	ErrValInfNeg      = errors.New("negative infinity value is not allowed")
 	ErrValInfPos      = errors.New("positive infinity value is not allowed")
 	ErrBoundsReversed = errors.New("lower-bound is greater than upper-bound")
+	ErrUnknownSource  = errors.New("unknown financial source")
+
+	ErrUnknownCreditRuleType = errors.New("unknown credit rule type")
 )
@@ -1,6 +1,8 @@
 package finance
 
 import (
+	"encoding/json"
+
 	"github.com/malkhamis/quantax/core"
 )
 
@@ -83,3 +85,30 @@ func (hf *HouseholdFinances) clone() *HouseholdFinances {
 
 	return clone
 }
+
+// householdFinancesJSON is the JSON encoding of household finances
+type householdFinancesJSON struct {
+	SpouseA *IndividualFinances `json:"spouse_a,omitempty"`
+	SpouseB *IndividualFinances `json:"spouse_b,omitempty"`
+}
+
+// MarshalJSON encodes this instance as a JSON object with the finances of
+// each spouse. See IndividualFinances.MarshalJSON for the encoding of each
+// spouse. Nil spouses are omitted
+func (hf *HouseholdFinances) MarshalJSON() ([]byte, error) {
+	return json.Marshal(householdFinancesJSON{SpouseA: hf.spouseA, SpouseB: hf.spouseB})
+}
+
+// UnmarshalJSON replaces the finances of the spouses in this instance with the
+// ones in the given JSON object. Spouses that are missing or null are set to nil
+func (hf *HouseholdFinances) UnmarshalJSON(data []byte) error {
+
+	var decoded householdFinancesJSON
+	err := json.Unmarshal(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	hf.spouseA, hf.spouseB = decoded.SpouseA, decoded.SpouseB
+	return nil
+}
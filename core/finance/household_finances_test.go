@@ -1,12 +1,15 @@
 package finance
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
 	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
 )
 
 func TestNewHouseholdFinances(t *testing.T) {
@@ -188,3 +191,67 @@ func TestHouseholdFinances_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestHouseholdFinances_JSON(t *testing.T) {
+
+	spouseA := NewIndividualFinances()
+	spouseA.SetAmount(core.IncSrcEarned, 1000)
+	spouseB := NewIndividualFinances()
+	spouseB.SetAmount(core.DeducSrcRRSP, 200)
+
+	cases := []struct {
+		name     string
+		original *HouseholdFinances
+		json     string
+	}{
+		{
+			name:     "couple",
+			original: NewHouseholdFinances(spouseA, spouseB),
+			json:     `{"spouse_a":{"earned":1000},"spouse_b":{"rrsp-contribution":200}}`,
+		},
+		{
+			name:     "single",
+			original: NewHouseholdFinances(spouseA, nil),
+			json:     `{"spouse_a":{"earned":1000}}`,
+		},
+		{
+			name:     "empty",
+			original: NewHouseholdFinances(nil, nil),
+			json:     `{}`,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			data, err := json.Marshal(c.original)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != c.json {
+				t.Errorf("unexpected encoding\nwant: %s\n got: %s", c.json, data)
+			}
+
+			actual := NewHouseholdFinances(NewIndividualFinances(), NewIndividualFinances())
+			err = json.Unmarshal(data, actual)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diff := deep.Equal(actual, c.original)
+			if diff != nil {
+				t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+			}
+		})
+	}
+}
+
+func TestHouseholdFinances_JSON_Errors(t *testing.T) {
+
+	f := &HouseholdFinances{}
+	err := json.Unmarshal([]byte(`{"spouse_b": {"lottery": 1}}`), f)
+	if errors.Cause(err) != core.ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrUnknownSource, err)
+	}
+}
@@ -1,6 +1,8 @@
 package finance
 
 import (
+	"encoding/json"
+
 	"github.com/malkhamis/quantax/core"
 )
 
@@ -193,6 +195,44 @@ func (f *IndividualFinances) clone() *IndividualFinances {
 	return clone
 }
 
+// MarshalJSON encodes this instance as a JSON object of all amounts keyed by
+// the names of their sources, which is the same format as the amounts used to
+// construct finances by package factory. Sources without a name cannot be
+// encoded and cause an error that wraps core.ErrUnknownSource
+func (f *IndividualFinances) MarshalJSON() ([]byte, error) {
+
+	amounts := make(map[string]float64)
+	for _, m := range []map[core.FinancialSource]float64{f.income, f.deductions, f.miscAmounts} {
+		for src, amount := range m {
+			name, err := src.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			amounts[string(name)] = amount
+		}
+	}
+
+	return json.Marshal(amounts)
+}
+
+// UnmarshalJSON replaces the amounts in this instance with the amounts in the
+// given JSON object, which are keyed by the names of their sources. Unknown
+// names cause an error that wraps core.ErrUnknownSource
+func (f *IndividualFinances) UnmarshalJSON(data []byte) error {
+
+	var amounts map[core.FinancialSource]float64
+	err := json.Unmarshal(data, &amounts)
+	if err != nil {
+		return err
+	}
+
+	*f = *NewIndividualFinances()
+	for src, amount := range amounts {
+		f.SetAmount(src, amount)
+	}
+	return nil
+}
+
 // amountBySource is a helper type used to encapsulate the logic of map cloning
 type amountBySource map[core.FinancialSource]float64
 
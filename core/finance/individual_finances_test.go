@@ -1,6 +1,7 @@
 package finance
 
 import (
+	"encoding/json"
 	"reflect"
 	"sort"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/go-test/deep"
 	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
 )
 
 func TestNewIndividualFinances(t *testing.T) {
@@ -277,3 +279,59 @@ func TestIndividualFinances_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestIndividualFinances_JSON(t *testing.T) {
+
+	original := NewIndividualFinances()
+	original.SetAmount(core.IncSrcEarned, 1000)
+	original.SetAmount(core.DeducSrcRRSP, 200)
+	original.SetAmount(core.MiscSrcTuition, 30)
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedJSON := `{"earned":1000,"rrsp-contribution":200,"tuition":30}`
+	if string(data) != expectedJSON {
+		t.Errorf("unexpected encoding\nwant: %s\n got: %s", expectedJSON, data)
+	}
+
+	actual := NewIndividualFinances()
+	actual.SetAmount(core.IncSrcInterest, 1)
+	err = json.Unmarshal(data, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(actual, original)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestIndividualFinances_JSON_Errors(t *testing.T) {
+
+	f := NewIndividualFinances()
+	f.SetAmount(core.FinancialSource(-1), 1000)
+
+	_, err := f.MarshalJSON()
+	if errors.Cause(err) != core.ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrUnknownSource, err)
+	}
+
+	_, err = json.Marshal(f)
+	if err == nil {
+		t.Error("expected an error when encoding unknown sources")
+	}
+
+	err = json.Unmarshal([]byte(`{"lottery": 1000}`), f)
+	if errors.Cause(err) != core.ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrUnknownSource, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"earned": "1000"}`), f)
+	if err == nil {
+		t.Error("expected an error for non-numeric amounts")
+	}
+}
@@ -0,0 +1,24 @@
+package human
+
+import "time"
+
+// MonthsInYear is the number of months in a year
+const MonthsInYear = 12
+
+// TaxYearStart returns the first day of the given tax year, which is the
+// reference date of ages for calculations that walk the months of the year
+func TaxYearStart(year uint) time.Time {
+	return time.Date(int(year), time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// TaxYearEnd returns the last day of the given tax year, which is the reference
+// date of ages for tax purposes
+func TaxYearEnd(year uint) time.Time {
+	return time.Date(int(year), time.December, 31, 0, 0, 0, 0, time.UTC)
+}
+
+// BenefitYearStart returns the first day of the benefit year that starts in
+// July of the given year
+func BenefitYearStart(year uint) time.Time {
+	return time.Date(int(year), time.July, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,24 @@
+package human
+
+import "time"
+
+// Pensioner represents a person who may recieve a public pension, e.g. the Old
+// Age Security pension
+type Pensioner struct {
+	Person
+	// DeferralMonths is the number of months the pensioner deferred the start
+	// of the pension past the age of eligibility
+	DeferralMonths uint
+	// ResidenceYears is the number of years the pensioner resided in Canada
+	// after the age of 18
+	ResidenceYears uint
+}
+
+// AtDate returns a copy of the pensioner whose AgeMonths is their age at the
+// given date. If the birth date is unknown, the age of the copy is unchanged
+func (p *Pensioner) AtDate(date time.Time) *Pensioner {
+
+	clone := *p
+	clone.Person = *p.Person.AtDate(date)
+	return &clone
+}
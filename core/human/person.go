@@ -1,7 +1,225 @@
 package human
 
+import (
+	"errors"
+	"time"
+)
+
+// Sentinel errors that can be wrapped and returned
+var (
+	ErrInvalidStudentStatus = errors.New("invalid student status")
+	ErrInvalidResidency     = errors.New("invalid residency")
+	ErrInvalidCustody       = errors.New("invalid custody percentage")
+)
+
+// the bounds of the percentage of time a child lives with a parent for the
+// custody to be considered shared (bound-inclusive)
+const (
+	MinSharedCustodyPercent = 40
+	MaxSharedCustodyPercent = 60
+)
+
+// SharedCustodyShare is the share of benefits a household recieves for a child
+// under shared custody
+const SharedCustodyShare = 0.5
+
+// DaysInYear is the number of days used to prorate amounts for part-year
+// residents
+const DaysInYear = 365
+
+// StudentStatus represents the enrolment of a person in post-secondary
+// education
+type StudentStatus int
+
+// student statuses
+const (
+	NotStudent StudentStatus = iota
+	PartTimeStudent
+	FullTimeStudent
+)
+
+// Residency represents the residency of a person in Canada for a year
+type Residency int
+
+// residencies
+const (
+	Resident Residency = iota
+	PartYearResident
+	NonResident
+)
+
+// the names of the student statuses and residencies, where the empty name is
+// the default
+var (
+	studentStatusNames = map[string]StudentStatus{
+		"":          NotStudent,
+		"part_time": PartTimeStudent,
+		"full_time": FullTimeStudent,
+	}
+	residencyNames = map[string]Residency{
+		"":             Resident,
+		"resident":     Resident,
+		"part_year":    PartYearResident,
+		"non_resident": NonResident,
+	}
+)
+
+// ParseStudentStatus returns the student status of the given name, which is
+// either empty, "part_time", or "full_time"
+func ParseStudentStatus(name string) (StudentStatus, error) {
+
+	status, ok := studentStatusNames[name]
+	if !ok {
+		return NotStudent, ErrInvalidStudentStatus
+	}
+	return status, nil
+}
+
+// ParseResidency returns the residency of the given name, which is either
+// empty, "resident", "part_year", or "non_resident"
+func ParseResidency(name string) (Residency, error) {
+
+	residency, ok := residencyNames[name]
+	if !ok {
+		return Resident, ErrInvalidResidency
+	}
+	return residency, nil
+}
+
 // Person represents a tax payer or beneficiary
 type Person struct {
-	Name      string
+	Name string
+	// AgeMonths is the age of the person in months, which is used if the
+	// birth date is unknown
 	AgeMonths uint
+	// BirthDate is the optional birth date of the person. If set, ages are
+	// computed from the birth date at the reference date of each calculation
+	// instead of using AgeMonths
+	BirthDate time.Time
+	// DisabilityEligible is true if the person is eligible for the disability
+	// tax credit
+	DisabilityEligible bool
+	// Student is the enrolment of the person in post-secondary education
+	Student StudentStatus
+	// Residency is the residency of the person in Canada, where persons are
+	// residents for the full year by default
+	Residency Residency
+	// DaysResident is the number of days a part-year resident resided in
+	// Canada in the year. It is only used for part-year residents
+	DaysResident uint
+	// CustodyPercent is the percentage of time a child under shared custody
+	// lives with the household, where zero means that the custody is not
+	// shared
+	CustodyPercent uint
+}
+
+// Validate ensures this instance is valid for the intended use. Users need to
+// call this method before use only if the instance was manually created/modified
+func (p *Person) Validate() error {
+
+	if p.Student < NotStudent || p.Student > FullTimeStudent {
+		return ErrInvalidStudentStatus
+	}
+
+	if p.Residency < Resident || p.Residency > NonResident {
+		return ErrInvalidResidency
+	}
+
+	if p.Residency == PartYearResident && p.DaysResident > DaysInYear+1 {
+		return ErrInvalidResidency
+	}
+
+	if p.CustodyPercent > 100 {
+		return ErrInvalidCustody
+	}
+
+	return nil
+}
+
+// ResidentShare returns the share of the year the person resided in Canada,
+// which is one for residents, zero for non-residents, and the share of days
+// resident for part-year residents
+func (p *Person) ResidentShare() float64 {
+
+	switch p.Residency {
+	case NonResident:
+		return 0.0
+	case PartYearResident:
+		if p.DaysResident >= DaysInYear {
+			return 1.0
+		}
+		return float64(p.DaysResident) / DaysInYear
+	default:
+		return 1.0
+	}
+}
+
+// CustodyShare returns the share of benefits the household recieves for the
+// person as a child. It is one if the custody is not shared or the child lives
+// with the household most of the time, one half if the custody is shared, and
+// zero if the child lives with the household less than the shared minimum
+func (p *Person) CustodyShare() float64 {
+
+	switch {
+	case p.CustodyPercent == 0 || p.CustodyPercent > MaxSharedCustodyPercent:
+		return 1.0
+	case p.CustodyPercent >= MinSharedCustodyPercent:
+		return SharedCustodyShare
+	default:
+		return 0.0
+	}
+}
+
+// HasBirthDate returns true if the birth date of the person is known
+func (p *Person) HasBirthDate() bool {
+	return !p.BirthDate.IsZero()
+}
+
+// AgeMonthsAt returns the age of the person in complete months at the given
+// date. If the birth date is unknown, it returns AgeMonths. If the given date
+// is before the birth date, it returns zero
+func (p *Person) AgeMonthsAt(date time.Time) uint {
+
+	if !p.HasBirthDate() {
+		return p.AgeMonths
+	}
+
+	if date.Before(p.BirthDate) {
+		return 0
+	}
+
+	birthYear, birthMonth, birthDay := p.BirthDate.Date()
+	year, month, day := date.Date()
+
+	months := (year-birthYear)*MonthsInYear + int(month-birthMonth)
+	if day < birthDay {
+		months--
+	}
+	return uint(months)
+}
+
+// AtDate returns a copy of the person whose AgeMonths is their age at the given
+// date. If the birth date is unknown, the age of the copy is unchanged
+func (p *Person) AtDate(date time.Time) *Person {
+
+	clone := *p
+	clone.AgeMonths = p.AgeMonthsAt(date)
+	return &clone
+}
+
+// PeopleAtDate returns copies of the given people whose ages are their ages at
+// the given date, where nil people remain nil
+func PeopleAtDate(people []*Person, date time.Time) []*Person {
+
+	if people == nil {
+		return nil
+	}
+
+	aged := make([]*Person, len(people))
+	for i, person := range people {
+		if person != nil {
+			aged[i] = person.AtDate(date)
+		}
+	}
+	return aged
 }
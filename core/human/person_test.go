@@ -0,0 +1,241 @@
+package human
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPerson_AgeMonthsAt(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		person   Person
+		date     time.Time
+		expected uint
+	}{
+		{
+			name:     "no-birth-date",
+			person:   Person{AgeMonths: 30},
+			date:     date(2024, time.July, 1),
+			expected: 30,
+		},
+		{
+			name:     "birthday",
+			person:   Person{AgeMonths: 30, BirthDate: date(2018, time.April, 15)},
+			date:     date(2024, time.April, 15),
+			expected: 72,
+		},
+		{
+			name:     "day-before-birthday",
+			person:   Person{BirthDate: date(2018, time.April, 15)},
+			date:     date(2024, time.April, 14),
+			expected: 71,
+		},
+		{
+			name:     "first-of-month-after-birthday",
+			person:   Person{BirthDate: date(2018, time.April, 15)},
+			date:     date(2024, time.May, 1),
+			expected: 72,
+		},
+		{
+			name:     "across-years",
+			person:   Person{BirthDate: date(2023, time.November, 30)},
+			date:     date(2024, time.January, 1),
+			expected: 1,
+		},
+		{
+			name:     "before-birth",
+			person:   Person{BirthDate: date(2024, time.November, 30)},
+			date:     date(2024, time.July, 1),
+			expected: 0,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := c.person.AgeMonthsAt(c.date)
+			if actual != c.expected {
+				t.Errorf("unexpected age\nwant: %d\n got: %d", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPerson_AtDate(t *testing.T) {
+
+	person := &Person{Name: "A", AgeMonths: 1, BirthDate: date(2020, time.January, 1)}
+
+	actual := person.AtDate(date(2021, time.January, 1))
+	if actual == person {
+		t.Fatal("expected a copy of the person")
+	}
+	if actual.Name != "A" || actual.AgeMonths != 12 || actual.BirthDate != person.BirthDate {
+		t.Errorf("unexpected person: %+v", actual)
+	}
+	if person.AgeMonths != 1 {
+		t.Error("expected the original person to be unchanged")
+	}
+}
+
+func TestPeopleAtDate(t *testing.T) {
+
+	if actual := PeopleAtDate(nil, date(2021, time.January, 1)); actual != nil {
+		t.Errorf("expected nil people, got: %v", actual)
+	}
+
+	people := []*Person{{AgeMonths: 5}, nil, {BirthDate: date(2020, time.July, 1)}}
+	actual := PeopleAtDate(people, date(2021, time.January, 1))
+	if len(actual) != len(people) {
+		t.Fatalf("expected %d people, got: %d", len(people), len(actual))
+	}
+	if actual[0].AgeMonths != 5 || actual[1] != nil || actual[2].AgeMonths != 6 {
+		t.Errorf("unexpected people: %+v, %+v, %+v", actual[0], actual[1], actual[2])
+	}
+}
+
+func TestPensioner_AtDate(t *testing.T) {
+
+	pensioner := &Pensioner{
+		Person:         Person{BirthDate: date(1959, time.March, 10)},
+		DeferralMonths: 6,
+	}
+
+	actual := pensioner.AtDate(TaxYearStart(2024))
+	if actual.AgeMonths != 64*12+9 || actual.DeferralMonths != 6 {
+		t.Errorf("unexpected pensioner: %+v", actual)
+	}
+	if pensioner.AgeMonths != 0 {
+		t.Error("expected the original pensioner to be unchanged")
+	}
+}
+
+func TestReferenceDates(t *testing.T) {
+
+	if actual := TaxYearStart(2024); actual != date(2024, time.January, 1) {
+		t.Errorf("unexpected date: %v", actual)
+	}
+	if actual := TaxYearEnd(2024); actual != date(2024, time.December, 31) {
+		t.Errorf("unexpected date: %v", actual)
+	}
+	if actual := BenefitYearStart(2024); actual != date(2024, time.July, 1) {
+		t.Errorf("unexpected date: %v", actual)
+	}
+}
+
+func TestPerson_Validate(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		person Person
+		err    error
+	}{
+		{
+			name:   "valid",
+			person: Person{Student: FullTimeStudent, Residency: PartYearResident, DaysResident: 366, CustodyPercent: 100},
+			err:    nil,
+		},
+		{
+			name:   "invalid-student-status",
+			person: Person{Student: FullTimeStudent + 1},
+			err:    ErrInvalidStudentStatus,
+		},
+		{
+			name:   "invalid-residency",
+			person: Person{Residency: -1},
+			err:    ErrInvalidResidency,
+		},
+		{
+			name:   "invalid-days-resident",
+			person: Person{Residency: PartYearResident, DaysResident: 367},
+			err:    ErrInvalidResidency,
+		},
+		{
+			name:   "invalid-custody",
+			person: Person{CustodyPercent: 101},
+			err:    ErrInvalidCustody,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			err := c.person.Validate()
+			if err != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestPerson_ResidentShare(t *testing.T) {
+
+	cases := []struct {
+		person   Person
+		expected float64
+	}{
+		{person: Person{}, expected: 1.0},
+		{person: Person{Residency: NonResident, DaysResident: 100}, expected: 0.0},
+		{person: Person{Residency: PartYearResident, DaysResident: 73}, expected: 0.2},
+		{person: Person{Residency: PartYearResident, DaysResident: 366}, expected: 1.0},
+	}
+
+	for i, c := range cases {
+		actual := c.person.ResidentShare()
+		if actual != c.expected {
+			t.Errorf("case %d: unexpected share\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+}
+
+func TestPerson_CustodyShare(t *testing.T) {
+
+	cases := []struct {
+		percent  uint
+		expected float64
+	}{
+		{percent: 0, expected: 1.0},
+		{percent: 39, expected: 0.0},
+		{percent: 40, expected: SharedCustodyShare},
+		{percent: 60, expected: SharedCustodyShare},
+		{percent: 61, expected: 1.0},
+	}
+
+	for i, c := range cases {
+		person := Person{CustodyPercent: c.percent}
+		actual := person.CustodyShare()
+		if actual != c.expected {
+			t.Errorf("case %d: unexpected share\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+}
+
+func TestParseStudentStatus_ParseResidency(t *testing.T) {
+
+	status, err := ParseStudentStatus("full_time")
+	if err != nil || status != FullTimeStudent {
+		t.Errorf("unexpected results: %v, %v", status, err)
+	}
+
+	_, err = ParseStudentStatus("sometimes")
+	if err != ErrInvalidStudentStatus {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidStudentStatus, err)
+	}
+
+	residency, err := ParseResidency("part_year")
+	if err != nil || residency != PartYearResident {
+		t.Errorf("unexpected results: %v, %v", residency, err)
+	}
+
+	_, err = ParseResidency("abroad")
+	if err != ErrInvalidResidency {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidResidency, err)
+	}
+}
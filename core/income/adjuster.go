@@ -1,8 +1,12 @@
 package income
 
+import "math"
+
 // compile-time check for interface implementatino
 var (
 	_ Adjuster = WeightedAdjuster(0.0)
+	_ Adjuster = CappedReductionAdjuster{}
+	_ Adjuster = ExemptionAdjuster{}
 )
 
 // Adjuster is a type that adjusts any given amount according to some logic
@@ -25,3 +29,65 @@ func (wa WeightedAdjuster) Adjusted(amount float64) float64 {
 func (wa WeightedAdjuster) Clone() Adjuster {
 	return wa
 }
+
+// CappedReductionAdjuster reduces a given amount by a percentage of itself,
+// where the reduction is capped by a maximum value, e.g. Quebec's deduction
+// for workers on employment income
+type CappedReductionAdjuster struct {
+	// the percentage of the amount to reduce
+	Rate float64
+	// the maximum reduction
+	Cap float64
+}
+
+// Adjusted returns the given amount less the capped reduction. If amount is
+// zero or less, it is returned unchanged
+func (cra CappedReductionAdjuster) Adjusted(amount float64) float64 {
+
+	if amount <= 0.0 {
+		return amount
+	}
+
+	reduction := cra.Rate * amount
+	if reduction > cra.Cap {
+		reduction = cra.Cap
+	}
+	return amount - reduction
+}
+
+// Clone returns a copy of this instance
+func (cra CappedReductionAdjuster) Clone() Adjuster {
+	return cra
+}
+
+// ExemptionAdjuster exempts a portion of a given amount in two tiers, where
+// the first tier is fully exempt and a percentage of the second tier is
+// exempt, e.g. the employment income exemption of the Guaranteed Income
+// Supplement
+type ExemptionAdjuster struct {
+	// the amount that is fully exempt
+	Exemption float64
+	// the amount above the full exemption that is partially exempt
+	PartialExemption float64
+	// the percentage of the partially exempt amount that is exempt
+	PartialRate float64
+}
+
+// Adjusted returns the given amount less the exempt portions. If amount is
+// zero or less, it is returned unchanged
+func (ea ExemptionAdjuster) Adjusted(amount float64) float64 {
+
+	if amount <= 0.0 {
+		return amount
+	}
+
+	exempt := math.Min(amount, ea.Exemption)
+	exempt += ea.PartialRate * math.Min(amount-exempt, ea.PartialExemption)
+
+	return amount - exempt
+}
+
+// Clone returns a copy of this instance
+func (ea ExemptionAdjuster) Clone() Adjuster {
+	return ea
+}
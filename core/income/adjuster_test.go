@@ -28,3 +28,66 @@ func TestCanadianCapitalGainAdjuster_Clone(t *testing.T) {
 		t.Fatal("expected changes to original to not affect clone")
 	}
 }
+
+func TestCappedReductionAdjuster_Adjusted(t *testing.T) {
+
+	cra := CappedReductionAdjuster{Rate: 0.06, Cap: 1200}
+
+	cases := []struct {
+		amount   float64
+		expected float64
+	}{
+		{amount: -100, expected: -100},
+		{amount: 0, expected: 0},
+		{amount: 10000, expected: 9400},
+		{amount: 50000, expected: 48800},
+	}
+
+	for i, c := range cases {
+		actual := cra.Adjusted(c.amount)
+		if actual != c.expected {
+			t.Errorf("case %d: unexpected result\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+}
+
+func TestCappedReductionAdjuster_Clone(t *testing.T) {
+
+	cra := CappedReductionAdjuster{Rate: 0.06, Cap: 1200}
+	clone := cra.Clone()
+	if clone != cra {
+		t.Fatalf("unexpected result\nwant: %v\n got: %v", cra, clone)
+	}
+}
+
+func TestExemptionAdjuster_Adjusted(t *testing.T) {
+
+	ea := ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5}
+
+	cases := []struct {
+		amount   float64
+		expected float64
+	}{
+		{amount: -100, expected: -100},
+		{amount: 0, expected: 0},
+		{amount: 3000, expected: 0},
+		{amount: 9000, expected: 2000},
+		{amount: 20000, expected: 10000},
+	}
+
+	for i, c := range cases {
+		actual := ea.Adjusted(c.amount)
+		if actual != c.expected {
+			t.Errorf("case %d: unexpected result\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+}
+
+func TestExemptionAdjuster_Clone(t *testing.T) {
+
+	ea := ExemptionAdjuster{Exemption: 3500}
+	clone := ea.Clone()
+	if clone != ea {
+		t.Fatalf("unexpected result\nwant: %v\n got: %v", ea, clone)
+	}
+}
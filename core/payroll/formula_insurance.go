@@ -0,0 +1,75 @@
+package payroll
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+)
+
+// compile-time check for interface implementation
+var _ Formula = (*InsuranceFormula)(nil)
+
+// InsuranceFormula computes the employee premiums of a public insurance plan
+// such as Employment Insurance (EI) or the Quebec Parental Insurance Plan
+// (QPIP). The premiums are a percentage of earnings up to a maximum amount of
+// insurable earnings
+type InsuranceFormula struct {
+	// the premium rate on insurable earnings
+	Rate float64
+	// the maximum insurable earnings
+	MaxInsurableEarnings float64
+	// sources that are subject to premiums
+	IncomeSources []core.FinancialSource
+	// the source to record the premiums under
+	PremiumSource core.FinancialSource
+}
+
+// Contributions returns the premiums for the given earnings. It is up to the
+// client to calculate the earnings appropriately by checking allowed income
+// sources through calling 'AllowedIncomeSources()'
+func (inf *InsuranceFormula) Contributions(earnings float64) map[core.FinancialSource]float64 {
+
+	insurable := math.Max(0.0, math.Min(earnings, inf.MaxInsurableEarnings))
+
+	return map[core.FinancialSource]float64{
+		inf.PremiumSource: inf.Rate * insurable,
+	}
+}
+
+// AllowedIncomeSources returns the sources which this formula expects as part
+// of the earnings when calculating the premiums
+func (inf *InsuranceFormula) AllowedIncomeSources() []core.FinancialSource {
+	return inf.IncomeSources
+}
+
+// Validate checks if the formula is valid for use
+func (inf *InsuranceFormula) Validate() error {
+
+	for _, amount := range []float64{inf.Rate, inf.MaxInsurableEarnings} {
+		if amount < 0 {
+			return core.ErrValNeg
+		}
+		if math.IsInf(amount, 0) {
+			return core.ErrValInf
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a copy of the formula
+func (inf *InsuranceFormula) Clone() Formula {
+
+	if inf == nil {
+		return nil
+	}
+
+	clone := *inf
+
+	if inf.IncomeSources != nil {
+		clone.IncomeSources = make([]core.FinancialSource, len(inf.IncomeSources))
+		copy(clone.IncomeSources, inf.IncomeSources)
+	}
+
+	return &clone
+}
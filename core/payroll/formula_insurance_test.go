@@ -0,0 +1,82 @@
+package payroll
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+func TestInsuranceFormula_Contributions(t *testing.T) {
+
+	formula := &InsuranceFormula{
+		Rate:                 0.0166,
+		MaxInsurableEarnings: 63200,
+		PremiumSource:        core.MiscSrcEIPremiums,
+	}
+
+	cases := []struct {
+		earnings float64
+		premiums float64
+	}{
+		{earnings: -100, premiums: 0},
+		{earnings: 0, premiums: 0},
+		{earnings: 10000, premiums: 166},
+		{earnings: 63200, premiums: 1049.12},
+		{earnings: 100000, premiums: 1049.12},
+	}
+
+	for i, c := range cases {
+		actual := formula.Contributions(c.earnings)[core.MiscSrcEIPremiums]
+		if math.Abs(actual-c.premiums) > 1e-6 {
+			t.Errorf("case %d: unexpected premiums\nwant: %.2f\n got: %.2f", i, c.premiums, actual)
+		}
+	}
+}
+
+func TestInsuranceFormula_Validate(t *testing.T) {
+
+	err := (&InsuranceFormula{Rate: 0.0166, MaxInsurableEarnings: 63200}).Validate()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = (&InsuranceFormula{Rate: -0.0166}).Validate()
+	if errors.Cause(err) != core.ErrValNeg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrValNeg, err)
+	}
+
+	err = (&InsuranceFormula{MaxInsurableEarnings: math.Inf(1)}).Validate()
+	if errors.Cause(err) != core.ErrValInf {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrValInf, err)
+	}
+}
+
+func TestInsuranceFormula_Clone(t *testing.T) {
+
+	var nilFormula *InsuranceFormula
+	if nilFormula.Clone() != nil {
+		t.Error("cloning a nil formula should return nil")
+	}
+
+	original := &InsuranceFormula{
+		Rate:                 0.0166,
+		MaxInsurableEarnings: 63200,
+		IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+		PremiumSource:        core.MiscSrcEIPremiums,
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Fatal("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	original.IncomeSources[0] = core.IncSrcInterest
+	if clone.AllowedIncomeSources()[0] != core.IncSrcEarned {
+		t.Error("expected changes to original formula to not affect clone formula")
+	}
+}
@@ -0,0 +1,119 @@
+package payroll
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ Formula = (*PensionPlanFormula)(nil)
+
+// PensionPlanFormula computes the employee contributions to a public pension
+// plan such as the Canada Pension Plan (CPP) or the Quebec Pension Plan (QPP).
+// Earnings between the basic exemption and the first ceiling are subject to
+// the base and first additional rates. Earnings between the first and second
+// ceilings are subject to the second additional rate. The base contributions
+// are recorded separately from the additional (enhanced) contributions since
+// they are treated differently for tax purposes
+type PensionPlanFormula struct {
+	// the earnings below which no contributions are made
+	BasicExemption float64
+	// the year's maximum pensionable earnings (first ceiling)
+	MaxPensionableEarnings float64
+	// the year's additional maximum pensionable earnings (second ceiling). If
+	// set to zero, no earnings are subject to the second additional rate
+	AdditionalMaxPensionableEarnings float64
+	// the rate of base contributions below the first ceiling
+	BaseRate float64
+	// the rate of additional contributions below the first ceiling
+	FirstAdditionalRate float64
+	// the rate of additional contributions between the two ceilings
+	SecondAdditionalRate float64
+	// sources that are subject to contributions
+	IncomeSources []core.FinancialSource
+	// the source to record the base contributions under
+	BaseContributionSource core.FinancialSource
+	// the source to record the additional contributions under
+	AdditionalContributionSource core.FinancialSource
+}
+
+// Contributions returns the base and additional contributions for the given
+// earnings. It is up to the client to calculate the earnings appropriately by
+// checking allowed income sources through calling 'AllowedIncomeSources()'
+func (ppf *PensionPlanFormula) Contributions(earnings float64) map[core.FinancialSource]float64 {
+
+	var firstTier, secondTier float64
+
+	if earnings > ppf.BasicExemption {
+		firstTier = math.Min(earnings, ppf.MaxPensionableEarnings) - ppf.BasicExemption
+	}
+
+	if earnings > ppf.MaxPensionableEarnings && ppf.AdditionalMaxPensionableEarnings > 0 {
+		secondTier = math.Min(earnings, ppf.AdditionalMaxPensionableEarnings) - ppf.MaxPensionableEarnings
+	}
+
+	contributions := make(map[core.FinancialSource]float64)
+	contributions[ppf.BaseContributionSource] += ppf.BaseRate * firstTier
+	contributions[ppf.AdditionalContributionSource] += ppf.FirstAdditionalRate * firstTier
+	contributions[ppf.AdditionalContributionSource] += ppf.SecondAdditionalRate * secondTier
+
+	return contributions
+}
+
+// AllowedIncomeSources returns the sources which this formula expects as part
+// of the earnings when calculating the contributions
+func (ppf *PensionPlanFormula) AllowedIncomeSources() []core.FinancialSource {
+	return ppf.IncomeSources
+}
+
+// Validate checks if the formula is valid for use
+func (ppf *PensionPlanFormula) Validate() error {
+
+	amounts := []float64{
+		ppf.BasicExemption,
+		ppf.MaxPensionableEarnings,
+		ppf.AdditionalMaxPensionableEarnings,
+		ppf.BaseRate,
+		ppf.FirstAdditionalRate,
+		ppf.SecondAdditionalRate,
+	}
+
+	for _, amount := range amounts {
+		if amount < 0 {
+			return core.ErrValNeg
+		}
+		if math.IsInf(amount, 0) {
+			return core.ErrValInf
+		}
+	}
+
+	if ppf.BasicExemption > ppf.MaxPensionableEarnings {
+		return errors.Wrap(core.ErrBoundsReversed, "basic exemption and first ceiling")
+	}
+
+	if ppf.AdditionalMaxPensionableEarnings > 0 &&
+		ppf.MaxPensionableEarnings > ppf.AdditionalMaxPensionableEarnings {
+		return errors.Wrap(core.ErrBoundsReversed, "first and second ceilings")
+	}
+
+	return nil
+}
+
+// Clone returns a copy of the formula
+func (ppf *PensionPlanFormula) Clone() Formula {
+
+	if ppf == nil {
+		return nil
+	}
+
+	clone := *ppf
+
+	if ppf.IncomeSources != nil {
+		clone.IncomeSources = make([]core.FinancialSource, len(ppf.IncomeSources))
+		copy(clone.IncomeSources, ppf.IncomeSources)
+	}
+
+	return &clone
+}
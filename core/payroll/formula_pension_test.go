@@ -0,0 +1,159 @@
+package payroll
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+func TestPensionPlanFormula_Contributions(t *testing.T) {
+
+	formula := &PensionPlanFormula{
+		BasicExemption:                   3500,
+		MaxPensionableEarnings:           68500,
+		AdditionalMaxPensionableEarnings: 73200,
+		BaseRate:                         0.0495,
+		FirstAdditionalRate:              0.0100,
+		SecondAdditionalRate:             0.0400,
+		BaseContributionSource:           core.MiscSrcCPPBase,
+		AdditionalContributionSource:     core.DeducSrcCPPEnhanced,
+	}
+
+	cases := []struct {
+		earnings float64
+		base     float64
+		enhanced float64
+	}{
+		{earnings: -1000, base: 0, enhanced: 0},
+		{earnings: 3500, base: 0, enhanced: 0},
+		{earnings: 13500, base: 495, enhanced: 100},
+		{earnings: 68500, base: 3217.50, enhanced: 650},
+		{earnings: 70500, base: 3217.50, enhanced: 730},
+		{earnings: 100000, base: 3217.50, enhanced: 838},
+	}
+
+	for i, c := range cases {
+		actual := formula.Contributions(c.earnings)
+		if math.Abs(actual[core.MiscSrcCPPBase]-c.base) > 1e-6 {
+			t.Errorf("case %d: unexpected base contributions\nwant: %.2f\n got: %.2f",
+				i, c.base, actual[core.MiscSrcCPPBase],
+			)
+		}
+		if math.Abs(actual[core.DeducSrcCPPEnhanced]-c.enhanced) > 1e-6 {
+			t.Errorf("case %d: unexpected enhanced contributions\nwant: %.2f\n got: %.2f",
+				i, c.enhanced, actual[core.DeducSrcCPPEnhanced],
+			)
+		}
+	}
+}
+
+func TestPensionPlanFormula_Contributions_NoSecondCeiling(t *testing.T) {
+
+	formula := &PensionPlanFormula{
+		BasicExemption:               3500,
+		MaxPensionableEarnings:       55900,
+		BaseRate:                     0.0495,
+		BaseContributionSource:       core.MiscSrcCPPBase,
+		AdditionalContributionSource: core.MiscSrcCPPBase,
+	}
+
+	actual := formula.Contributions(100000)
+	expected := map[core.FinancialSource]float64{core.MiscSrcCPPBase: 0.0495 * 52400}
+	diff := deep.Equal(actual, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestPensionPlanFormula_Validate(t *testing.T) {
+
+	cases := []struct {
+		name    string
+		formula *PensionPlanFormula
+		err     error
+	}{
+		{
+			name:    "valid",
+			formula: &PensionPlanFormula{BasicExemption: 3500, MaxPensionableEarnings: 55900},
+			err:     nil,
+		},
+		{
+			name:    "negative",
+			formula: &PensionPlanFormula{BaseRate: -0.1},
+			err:     core.ErrValNeg,
+		},
+		{
+			name:    "infinite",
+			formula: &PensionPlanFormula{MaxPensionableEarnings: math.Inf(1)},
+			err:     core.ErrValInf,
+		},
+		{
+			name:    "exemption-above-first-ceiling",
+			formula: &PensionPlanFormula{BasicExemption: 3500, MaxPensionableEarnings: 3000},
+			err:     core.ErrBoundsReversed,
+		},
+		{
+			name: "first-ceiling-above-second-ceiling",
+			formula: &PensionPlanFormula{
+				MaxPensionableEarnings:           70000,
+				AdditionalMaxPensionableEarnings: 60000,
+			},
+			err: core.ErrBoundsReversed,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+			err := c.formula.Validate()
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestPensionPlanFormula_Clone(t *testing.T) {
+
+	var nilFormula *PensionPlanFormula
+	if nilFormula.Clone() != nil {
+		t.Error("cloning a nil formula should return nil")
+	}
+
+	original := &PensionPlanFormula{
+		BasicExemption:         3500,
+		MaxPensionableEarnings: 55900,
+		BaseRate:               0.0495,
+		IncomeSources:          []core.FinancialSource{core.IncSrcEarned},
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Fatal("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	original.IncomeSources[0] = core.IncSrcInterest
+	if clone.AllowedIncomeSources()[0] != core.IncSrcEarned {
+		t.Error("expected changes to original formula to not affect clone formula")
+	}
+}
+
+func TestPensionPlanFormula_NumFieldsUnchanged(t *testing.T) {
+
+	dummy := PensionPlanFormula{}
+	s := reflect.ValueOf(&dummy).Elem()
+	if s.NumField() != 9 {
+		t.Fatal(
+			"number of struct fields changed. Please update the clone method of " +
+				"this type as well as associated test. Next, update this test with " +
+				"the new number of fields",
+		)
+	}
+}
@@ -0,0 +1,24 @@
+package payroll
+
+import "github.com/malkhamis/quantax/core"
+
+type testFormula struct {
+	onContributions         map[core.FinancialSource]float64
+	onAllowedIncomeSources  []core.FinancialSource
+	onValidate              error
+	earningsPassedOnContrib []float64
+}
+
+func (tf *testFormula) Contributions(earnings float64) map[core.FinancialSource]float64 {
+	tf.earningsPassedOnContrib = append(tf.earningsPassedOnContrib, earnings)
+	return tf.onContributions
+}
+func (tf *testFormula) AllowedIncomeSources() []core.FinancialSource {
+	return tf.onAllowedIncomeSources
+}
+func (tf *testFormula) Validate() error {
+	return tf.onValidate
+}
+func (tf *testFormula) Clone() Formula {
+	return tf
+}
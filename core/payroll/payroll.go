@@ -0,0 +1,56 @@
+// Package payroll provides implementations for the PayrollCalculator interface
+// defined in package core
+package payroll
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors that can ben wrapped and returned by this package
+var (
+	ErrNoFormula = errors.New("no formula given/set")
+)
+
+// Formula computes the employee payroll contributions for a single program,
+// e.g. a pension plan or an insurance plan
+type Formula interface {
+	// Contributions returns the contributions for the given earnings, where
+	// the amounts are keyed by the financial source which they should be
+	// recorded under
+	Contributions(earnings float64) map[core.FinancialSource]float64
+	// AllowedIncomeSources return the income sources which are subject to
+	// contributions
+	AllowedIncomeSources() []core.FinancialSource
+	// Validate checks if the formula is valid for use
+	Validate() error
+	// Clone returns a copy of the formula
+	Clone() Formula
+}
+
+// CalcConfig is used to pass configurations to create new payroll calculator
+type CalcConfig struct {
+	Formulas []Formula
+}
+
+// validate checks if the configurations are valid for use by calc constructors
+func (cfg CalcConfig) validate() error {
+
+	if len(cfg.Formulas) == 0 {
+		return ErrNoFormula
+	}
+
+	for i, formula := range cfg.Formulas {
+
+		if formula == nil {
+			return errors.Wrapf(ErrNoFormula, "index %d", i)
+		}
+
+		err := formula.Validate()
+		if err != nil {
+			return errors.Wrapf(err, "invalid formula at index %d", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package payroll
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ core.PayrollCalculator = (*Calculator)(nil)
+
+// Calculator is used to calculate the payroll contributions of both spouses in
+// a household. The contributions of all the underlying formulas are combined
+type Calculator struct {
+	formulas []Formula
+	finances core.HouseholdFinances
+}
+
+// NewCalculator returns a new payroll calculator from the given options with
+// an empty finances instance
+func NewCalculator(cfg CalcConfig) (*Calculator, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	c := &Calculator{
+		formulas: make([]Formula, len(cfg.Formulas)),
+		finances: core.NewHouseholdFinancesNop(),
+	}
+
+	for i, formula := range cfg.Formulas {
+		c.formulas[i] = formula.Clone()
+	}
+
+	return c, nil
+}
+
+// Contributions returns the payroll contributions for both spouses in the set
+// finances. The contributions of a nil spouse are nil
+func (c *Calculator) Contributions() (spouseA, spouseB map[core.FinancialSource]float64) {
+	spouseA = c.contributions(c.finances.SpouseA())
+	spouseB = c.contributions(c.finances.SpouseB())
+	return spouseA, spouseB
+}
+
+// SetFinances makes subsequent calculations based on the given finances.
+// if new finances is nil, an empty finances instance is set. Change to the
+// given finances will affect the results of future calls on this calculator
+func (c *Calculator) SetFinances(f core.HouseholdFinances) {
+	if f == nil {
+		f = core.NewHouseholdFinancesNop()
+	}
+	c.finances = f
+}
+
+// contributions returns the combined contributions of all formulas for the
+// given finances. If finances is nil, it returns nil
+func (c *Calculator) contributions(finances core.Financer) map[core.FinancialSource]float64 {
+
+	if finances == nil {
+		return nil
+	}
+
+	total := make(map[core.FinancialSource]float64)
+	for _, formula := range c.formulas {
+		earnings := finances.TotalAmount(formula.AllowedIncomeSources()...)
+		for src, amount := range formula.Contributions(earnings) {
+			total[src] += amount
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,108 @@
+package payroll
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/finance"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestNewCalculator(t *testing.T) {
+
+	c, err := NewCalculator(CalcConfig{Formulas: []Formula{&testFormula{}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c == nil {
+		t.Fatal("expected non-nil calculator if no error")
+	}
+}
+
+func TestNewCalculator_Errors(t *testing.T) {
+
+	_, err := NewCalculator(CalcConfig{})
+	if pkgerrors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	_, err = NewCalculator(CalcConfig{Formulas: []Formula{nil}})
+	if pkgerrors.Cause(err) != ErrNoFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoFormula, err)
+	}
+
+	invalid := errors.New("invalid")
+	_, err = NewCalculator(CalcConfig{Formulas: []Formula{&testFormula{onValidate: invalid}}})
+	if pkgerrors.Cause(err) != invalid {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", invalid, err)
+	}
+}
+
+func TestCalculator_Contributions(t *testing.T) {
+
+	pension := &testFormula{
+		onContributions: map[core.FinancialSource]float64{
+			core.MiscSrcCPPBase:      100,
+			core.DeducSrcCPPEnhanced: 20,
+		},
+		onAllowedIncomeSources: []core.FinancialSource{core.IncSrcEarned},
+	}
+	insurance := &testFormula{
+		onContributions: map[core.FinancialSource]float64{
+			core.MiscSrcEIPremiums: 50,
+			core.MiscSrcCPPBase:    1,
+		},
+		onAllowedIncomeSources: []core.FinancialSource{core.IncSrcEarned, core.IncSrcInterest},
+	}
+
+	c, err := NewCalculator(CalcConfig{Formulas: []Formula{pension, insurance}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spouseA := finance.NewIndividualFinances()
+	spouseA.SetAmount(core.IncSrcEarned, 1000)
+	spouseA.SetAmount(core.IncSrcInterest, 10)
+	c.SetFinances(finance.NewHouseholdFinances(spouseA, nil))
+
+	actualA, actualB := c.Contributions()
+	if actualB != nil {
+		t.Errorf("expected nil contributions for nil spouse, got: %v", actualB)
+	}
+
+	expectedA := map[core.FinancialSource]float64{
+		core.MiscSrcCPPBase:      101,
+		core.DeducSrcCPPEnhanced: 20,
+		core.MiscSrcEIPremiums:   50,
+	}
+	diff := deep.Equal(actualA, expectedA)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	diff = deep.Equal(pension.earningsPassedOnContrib, []float64{1000})
+	if diff != nil {
+		t.Error("unexpected earnings passed to formula\n" + strings.Join(diff, "\n"))
+	}
+	diff = deep.Equal(insurance.earningsPassedOnContrib, []float64{1010})
+	if diff != nil {
+		t.Error("unexpected earnings passed to formula\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestCalculator_SetFinances(t *testing.T) {
+
+	c := &Calculator{}
+	c.SetFinances(nil)
+	if c.finances == nil {
+		t.Fatal("expected a noop finances to be set when method is called with nil")
+	}
+
+	spouseA, spouseB := c.Contributions()
+	if len(spouseA) != 0 || len(spouseB) != 0 {
+		t.Error("expected no contributions for noop finances")
+	}
+}
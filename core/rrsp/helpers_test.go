@@ -27,6 +27,12 @@ func (ttc *testTaxCalculator) TaxPayable() (float64, float64, []core.TaxCredit)
 	ttc._currentIndex++
 	return spouseA, spouseB, credits
 }
+func (ttc *testTaxCalculator) TaxBreakdown() ([]core.TaxBreakdown, []core.TaxBreakdown, []core.TaxCredit) {
+	return nil, nil, nil
+}
+func (ttc *testTaxCalculator) TaxRates(_ ...core.FinancialSource) (core.TaxRates, core.TaxRates) {
+	return core.TaxRates{}, core.TaxRates{}
+}
 func (ttc *testTaxCalculator) SetFinances(f core.HouseholdFinances, cr []core.TaxCredit) {
 	ttc.financesPassedOnSetFinances = append(ttc.financesPassedOnSetFinances, f)
 	ttc.creditsPassedOnSetFinances = append(ttc.creditsPassedOnSetFinances, cr)
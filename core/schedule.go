@@ -0,0 +1,56 @@
+package core
+
+// MonthsInBenefitYear is the number of months of a benefit year, which starts
+// in July and ends in June of the following year
+const MonthsInBenefitYear = 12
+
+// PaymentSchedule holds the benefits paid in each month of a benefit year,
+// where the first month is July
+type PaymentSchedule [MonthsInBenefitYear]float64
+
+// Total returns the sum of all payments in the schedule
+func (ps PaymentSchedule) Total() float64 {
+
+	var total float64
+	for _, payment := range ps {
+		total += payment
+	}
+	return total
+}
+
+// Add returns a new schedule where each payment is the sum of the payments of
+// this and the given schedule in the same month
+func (ps PaymentSchedule) Add(other PaymentSchedule) PaymentSchedule {
+
+	for month := range ps {
+		ps[month] += other[month]
+	}
+	return ps
+}
+
+// ChangeMonths returns the months of the benefit year, starting at zero, in
+// which the payment differs from the payment of the previous month, e.g. the
+// month in which a child ages into a new age group or out of eligibility
+func (ps PaymentSchedule) ChangeMonths() []uint {
+
+	var months []uint
+	for month := 1; month < len(ps); month++ {
+		if ps[month] != ps[month-1] {
+			months = append(months, uint(month))
+		}
+	}
+	return months
+}
+
+// LumpSum returns a schedule where all payments are paid in the first month if
+// the total payments are positive and below the given threshold. Otherwise, it
+// returns the schedule unchanged
+func (ps PaymentSchedule) LumpSum(threshold float64) PaymentSchedule {
+
+	total := ps.Total()
+	if total <= 0.0 || total >= threshold {
+		return ps
+	}
+
+	return PaymentSchedule{total}
+}
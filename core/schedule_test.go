@@ -0,0 +1,114 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPaymentSchedule_Total(t *testing.T) {
+
+	schedule := PaymentSchedule{100, 100, 100, 50, 50, 50, 50, 50, 50, 0, 0, 0}
+	actual := schedule.Total()
+	if actual != 600.0 {
+		t.Errorf("unexpected results\nwant: %.2f\n got: %.2f", 600.0, actual)
+	}
+}
+
+func TestPaymentSchedule_Add(t *testing.T) {
+
+	schedule := PaymentSchedule{100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100}
+	other := PaymentSchedule{10, 20, 30}
+
+	actual := schedule.Add(other)
+	expected := PaymentSchedule{110, 120, 130, 100, 100, 100, 100, 100, 100, 100, 100, 100}
+	if actual != expected {
+		t.Errorf("unexpected results\nwant: %v\n got: %v", expected, actual)
+	}
+
+	if schedule[0] != 100.0 {
+		t.Error("expected the original schedule to be unchanged")
+	}
+}
+
+func TestPaymentSchedule_ChangeMonths(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		schedule PaymentSchedule
+		expected []uint
+	}{
+		{
+			name:     "no-payments",
+			schedule: PaymentSchedule{},
+			expected: nil,
+		},
+		{
+			name:     "new-age-group",
+			schedule: PaymentSchedule{100, 100, 100, 80, 80, 80, 80, 80, 80, 80, 80, 80},
+			expected: []uint{3},
+		},
+		{
+			name:     "new-age-group-then-ineligible",
+			schedule: PaymentSchedule{100, 80, 80, 80, 80, 80, 80, 80, 0, 0, 0, 0},
+			expected: []uint{1, 8},
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := c.schedule.ChangeMonths()
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("unexpected results\nwant: %v\n got: %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPaymentSchedule_LumpSum(t *testing.T) {
+
+	cases := []struct {
+		name      string
+		schedule  PaymentSchedule
+		threshold float64
+		expected  PaymentSchedule
+	}{
+		{
+			name:      "below-threshold",
+			schedule:  PaymentSchedule{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+			threshold: 240,
+			expected:  PaymentSchedule{120},
+		},
+		{
+			name:      "at-threshold",
+			schedule:  PaymentSchedule{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
+			threshold: 240,
+			expected:  PaymentSchedule{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
+		},
+		{
+			name:      "no-payments",
+			schedule:  PaymentSchedule{},
+			threshold: 240,
+			expected:  PaymentSchedule{},
+		},
+		{
+			name:      "no-threshold",
+			schedule:  PaymentSchedule{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			threshold: 0,
+			expected:  PaymentSchedule{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := c.schedule.LumpSum(c.threshold)
+			if actual != c.expected {
+				t.Errorf("unexpected results\nwant: %v\n got: %v", c.expected, actual)
+			}
+		})
+	}
+}
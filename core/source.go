@@ -1,5 +1,11 @@
 package core
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
 // Source represents a financial source
 type FinancialSource int
 
@@ -32,23 +38,142 @@ const (
 	MiscSourcesEnd
 )
 
+// Sources added after the original ranges are appended here so that the
+// values of existing sources never change. New sources must only be appended
+// to this block, and they must be classified in the maps below
+const (
+	AppendedSourcesBegin FinancialSource = MiscSourcesEnd + 1 + iota
+	IncSrcOAS                            // old age security pension
+	DeducSrcCPPEnhanced                  // enhanced CPP/QPP contributions (employee share)
+	MiscSrcCPPBase                       // base CPP/QPP contributions (employee share)
+	MiscSrcEIPremiums                    // employment insurance premiums (employee share)
+	MiscSrcQPIPPremiums                  // Quebec parental insurance plan premiums
+	AppendedSourcesEnd
+)
+
+// the classification of the appended sources
+var (
+	appendedIncomeSources    = map[FinancialSource]bool{IncSrcOAS: true}
+	appendedDeductionSources = map[FinancialSource]bool{DeducSrcCPPEnhanced: true}
+	appendedMiscSources      = map[FinancialSource]bool{
+		MiscSrcCPPBase:      true,
+		MiscSrcEIPremiums:   true,
+		MiscSrcQPIPPremiums: true,
+	}
+)
+
 // IsIncomeSource returns true if this source is an identified income source
 func (s FinancialSource) IsIncomeSource() bool {
-	return s > IncomeSourcesBegin && s < IncomeSourcesEnd
+	return (s > IncomeSourcesBegin && s < IncomeSourcesEnd) || appendedIncomeSources[s]
 }
 
 // IsDeductionSource returns true if this source is an identified deduction
 // source
 func (s FinancialSource) IsDeductionSource() bool {
-	return s > DeductionSourcesBegin && s < DeductionSourcesEnd
+	return (s > DeductionSourcesBegin && s < DeductionSourcesEnd) || appendedDeductionSources[s]
 }
 
 // IsMiscSource returns true if this source is an identified misc source
 func (s FinancialSource) IsMiscSource() bool {
-	return s > MiscSourcesBegin && s < MiscSourcesEnd
+	return (s > MiscSourcesBegin && s < MiscSourcesEnd) || appendedMiscSources[s]
+}
+
+// FinancialSources returns all identified financial sources in the order they
+// are declared
+func FinancialSources() []FinancialSource {
+
+	var sources []FinancialSource
+	for src := SrcNone + 1; src < AppendedSourcesEnd; src++ {
+		if !src.IsUnknownSource() {
+			sources = append(sources, src)
+		}
+	}
+	return sources
 }
 
 // IsUnknownSource returns true if this source is an unidentified source
 func (s FinancialSource) IsUnknownSource() bool {
 	return !s.IsIncomeSource() && !s.IsDeductionSource() && !s.IsMiscSource()
 }
+
+// financialSourceNames holds the stable names of the identified financial
+// sources, which are used when sources are encoded as text. Names must never
+// change once added
+var financialSourceNames = map[FinancialSource]string{
+	SrcNone:                      "none",
+	IncSrcEarned:                 "earned",
+	IncSrcInterest:               "interest",
+	IncSrcCapitalGainCA:          "capital-gain-ca",
+	IncSrcEligibleDividendsCA:    "eligible-dividends-ca",
+	IncSrcNonEligibleDividendsCA: "non-eligible-dividends-ca",
+	IncSrcForeignDividends:       "foreign-dividends",
+	IncSrcRRSP:                   "rrsp-withdrawal",
+	IncSrcUCCB:                   "uccb",
+	IncSrcRDSP:                   "rdsp",
+	IncSrcTFSA:                   "tfsa",
+	IncSrcOAS:                    "oas",
+	DeducSrcChildCareExpense:     "child-care-expense",
+	DeducSrcRRSP:                 "rrsp-contribution",
+	DeducSrcOthers:               "other-deductions",
+	DeducSrcCPPEnhanced:          "cpp-enhanced",
+	MiscSrcMedical:               "medical",
+	MiscSrcTuition:               "tuition",
+	MiscSrcOthers:                "other-misc",
+	MiscSrcCPPBase:               "cpp-base",
+	MiscSrcEIPremiums:            "ei-premiums",
+	MiscSrcQPIPPremiums:          "qpip-premiums",
+}
+
+// financialSourcesByName is the reverse of financialSourceNames
+var financialSourcesByName = make(map[string]FinancialSource)
+
+func init() {
+	for src, name := range financialSourceNames {
+		financialSourcesByName[name] = src
+	}
+}
+
+// ParseFinancialSource returns the financial source with the given name. If
+// no source has the given name, it returns ErrUnknownSource
+func ParseFinancialSource(name string) (FinancialSource, error) {
+
+	src, ok := financialSourcesByName[name]
+	if !ok {
+		return SrcNone, errors.Wrapf(ErrUnknownSource, "%q", name)
+	}
+	return src, nil
+}
+
+// String returns the name of this source. Sources without a name are
+// formatted as FinancialSource(n)
+func (s FinancialSource) String() string {
+
+	name, ok := financialSourceNames[s]
+	if !ok {
+		return fmt.Sprintf("FinancialSource(%d)", int(s))
+	}
+	return name
+}
+
+// MarshalText returns the name of this source. If this source has no name,
+// it returns ErrUnknownSource
+func (s FinancialSource) MarshalText() ([]byte, error) {
+
+	name, ok := financialSourceNames[s]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownSource, "FinancialSource(%d)", int(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText sets this source to the source with the given name. If no
+// source has the given name, it returns ErrUnknownSource
+func (s *FinancialSource) UnmarshalText(text []byte) error {
+
+	src, err := ParseFinancialSource(string(text))
+	if err != nil {
+		return err
+	}
+	*s = src
+	return nil
+}
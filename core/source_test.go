@@ -1,6 +1,12 @@
 package core
 
-import "testing"
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
 
 func TestFinancialSource(t *testing.T) {
 
@@ -15,6 +21,12 @@ func TestFinancialSource(t *testing.T) {
 		{source: DeducSrcRRSP, isDeduc: true},
 		{source: MiscSrcMedical, isMisc: true},
 		{source: SrcNone, isUnkown: true},
+		{source: IncSrcOAS, isInc: true},
+		{source: DeducSrcCPPEnhanced, isDeduc: true},
+		{source: MiscSrcEIPremiums, isMisc: true},
+		{source: MiscSourcesEnd, isUnkown: true},
+		{source: AppendedSourcesBegin, isUnkown: true},
+		{source: AppendedSourcesEnd, isUnkown: true},
 	}
 
 	for _, c := range cases {
@@ -53,3 +65,123 @@ func TestFinancialSource(t *testing.T) {
 
 	}
 }
+
+func TestFinancialSource_ValuesUnchanged(t *testing.T) {
+
+	// the values of sources are stored and exchanged as integers, so they
+	// must never change once released
+	expected := map[FinancialSource]int{
+		IncSrcEarned:             2,
+		IncSrcTFSA:               11,
+		DeducSrcChildCareExpense: 14,
+		DeducSrcOthers:           16,
+		MiscSrcMedical:           19,
+		MiscSrcOthers:            21,
+		MiscSourcesEnd:           22,
+		IncSrcOAS:                24,
+		MiscSrcQPIPPremiums:      28,
+	}
+
+	for src, value := range expected {
+		if int(src) != value {
+			t.Errorf("FinancialSource(%d): expected the value %d", int(src), value)
+		}
+	}
+}
+
+func TestFinancialSources(t *testing.T) {
+
+	sources := FinancialSources()
+	if len(sources) != 21 {
+		t.Fatalf("unexpected number of sources\nwant: %d\n got: %d", 21, len(sources))
+	}
+
+	for _, src := range sources {
+		if src.IsUnknownSource() {
+			t.Errorf("FinancialSource(%d): expected an identified source", int(src))
+		}
+	}
+}
+
+func TestFinancialSource_MarshalText(t *testing.T) {
+
+	for _, src := range append(FinancialSources(), SrcNone) {
+
+		text, err := src.MarshalText()
+		if err != nil {
+			t.Errorf("FinancialSource(%d): expected a name: %v", int(src), err)
+			continue
+		}
+
+		var actual FinancialSource
+		err = actual.UnmarshalText(text)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+		}
+		if actual != src {
+			t.Errorf("%s: actual '%d' does not match expected '%d'", text, actual, src)
+		}
+		if src.String() != string(text) {
+			t.Errorf("unexpected string\nwant: %q\n got: %q", text, src.String())
+		}
+	}
+}
+
+func TestFinancialSource_MarshalText_Errors(t *testing.T) {
+
+	_, err := IncomeSourcesBegin.MarshalText()
+	if errors.Cause(err) != ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownSource, err)
+	}
+
+	_, err = FinancialSource(-1).MarshalText()
+	if errors.Cause(err) != ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownSource, err)
+	}
+
+	if FinancialSource(-1).String() != "FinancialSource(-1)" {
+		t.Errorf("unexpected string for unnamed source: %q", FinancialSource(-1).String())
+	}
+
+	src := IncSrcEarned
+	err = src.UnmarshalText([]byte("lottery"))
+	if errors.Cause(err) != ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownSource, err)
+	}
+	if src != IncSrcEarned {
+		t.Error("expected source not to change on errors")
+	}
+}
+
+func TestFinancialSource_JSON(t *testing.T) {
+
+	amounts := map[FinancialSource]float64{
+		IncSrcEarned:   1000,
+		DeducSrcRRSP:   200,
+		MiscSrcTuition: 30,
+	}
+
+	data, err := json.Marshal(amounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedJSON := `{"earned":1000,"rrsp-contribution":200,"tuition":30}`
+	if string(data) != expectedJSON {
+		t.Errorf("unexpected encoding\nwant: %s\n got: %s", expectedJSON, data)
+	}
+
+	var actual map[FinancialSource]float64
+	err = json.Unmarshal(data, &actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(actual, amounts) {
+		t.Errorf("actual does not match expected\nwant: %v\n got: %v", amounts, actual)
+	}
+
+	err = json.Unmarshal([]byte(`{"lottery": 1}`), &actual)
+	if errors.Cause(err) != ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownSource, err)
+	}
+}
@@ -0,0 +1,87 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// Abatement is a reduction of the basic tax of a target region that is granted
+// because of the tax regime of another region, e.g. the refundable Quebec
+// abatement which reduces the basic federal tax of Quebec residents by 16.5%
+type Abatement struct {
+	// TargetRegion is the tax region whose basic tax is reduced
+	TargetRegion core.Region
+	// Rate is the percentage of the basic tax of the target region to reduce
+	Rate float64
+	// Desc is a short description of the abatement
+	Desc string
+}
+
+// Validate checks if the abatement is valid for use
+func (a Abatement) Validate() error {
+
+	if a.TargetRegion == "" {
+		return errors.Wrap(ErrInvalidTaxArg, "abatement target region is not set")
+	}
+
+	if a.Rate < 0.0 || a.Rate > 1.0 {
+		return errors.Wrapf(ErrInvalidTaxArg, "abatement rate %.4f is not within [0, 1]", a.Rate)
+	}
+
+	return nil
+}
+
+// amount returns the abatement amount for the given breakdown. The basic tax
+// is the payable tax after applying tax credits but before adjustments. If the
+// breakdown is not for the target region, it returns zero
+func (a Abatement) amount(bd core.TaxBreakdown) float64 {
+
+	if bd.Region != a.TargetRegion {
+		return 0.0
+	}
+
+	basicTax := bd.GrossTax - bd.CreditsApplied
+	if basicTax <= 0.0 {
+		return 0.0
+	}
+
+	return a.Rate * basicTax
+}
+
+// applyAbatements reduces the net payable tax of the given breakdowns by the
+// given abatements and records each abatement as a negative adjustment. It
+// returns the total abated amount
+func applyAbatements(breakdowns []core.TaxBreakdown, abatements []Abatement) float64 {
+
+	var total float64
+	for i := range breakdowns {
+		for _, abatement := range abatements {
+
+			amount := abatement.amount(breakdowns[i])
+			if amount == 0.0 {
+				continue
+			}
+
+			breakdowns[i].Adjustments = append(breakdowns[i].Adjustments, core.TaxAdjustment{
+				Description: abatement.Desc,
+				Amount:      -amount,
+			})
+			breakdowns[i].NetPayable -= amount
+			total += amount
+		}
+	}
+
+	return total
+}
+
+// cloneAbatements returns a copy of the given abatements
+func cloneAbatements(abatements []Abatement) []Abatement {
+
+	if abatements == nil {
+		return nil
+	}
+
+	clone := make([]Abatement, len(abatements))
+	copy(clone, abatements)
+	return clone
+}
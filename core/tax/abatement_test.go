@@ -0,0 +1,77 @@
+package tax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+func TestAbatement_Validate(t *testing.T) {
+
+	cases := []struct {
+		name      string
+		abatement Abatement
+		err       error
+	}{
+		{name: "valid", abatement: Abatement{TargetRegion: core.RegionCA, Rate: 0.165}, err: nil},
+		{name: "no-target", abatement: Abatement{Rate: 0.165}, err: ErrInvalidTaxArg},
+		{name: "negative-rate", abatement: Abatement{TargetRegion: core.RegionCA, Rate: -0.1}, err: ErrInvalidTaxArg},
+		{name: "rate-above-one", abatement: Abatement{TargetRegion: core.RegionCA, Rate: 1.1}, err: ErrInvalidTaxArg},
+	}
+
+	for _, c := range cases {
+		err := c.abatement.Validate()
+		if errors.Cause(err) != c.err {
+			t.Errorf("%s: unexpected error\nwant: %v\n got: %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestApplyAbatements(t *testing.T) {
+
+	breakdowns := []core.TaxBreakdown{
+		{Region: core.RegionCA, GrossTax: 1200, CreditsApplied: 200, NetPayable: 1000},
+		{Region: core.RegionQC, GrossTax: 1500, CreditsApplied: 500, NetPayable: 1000},
+		{Region: core.RegionCA, GrossTax: 100, CreditsApplied: 200, NetPayable: 0},
+	}
+	abatements := []Abatement{{TargetRegion: core.RegionCA, Rate: 0.165, Desc: "abatement"}}
+
+	total := applyAbatements(breakdowns, abatements)
+	if total != 165 {
+		t.Errorf("unexpected total abatements\nwant: %.2f\n got: %.2f", 165.0, total)
+	}
+
+	expected := []core.TaxBreakdown{
+		{
+			Region:         core.RegionCA,
+			GrossTax:       1200,
+			CreditsApplied: 200,
+			Adjustments:    []core.TaxAdjustment{{Description: "abatement", Amount: -165}},
+			NetPayable:     835,
+		},
+		{Region: core.RegionQC, GrossTax: 1500, CreditsApplied: 500, NetPayable: 1000},
+		{Region: core.RegionCA, GrossTax: 100, CreditsApplied: 200, NetPayable: 0},
+	}
+
+	diff := deep.Equal(breakdowns, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestCloneAbatements(t *testing.T) {
+
+	if cloneAbatements(nil) != nil {
+		t.Error("expected cloning nil abatements to return nil")
+	}
+
+	original := []Abatement{{TargetRegion: core.RegionCA, Rate: 0.165}}
+	clone := cloneAbatements(original)
+	original[0].Rate = 0.5
+	if clone[0].Rate != 0.165 {
+		t.Error("expected changes to original to not affect clone")
+	}
+}
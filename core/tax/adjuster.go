@@ -0,0 +1,15 @@
+package tax
+
+// TaxAdjuster calculates an adjustment on the payable tax after applying tax
+// credits, e.g. a surtax, a premium, or a tax reduction
+type TaxAdjuster interface {
+	// Adjustment returns the amount to add to the given tax of the tax payer,
+	// where a negative amount reduces the tax
+	Adjustment(taxPayer *TaxPayer, tax float64) float64
+	// Description returns a short description of the adjustment
+	Description() string
+	// Validate checks if the adjuster is valid for use
+	Validate() error
+	// Clone returns a copy of this adjuster
+	Clone() TaxAdjuster
+}
@@ -0,0 +1,55 @@
+package tax
+
+import "github.com/malkhamis/quantax/core"
+
+// SourceCreditAdjuster is a TaxAdjuster that reduces the tax by a weighted
+// amount of a financial source, e.g. Ontario's dividend tax credit which is
+// deducted after the surtax is calculated. Like a non-refundable tax credit,
+// the reduction cannot exceed the tax and its unused portion is lost
+type SourceCreditAdjuster struct {
+	// the financial source which the credit is computed for
+	Source core.FinancialSource
+	// the weight to apply on the amount of the financial source
+	Weight float64
+	// a short description of the credit
+	Desc string
+}
+
+// Adjustment returns the negated credit for the tax payer's amount of the
+// financial source, which is capped at the given tax. If tax payer is nil or
+// the tax is zero or less, it returns zero
+func (sca SourceCreditAdjuster) Adjustment(tp *TaxPayer, tax float64) float64 {
+
+	if tp == nil || tp.Finances == nil || tax <= 0.0 {
+		return 0.0
+	}
+
+	credit := sca.Weight * tp.Finances.TotalAmount(sca.Source)
+	if credit <= 0.0 {
+		return 0.0
+	}
+	if credit > tax {
+		credit = tax
+	}
+
+	return -credit
+}
+
+// Description returns a short description of the credit
+func (sca SourceCreditAdjuster) Description() string {
+	return sca.Desc
+}
+
+// Validate checks if the adjuster is valid for use
+func (sca SourceCreditAdjuster) Validate() error {
+
+	if sca.Weight < 0 {
+		return core.ErrValNeg
+	}
+	return nil
+}
+
+// Clone returns a deep copy of this adjuster
+func (sca SourceCreditAdjuster) Clone() TaxAdjuster {
+	return sca
+}
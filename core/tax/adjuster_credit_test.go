@@ -0,0 +1,81 @@
+package tax
+
+import (
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+func TestSourceCreditAdjuster_Adjustment(t *testing.T) {
+
+	adjuster := SourceCreditAdjuster{
+		Source: core.IncSrcEligibleDividendsCA,
+		Weight: 0.10,
+		Desc:   "dividend tax credit",
+	}
+
+	finances := &testFinancer{onTotalAmount: 2000}
+
+	cases := []struct {
+		name     string
+		tp       *TaxPayer
+		tax      float64
+		expected float64
+	}{
+		{
+			name:     "nil-tax-payer",
+			tp:       nil,
+			tax:      1000,
+			expected: 0,
+		},
+		{
+			name:     "no-tax",
+			tp:       &TaxPayer{Finances: finances},
+			tax:      0,
+			expected: 0,
+		},
+		{
+			name:     "full-credit",
+			tp:       &TaxPayer{Finances: finances},
+			tax:      1000,
+			expected: -200,
+		},
+		{
+			name:     "capped-credit",
+			tp:       &TaxPayer{Finances: finances},
+			tax:      150,
+			expected: -150,
+		},
+	}
+
+	for _, c := range cases {
+		actual := adjuster.Adjustment(c.tp, c.tax)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("%s: unexpected credit\nwant: %.2f\n got: %.2f", c.name, c.expected, actual)
+		}
+	}
+
+	if len(finances.onTotalAmountCapturedArg) != 1 || finances.onTotalAmountCapturedArg[0] != core.IncSrcEligibleDividendsCA {
+		t.Errorf("unexpected sources: %v", finances.onTotalAmountCapturedArg)
+	}
+}
+
+func TestSourceCreditAdjuster_Validate_Clone(t *testing.T) {
+
+	err := SourceCreditAdjuster{Weight: -1}.Validate()
+	if errors.Cause(err) != core.ErrValNeg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrValNeg, err)
+	}
+
+	original := SourceCreditAdjuster{Source: core.IncSrcEligibleDividendsCA, Weight: 0.1, Desc: "credit"}
+	if err := original.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	clone := original.Clone()
+	if clone != original || clone.Description() != "credit" {
+		t.Errorf("clone does not match original\nwant: %v\n got: %v", original, clone)
+	}
+}
@@ -0,0 +1,80 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// PremiumTier is a portion of net income which a premium rate applies to
+type PremiumTier struct {
+	// the rate applied on the portion of net income within the bracket
+	Rate float64
+	// the bracket of net income which the rate applies to
+	Bracket core.Bracket
+}
+
+// IncomePremiumAdjuster is a TaxAdjuster that adds a premium computed on the
+// tax payer's net income, e.g. Ontario Health Premium. Unlike weighted
+// brackets, the tiers of a premium may share the same rate
+type IncomePremiumAdjuster struct {
+	// the tiers of the premium, which are summed up
+	Tiers []PremiumTier
+	// a short description of the premium
+	Desc string
+}
+
+// Adjustment returns the premium for the net income of the given tax payer. If
+// tax payer is nil, it returns zero
+func (ipa IncomePremiumAdjuster) Adjustment(tp *TaxPayer, _ float64) float64 {
+
+	if tp == nil {
+		return 0.0
+	}
+
+	var premium float64
+	for _, tier := range ipa.Tiers {
+
+		if tp.NetIncome <= tier.Bracket.Lower() {
+			continue
+		}
+
+		if tp.NetIncome >= tier.Bracket.Upper() {
+			premium += tier.Rate * tier.Bracket.Amount()
+			continue
+		}
+
+		premium += tier.Rate * (tp.NetIncome - tier.Bracket.Lower())
+	}
+
+	return premium
+}
+
+// Description returns a short description of the premium
+func (ipa IncomePremiumAdjuster) Description() string {
+	return ipa.Desc
+}
+
+// Validate checks if the adjuster is valid for use
+func (ipa IncomePremiumAdjuster) Validate() error {
+
+	for i, tier := range ipa.Tiers {
+		err := tier.Bracket.Validate()
+		if err != nil {
+			return errors.Wrapf(err, "tier %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of this adjuster
+func (ipa IncomePremiumAdjuster) Clone() TaxAdjuster {
+
+	clone := IncomePremiumAdjuster{Desc: ipa.Desc}
+	if ipa.Tiers != nil {
+		clone.Tiers = make([]PremiumTier, len(ipa.Tiers))
+		copy(clone.Tiers, ipa.Tiers)
+	}
+
+	return clone
+}
@@ -0,0 +1,72 @@
+package tax
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// RecoveryTaxAdjuster is a TaxAdjuster that recovers a benefit from tax payers
+// whose net income is above a threshold, e.g. Old Age Security recovery tax.
+// The recovery tax is computed as follows:
+//
+//	recovery = Rate * (net income - Threshold)
+//
+// where the recovery tax can neither be negative nor exceed the benefit that
+// is recorded in the tax payer's finances under the benefit source
+type RecoveryTaxAdjuster struct {
+	// the rate applied on the net income above the threshold
+	Rate float64
+	// the net income above which the benefit is recovered
+	Threshold float64
+	// the financial source which the benefit is recorded under
+	BenefitSource core.FinancialSource
+	// a short description of the recovery tax
+	Desc string
+}
+
+// Adjustment returns the recovery tax for the net income of the given tax
+// payer. If tax payer or their finances are nil, it returns zero
+func (rta RecoveryTaxAdjuster) Adjustment(tp *TaxPayer, _ float64) float64 {
+
+	if tp == nil || tp.Finances == nil {
+		return 0.0
+	}
+
+	recovery := rta.Rate * (tp.NetIncome - rta.Threshold)
+	if recovery <= 0.0 {
+		return 0.0
+	}
+
+	benefit := tp.Finances.TotalAmount(rta.BenefitSource)
+	if benefit <= 0.0 {
+		return 0.0
+	}
+
+	return math.Min(recovery, benefit)
+}
+
+// Description returns a short description of the recovery tax
+func (rta RecoveryTaxAdjuster) Description() string {
+	return rta.Desc
+}
+
+// Validate checks if the adjuster is valid for use
+func (rta RecoveryTaxAdjuster) Validate() error {
+
+	if rta.Rate < 0.0 || rta.Rate > 1.0 {
+		return errors.Wrapf(ErrInvalidTaxArg, "recovery rate %.4f is not within [0, 1]", rta.Rate)
+	}
+
+	if rta.Threshold < 0.0 {
+		return errors.Wrap(core.ErrValNeg, "recovery threshold")
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of this adjuster
+func (rta RecoveryTaxAdjuster) Clone() TaxAdjuster {
+	return rta
+}
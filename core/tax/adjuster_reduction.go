@@ -0,0 +1,85 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+)
+
+// LowIncomeReductionAdjuster is a TaxAdjuster that reduces the tax of low
+// income tax payers, e.g. Ontario tax reduction. The reduction is computed as
+// follows:
+//   reduction = 2 * (BaseAmount + DependentAmount * eligible dependents) - tax
+// where the reduction can neither be negative nor exceed the tax
+type LowIncomeReductionAdjuster struct {
+	// the basic amount every tax payer can claim
+	BaseAmount float64
+	// the amount that can be claimed for each eligible dependent
+	DependentAmount float64
+	// dependents older than this age are not eligible unless they are
+	// eligible for the disability tax credit
+	MaxDependentAgeMonths uint
+	// a short description of the reduction
+	Desc string
+}
+
+// Adjustment returns the negated reduction for the given tax. If tax payer is
+// nil or the tax is zero or less, it returns zero. Only the tax payer with the
+// higher net income claims the amounts for dependents
+func (lira LowIncomeReductionAdjuster) Adjustment(tp *TaxPayer, tax float64) float64 {
+
+	if tp == nil || tax <= 0.0 {
+		return 0.0
+	}
+
+	claimable := lira.BaseAmount
+	if tp.SpouseFinances == nil || tp.NetIncome >= tp.SpouseNetIncome {
+		claimable += lira.DependentAmount * float64(lira.eligibleDependents(tp))
+	}
+
+	reduction := 2.0*claimable - tax
+	if reduction <= 0.0 {
+		return 0.0
+	}
+	if reduction > tax {
+		reduction = tax
+	}
+
+	return -reduction
+}
+
+// eligibleDependents returns the number of dependents of the given tax payer
+// who are eligible for the dependent amount, where dependents with disabilities
+// are eligible regardless of their age
+func (lira LowIncomeReductionAdjuster) eligibleDependents(tp *TaxPayer) int {
+
+	var count int
+	for _, dependent := range tp.Dependents {
+
+		if dependent == nil {
+			continue
+		}
+
+		if dependent.AgeMonths <= lira.MaxDependentAgeMonths || dependent.DisabilityEligible {
+			count++
+		}
+	}
+	return count
+}
+
+// Description returns a short description of the reduction
+func (lira LowIncomeReductionAdjuster) Description() string {
+	return lira.Desc
+}
+
+// Validate checks if the adjuster is valid for use
+func (lira LowIncomeReductionAdjuster) Validate() error {
+
+	if lira.BaseAmount < 0 || lira.DependentAmount < 0 {
+		return core.ErrValNeg
+	}
+	return nil
+}
+
+// Clone returns a deep copy of this adjuster
+func (lira LowIncomeReductionAdjuster) Clone() TaxAdjuster {
+	return lira
+}
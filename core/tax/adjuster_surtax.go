@@ -0,0 +1,41 @@
+package tax
+
+import "github.com/malkhamis/quantax/core"
+
+// SurtaxAdjuster is a TaxAdjuster that adds a surtax computed on the tax, e.g.
+// Ontario's surtax which is a percentage of the basic provincial tax that
+// exceeds certain thresholds
+type SurtaxAdjuster struct {
+	// the surtax rates applied on the tax
+	Rates core.WeightedBrackets
+	// a short description of the surtax
+	Desc string
+}
+
+// Adjustment returns the surtax on the given tax. If the tax is zero or less,
+// it returns zero. This adjuster does not refer to the tax payer's finances
+func (sa SurtaxAdjuster) Adjustment(_ *TaxPayer, tax float64) float64 {
+
+	if tax <= 0.0 {
+		return 0.0
+	}
+	return sa.Rates.Apply(tax)
+}
+
+// Description returns a short description of the surtax
+func (sa SurtaxAdjuster) Description() string {
+	return sa.Desc
+}
+
+// Validate checks if the adjuster is valid for use
+func (sa SurtaxAdjuster) Validate() error {
+	return sa.Rates.Validate()
+}
+
+// Clone returns a deep copy of this adjuster
+func (sa SurtaxAdjuster) Clone() TaxAdjuster {
+	return SurtaxAdjuster{
+		Rates: sa.Rates.Clone(),
+		Desc:  sa.Desc,
+	}
+}
@@ -0,0 +1,321 @@
+package tax
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/pkg/errors"
+)
+
+func TestSurtaxAdjuster_Adjustment(t *testing.T) {
+
+	adjuster := SurtaxAdjuster{
+		Rates: core.WeightedBrackets{
+			0.20: core.Bracket{4000, math.Inf(1)},
+			0.36: core.Bracket{5000, math.Inf(1)},
+		},
+	}
+
+	cases := []struct {
+		tax      float64
+		expected float64
+	}{
+		{tax: -1000, expected: 0},
+		{tax: 3000, expected: 0},
+		{tax: 4500, expected: 100},
+		{tax: 6000, expected: 400 + 360},
+	}
+
+	for i, c := range cases {
+		actual := adjuster.Adjustment(nil, c.tax)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("case %d: unexpected surtax\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+}
+
+func TestSurtaxAdjuster_Validate_Clone(t *testing.T) {
+
+	original := SurtaxAdjuster{
+		Rates: core.WeightedBrackets{0.20: core.Bracket{200, 100}},
+		Desc:  "surtax",
+	}
+
+	err := original.Validate()
+	if errors.Cause(err) != core.ErrBoundsReversed {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrBoundsReversed, err)
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Fatal("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	original.Rates[0.20] = core.Bracket{100, 200}
+	if clone.Validate() == nil {
+		t.Error("expected changes to original adjuster to not affect clone")
+	}
+
+	if clone.Description() != "surtax" {
+		t.Errorf("unexpected description: %q", clone.Description())
+	}
+}
+
+func TestIncomePremiumAdjuster_Adjustment(t *testing.T) {
+
+	adjuster := IncomePremiumAdjuster{
+		Tiers: []PremiumTier{
+			{Rate: 0.06, Bracket: core.Bracket{20000, 25000}},
+			{Rate: 0.06, Bracket: core.Bracket{36000, 38500}},
+			{Rate: 0.25, Bracket: core.Bracket{48000, 48600}},
+		},
+	}
+
+	cases := []struct {
+		netIncome float64
+		expected  float64
+	}{
+		{netIncome: 15000, expected: 0},
+		{netIncome: 22000, expected: 120},
+		{netIncome: 30000, expected: 300},
+		{netIncome: 37000, expected: 360},
+		{netIncome: 48200, expected: 500},
+		{netIncome: 100000, expected: 600},
+	}
+
+	for i, c := range cases {
+		actual := adjuster.Adjustment(&TaxPayer{NetIncome: c.netIncome}, 0)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("case %d: unexpected premium\nwant: %.2f\n got: %.2f", i, c.expected, actual)
+		}
+	}
+
+	if adjuster.Adjustment(nil, 1000) != 0 {
+		t.Error("expected zero premium for nil tax payer")
+	}
+}
+
+func TestIncomePremiumAdjuster_Validate_Clone(t *testing.T) {
+
+	original := IncomePremiumAdjuster{
+		Tiers: []PremiumTier{{Rate: 0.06, Bracket: core.Bracket{25000, 20000}}},
+		Desc:  "premium",
+	}
+
+	err := original.Validate()
+	if errors.Cause(err) != core.ErrBoundsReversed {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrBoundsReversed, err)
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Fatal("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	original.Tiers[0].Bracket = core.Bracket{20000, 25000}
+	if clone.Validate() == nil {
+		t.Error("expected changes to original adjuster to not affect clone")
+	}
+
+	if clone.Description() != "premium" {
+		t.Errorf("unexpected description: %q", clone.Description())
+	}
+}
+
+func TestLowIncomeReductionAdjuster_Adjustment(t *testing.T) {
+
+	adjuster := LowIncomeReductionAdjuster{
+		BaseAmount:            250,
+		DependentAmount:       450,
+		MaxDependentAgeMonths: 18 * 12,
+	}
+
+	child := &human.Person{AgeMonths: 12}
+	adult := &human.Person{AgeMonths: 20 * 12}
+	disabledAdult := &human.Person{AgeMonths: 20 * 12, DisabilityEligible: true}
+	finances := core.NewFinancerNop()
+
+	cases := []struct {
+		name     string
+		tp       *TaxPayer
+		tax      float64
+		expected float64
+	}{
+		{
+			name:     "nil-tax-payer",
+			tp:       nil,
+			tax:      100,
+			expected: 0,
+		},
+		{
+			name:     "no-tax",
+			tp:       &TaxPayer{},
+			tax:      0,
+			expected: 0,
+		},
+		{
+			name:     "full-reduction",
+			tp:       &TaxPayer{},
+			tax:      200,
+			expected: -200,
+		},
+		{
+			name:     "partial-reduction",
+			tp:       &TaxPayer{},
+			tax:      400,
+			expected: -100,
+		},
+		{
+			name:     "no-reduction",
+			tp:       &TaxPayer{},
+			tax:      600,
+			expected: 0,
+		},
+		{
+			name:     "eligible-dependents",
+			tp:       &TaxPayer{Dependents: []*human.Person{child, nil, adult}},
+			tax:      1000,
+			expected: -400,
+		},
+		{
+			name:     "dependents-with-disabilities",
+			tp:       &TaxPayer{Dependents: []*human.Person{child, disabledAdult}},
+			tax:      2000,
+			expected: -300,
+		},
+		{
+			name: "dependents-claimed-by-spouse",
+			tp: &TaxPayer{
+				NetIncome:       1000,
+				SpouseFinances:  finances,
+				SpouseNetIncome: 2000,
+				Dependents:      []*human.Person{child},
+			},
+			tax:      400,
+			expected: -100,
+		},
+	}
+
+	for _, c := range cases {
+		actual := adjuster.Adjustment(c.tp, c.tax)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("%s: unexpected reduction\nwant: %.2f\n got: %.2f", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestLowIncomeReductionAdjuster_Validate_Clone(t *testing.T) {
+
+	err := LowIncomeReductionAdjuster{BaseAmount: -1}.Validate()
+	if errors.Cause(err) != core.ErrValNeg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrValNeg, err)
+	}
+
+	original := LowIncomeReductionAdjuster{BaseAmount: 250, DependentAmount: 450, Desc: "reduction"}
+	if err := original.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Error("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	if clone.Description() != "reduction" {
+		t.Errorf("unexpected description: %q", clone.Description())
+	}
+}
+
+func TestRecoveryTaxAdjuster_Adjustment(t *testing.T) {
+
+	adjuster := RecoveryTaxAdjuster{
+		Rate:          0.15,
+		Threshold:     80000,
+		BenefitSource: core.IncSrcOAS,
+	}
+
+	finances := &testFinancer{onTotalAmount: 7000}
+
+	cases := []struct {
+		name     string
+		tp       *TaxPayer
+		expected float64
+	}{
+		{
+			name:     "nil-tax-payer",
+			tp:       nil,
+			expected: 0,
+		},
+		{
+			name:     "nil-finances",
+			tp:       &TaxPayer{NetIncome: 100000},
+			expected: 0,
+		},
+		{
+			name:     "below-threshold",
+			tp:       &TaxPayer{Finances: finances, NetIncome: 70000},
+			expected: 0,
+		},
+		{
+			name:     "above-threshold",
+			tp:       &TaxPayer{Finances: finances, NetIncome: 90000},
+			expected: 1500,
+		},
+		{
+			name:     "full-recovery",
+			tp:       &TaxPayer{Finances: finances, NetIncome: 150000},
+			expected: 7000,
+		},
+		{
+			name:     "no-benefit",
+			tp:       &TaxPayer{Finances: core.NewFinancerNop(), NetIncome: 150000},
+			expected: 0,
+		},
+	}
+
+	for _, c := range cases {
+		actual := adjuster.Adjustment(c.tp, 1000)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("%s: unexpected recovery tax\nwant: %.2f\n got: %.2f", c.name, c.expected, actual)
+		}
+	}
+
+	if len(finances.onTotalAmountCapturedArg) != 1 || finances.onTotalAmountCapturedArg[0] != core.IncSrcOAS {
+		t.Errorf("unexpected benefit source: %v", finances.onTotalAmountCapturedArg)
+	}
+}
+
+func TestRecoveryTaxAdjuster_Validate_Clone(t *testing.T) {
+
+	err := RecoveryTaxAdjuster{Rate: 1.5}.Validate()
+	if errors.Cause(err) != ErrInvalidTaxArg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidTaxArg, err)
+	}
+
+	err = RecoveryTaxAdjuster{Rate: 0.15, Threshold: -1}.Validate()
+	if errors.Cause(err) != core.ErrValNeg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrValNeg, err)
+	}
+
+	original := RecoveryTaxAdjuster{Rate: 0.15, Threshold: 80000, BenefitSource: core.IncSrcOAS, Desc: "recovery"}
+	if err := original.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Error("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	if clone.Description() != "recovery" {
+		t.Errorf("unexpected description: %q", clone.Description())
+	}
+}
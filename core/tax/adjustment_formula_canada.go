@@ -0,0 +1,99 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// compile-time check for interface implementation
+var _ AdjustmentFormula = (*CanadianAdjustmentFormula)(nil)
+
+// CanadianAdjustmentFormula is used to calculate the adjustments on the basic
+// tax of Canadian jurisdictions, e.g. Ontario's surtax and health premium
+type CanadianAdjustmentFormula struct {
+	// OrderedAdjusters are applied one by one in the given order, where each
+	// adjuster receives the tax as adjusted by the preceding adjusters
+	OrderedAdjusters []TaxAdjuster
+	// TaxYear is the tax year this adjustment formula is associated with
+	TaxYear uint
+	// TaxRegion is the tax region this adjustment formula is associated with
+	TaxRegion core.Region
+}
+
+// Apply applies the adjusters of this formula on the given basic tax in order
+// and returns the resulting adjustments. If tax payer is nil, it returns nil
+func (caf *CanadianAdjustmentFormula) Apply(tp *TaxPayer, basicTax float64) []core.TaxAdjustment {
+
+	if tp == nil || tp.Finances == nil {
+		return nil
+	}
+
+	adjustments := make([]core.TaxAdjustment, 0, len(caf.OrderedAdjusters))
+	tax := basicTax
+
+	for _, adjuster := range caf.OrderedAdjusters {
+
+		amount := adjuster.Adjustment(tp, tax)
+		tax += amount
+
+		adjustments = append(adjustments, core.TaxAdjustment{
+			Description: adjuster.Description(),
+			Amount:      amount,
+		})
+	}
+
+	return adjustments
+}
+
+// Year returns the tax year for which this adjustment formula is associated with
+func (caf *CanadianAdjustmentFormula) Year() uint {
+	return caf.TaxYear
+}
+
+// Region returns the tax region for which this adjustment formula is
+// associated with
+func (caf *CanadianAdjustmentFormula) Region() core.Region {
+	return caf.TaxRegion
+}
+
+// Validate checks if the formula is valid for use
+func (caf *CanadianAdjustmentFormula) Validate() error {
+
+	for i, adjuster := range caf.OrderedAdjusters {
+
+		if adjuster == nil {
+			return errors.Wrapf(ErrNoAdjuster, "index %d: invalid adjuster", i)
+		}
+
+		err := adjuster.Validate()
+		if err != nil {
+			return errors.Wrapf(err, "index %d: invalid adjuster", i)
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a copy of this adjustment formula
+func (caf *CanadianAdjustmentFormula) Clone() AdjustmentFormula {
+
+	if caf == nil {
+		return nil
+	}
+
+	clone := &CanadianAdjustmentFormula{
+		TaxYear:   caf.TaxYear,
+		TaxRegion: caf.TaxRegion,
+	}
+
+	if caf.OrderedAdjusters != nil {
+		clone.OrderedAdjusters = make([]TaxAdjuster, len(caf.OrderedAdjusters))
+		for i, adjuster := range caf.OrderedAdjusters {
+			if adjuster != nil {
+				clone.OrderedAdjusters[i] = adjuster.Clone()
+			}
+		}
+	}
+
+	return clone
+}
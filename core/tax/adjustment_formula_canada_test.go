@@ -0,0 +1,133 @@
+package tax
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+func TestCanadianAdjustmentFormula_Apply(t *testing.T) {
+
+	surtax := &testAdjuster{onAdjustment: 100}
+	reduction := &testAdjuster{onAdjustment: -30}
+	caf := &CanadianAdjustmentFormula{
+		OrderedAdjusters: []TaxAdjuster{surtax, reduction},
+	}
+
+	actual := caf.Apply(&TaxPayer{Finances: core.NewFinancerNop()}, 1000)
+	expected := []core.TaxAdjustment{
+		{Description: "test", Amount: 100},
+		{Description: "test", Amount: -30},
+	}
+
+	diff := deep.Equal(actual, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	diff = deep.Equal(reduction.taxPassedOnAdjustment, []float64{1100})
+	if diff != nil {
+		t.Error("expected adjusters to receive the tax adjusted by preceding adjusters\n" +
+			strings.Join(diff, "\n"),
+		)
+	}
+}
+
+func TestCanadianAdjustmentFormula_Apply_Nil_finances(t *testing.T) {
+
+	caf := &CanadianAdjustmentFormula{OrderedAdjusters: []TaxAdjuster{&testAdjuster{}}}
+
+	if caf.Apply(nil, 1000) != nil {
+		t.Error("expected nil adjustments if tax payer is nil")
+	}
+	if caf.Apply(&TaxPayer{}, 1000) != nil {
+		t.Error("expected nil adjustments if finances is nil")
+	}
+}
+
+func TestCanadianAdjustmentFormula_Validate(t *testing.T) {
+
+	simulatedErr := errors.New("simulated error")
+	cases := []struct {
+		name string
+		caf  *CanadianAdjustmentFormula
+		err  error
+	}{
+		{
+			name: "valid",
+			caf:  &CanadianAdjustmentFormula{OrderedAdjusters: []TaxAdjuster{&testAdjuster{}}},
+			err:  nil,
+		},
+		{
+			name: "nil-adjuster",
+			caf:  &CanadianAdjustmentFormula{OrderedAdjusters: []TaxAdjuster{nil}},
+			err:  ErrNoAdjuster,
+		},
+		{
+			name: "invalid-adjuster",
+			caf: &CanadianAdjustmentFormula{
+				OrderedAdjusters: []TaxAdjuster{&testAdjuster{onValidate: simulatedErr}},
+			},
+			err: simulatedErr,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+			err := c.caf.Validate()
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCanadianAdjustmentFormula_Clone(t *testing.T) {
+
+	var original *CanadianAdjustmentFormula
+	if original.Clone() != nil {
+		t.Error("cloning nil adjustment formula should return nil")
+	}
+
+	original = &CanadianAdjustmentFormula{
+		OrderedAdjusters: []TaxAdjuster{
+			SurtaxAdjuster{Rates: core.WeightedBrackets{0.20: core.Bracket{100, 200}}},
+		},
+		TaxYear:   2019,
+		TaxRegion: core.RegionON,
+	}
+
+	clone := original.Clone()
+	diff := deep.Equal(clone, original)
+	if diff != nil {
+		t.Fatal("clone does not match original\n" + strings.Join(diff, "\n"))
+	}
+
+	original.OrderedAdjusters[0].(SurtaxAdjuster).Rates[0.36] = core.Bracket{200, 300}
+	if len(clone.(*CanadianAdjustmentFormula).OrderedAdjusters[0].(SurtaxAdjuster).Rates) != 1 {
+		t.Error("expected changes to original formula to not affect clone formula")
+	}
+
+	if clone.Year() != 2019 || clone.Region() != core.RegionON {
+		t.Error("expected clone to have the same year and region of the original")
+	}
+}
+
+func TestCanadianAdjustmentFormula_NumFieldsUnchanged(t *testing.T) {
+
+	dummy := CanadianAdjustmentFormula{}
+	s := reflect.ValueOf(&dummy).Elem()
+	if s.NumField() != 3 {
+		t.Fatal(
+			"number of struct fields changed. Please update the clone method of " +
+				"this type as well as associated test. Next, update this test with " +
+				"the new number of fields",
+		)
+	}
+}
@@ -58,7 +58,7 @@ func TestCanadianContraFormula_Apply(t *testing.T) {
 			AmountInitial:   1000,
 			AmountRemaining: 1000,
 			AmountUsed:      0,
-			CrRule:          core.CreditRule{t.Name(), 123},
+			CrRule:          core.CreditRule{CrSource: t.Name(), Type: 123},
 			Desc:            "test",
 			FinancialSource: 1,
 			Ref:             f,
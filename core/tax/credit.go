@@ -123,3 +123,47 @@ func (tcg taxCreditGroup) typecast() []core.TaxCredit {
 	}
 	return typed
 }
+
+// reboundCredit is a tax credit that references a financer other than the one
+// referenced by the underlying tax credit
+type reboundCredit struct {
+	core.TaxCredit
+	ref core.Financer
+}
+
+// ReferenceFinancer returns the financer this credit was rebound to
+func (rc *reboundCredit) ReferenceFinancer() core.Financer {
+	return rc.ref
+}
+
+// ShallowCopy returns a shallow copy of the underlying tax credit that is bound
+// to the same financer as this credit
+func (rc *reboundCredit) ShallowCopy() core.TaxCredit {
+	return &reboundCredit{TaxCredit: rc.TaxCredit.ShallowCopy(), ref: rc.ref}
+}
+
+// rebindCredits returns shallow copies of the given credits, where the credits
+// referencing the spouses in 'from' reference the counterpart spouses in 'to'.
+// Credits that do not reference a spouse in 'from' are copied as is
+func rebindCredits(credits []core.TaxCredit, from, to core.HouseholdFinances) []core.TaxCredit {
+
+	rebound := make([]core.TaxCredit, 0, len(credits))
+	for _, cr := range credits {
+
+		if cr == nil {
+			continue
+		}
+
+		ref := cr.ReferenceFinancer()
+		switch {
+		case ref != nil && ref == from.SpouseA():
+			rebound = append(rebound, &reboundCredit{cr.ShallowCopy(), to.SpouseA()})
+		case ref != nil && ref == from.SpouseB():
+			rebound = append(rebound, &reboundCredit{cr.ShallowCopy(), to.SpouseB()})
+		default:
+			rebound = append(rebound, cr.ShallowCopy())
+		}
+	}
+
+	return rebound
+}
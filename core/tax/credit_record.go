@@ -0,0 +1,136 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// CreditOwner identifies the spouse a tax credit belongs to. Unlike the
+// financer referenced by a tax credit, it does not change from one tax year
+// to another
+type CreditOwner string
+
+const (
+	// OwnerNone indicates credits that do not belong to a spouse
+	OwnerNone CreditOwner = ""
+	// OwnerSpouseA indicates credits that belong to spouse A
+	OwnerSpouseA CreditOwner = "spouse-a"
+	// OwnerSpouseB indicates credits that belong to spouse B
+	OwnerSpouseB CreditOwner = "spouse-b"
+)
+
+// CreditRecord is a serializable form of a tax credit, where the financer the
+// credit belongs to is identified by its owner. It is used to carry forward
+// tax credits from the tax return of one year to the next
+type CreditRecord struct {
+	// Owner is the spouse this credit belongs to
+	Owner CreditOwner `json:"owner"`
+	// Year is the tax year from which the tax credit was calculated
+	Year uint `json:"year"`
+	// Region is the tax region for which the tax credit was calculated
+	Region core.Region `json:"region"`
+	// Source is the associated financial source
+	Source core.FinancialSource `json:"source"`
+	// CreditSource is the name of the credit source of the credit rule
+	CreditSource string `json:"credit_source"`
+	// RuleType is the method of using the credit amount
+	RuleType core.CreditRuleType `json:"rule_type"`
+	// Initial is the initial amount
+	Initial float64 `json:"initial"`
+	// Used is the amount used by tax calculators
+	Used float64 `json:"used"`
+	// Remaining is the remaining usable amount
+	Remaining float64 `json:"remaining"`
+	// Description is the description/reason for the tax credit
+	Description string `json:"description,omitempty"`
+}
+
+// NewCreditRecords returns records of the given tax credits, where the owner
+// of each credit is the spouse in the given finances the credit references.
+// Credits that reference neither spouse have no owner. Nil credits are skipped
+func NewCreditRecords(credits []core.TaxCredit, finances core.HouseholdFinances) []CreditRecord {
+
+	var spouseA, spouseB core.Financer
+	if finances != nil {
+		spouseA, spouseB = finances.SpouseA(), finances.SpouseB()
+	}
+
+	records := make([]CreditRecord, 0, len(credits))
+	for _, cr := range credits {
+
+		if cr == nil {
+			continue
+		}
+
+		owner := OwnerNone
+		ref := cr.ReferenceFinancer()
+		switch {
+		case ref != nil && ref == spouseA:
+			owner = OwnerSpouseA
+		case ref != nil && ref == spouseB:
+			owner = OwnerSpouseB
+		}
+
+		initial, used, remaining := cr.Amounts()
+		records = append(records, CreditRecord{
+			Owner:        owner,
+			Year:         cr.Year(),
+			Region:       cr.Region(),
+			Source:       cr.Source(),
+			CreditSource: cr.Rule().CrSource,
+			RuleType:     cr.Rule().Type,
+			Initial:      initial,
+			Used:         used,
+			Remaining:    remaining,
+			Description:  cr.Description(),
+		})
+	}
+
+	return records
+}
+
+// BindCreditRecords returns tax credits from the given records, where each
+// credit references the spouse in the given finances that owns the record.
+// The returned credits can be used with the given finances in the calculators
+// of the same or a later tax year. If a record is owned by a spouse that is
+// nil in the given finances, or if its owner is unknown, it returns an error
+func BindCreditRecords(records []CreditRecord, finances core.HouseholdFinances) ([]core.TaxCredit, error) {
+
+	var spouseA, spouseB core.Financer
+	if finances != nil {
+		spouseA, spouseB = finances.SpouseA(), finances.SpouseB()
+	}
+
+	credits := make([]core.TaxCredit, len(records))
+	for i, r := range records {
+
+		var ref core.Financer
+		switch r.Owner {
+		case OwnerNone:
+		case OwnerSpouseA:
+			ref = spouseA
+		case OwnerSpouseB:
+			ref = spouseB
+		default:
+			return nil, errors.Wrapf(ErrInvalidTaxArg, "index %d: unknown credit owner %q", i, r.Owner)
+		}
+
+		if r.Owner != OwnerNone && ref == nil {
+			return nil, errors.Wrapf(ErrInvalidTaxArg, "index %d: no finances for credit owner %q", i, r.Owner)
+		}
+
+		credits[i] = &TaxCredit{
+			AmountInitial:   r.Initial,
+			AmountUsed:      r.Used,
+			AmountRemaining: r.Remaining,
+			FinancialSource: r.Source,
+			CrRule:          core.CreditRule{CrSource: r.CreditSource, Type: r.RuleType},
+			Ref:             ref,
+			TaxYear:         r.Year,
+			TaxRegion:       r.Region,
+			Desc:            r.Description,
+		}
+	}
+
+	return credits, nil
+}
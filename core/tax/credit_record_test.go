@@ -0,0 +1,121 @@
+package tax
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/finance"
+	"github.com/pkg/errors"
+)
+
+func TestCreditRecords_RoundTrip(t *testing.T) {
+
+	lastYear := finance.NewHouseholdFinances(
+		finance.NewIndividualFinances(), finance.NewIndividualFinances(),
+	)
+
+	credits := []core.TaxCredit{
+		&TaxCredit{
+			AmountInitial:   1000,
+			AmountUsed:      400,
+			AmountRemaining: 600,
+			FinancialSource: core.MiscSrcTuition,
+			CrRule:          core.CreditRule{CrSource: "tuition", Type: core.CrRuleTypeCanCarryForward},
+			Ref:             lastYear.SpouseB(),
+			TaxYear:         2019,
+			TaxRegion:       core.RegionCA,
+			Desc:            "tuition amount",
+		},
+		nil,
+		&TaxCredit{
+			AmountInitial: 100,
+			CrRule:        core.CreditRule{CrSource: "other", Type: core.CrRuleTypeCashable},
+			TaxYear:       2019,
+			TaxRegion:     core.RegionCA,
+		},
+	}
+
+	records := NewCreditRecords(credits, lastYear)
+	if len(records) != 2 {
+		t.Fatalf("expected nil credits to be skipped, got %d records", len(records))
+	}
+	if records[0].Owner != OwnerSpouseB || records[1].Owner != OwnerNone {
+		t.Errorf("unexpected owners: %q, %q", records[0].Owner, records[1].Owner)
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded []CreditRecord
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(decoded, records)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	thisYear := finance.NewHouseholdFinances(
+		finance.NewIndividualFinances(), finance.NewIndividualFinances(),
+	)
+
+	rebound, err := BindCreditRecords(decoded, thisYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rebound[0].ReferenceFinancer() != thisYear.SpouseB() {
+		t.Error("expected credit to reference the finances of the same spouse this year")
+	}
+	if rebound[1].ReferenceFinancer() != nil {
+		t.Error("expected credit without owner to reference no finances")
+	}
+
+	expected := credits[0].ShallowCopy().(*TaxCredit)
+	expected.Ref = thisYear.SpouseB()
+	diff = deep.Equal(rebound[0], core.TaxCredit(expected))
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestBindCreditRecords_Errors(t *testing.T) {
+
+	single := finance.NewHouseholdFinances(finance.NewIndividualFinances(), nil)
+
+	_, err := BindCreditRecords([]CreditRecord{{Owner: OwnerSpouseB}}, single)
+	if errors.Cause(err) != ErrInvalidTaxArg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidTaxArg, err)
+	}
+
+	_, err = BindCreditRecords([]CreditRecord{{Owner: "spouse-c"}}, single)
+	if errors.Cause(err) != ErrInvalidTaxArg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidTaxArg, err)
+	}
+
+	_, err = BindCreditRecords([]CreditRecord{{Owner: OwnerSpouseA}}, nil)
+	if errors.Cause(err) != ErrInvalidTaxArg {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidTaxArg, err)
+	}
+}
+
+func TestCreditRecord_JSON_Errors(t *testing.T) {
+
+	var record CreditRecord
+	err := json.Unmarshal([]byte(`{"rule_type": "magic"}`), &record)
+	if errors.Cause(err) != core.ErrUnknownCreditRuleType {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrUnknownCreditRuleType, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"source": "lottery"}`), &record)
+	if errors.Cause(err) != core.ErrUnknownSource {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", core.ErrUnknownSource, err)
+	}
+}
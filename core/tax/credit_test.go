@@ -271,3 +271,44 @@ func TestTaxCredit_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestRebindCredits(t *testing.T) {
+
+	from := &testHouseholdFinances{
+		onSpouseA: core.NewFinancerNop(),
+		onSpouseB: core.NewFinancerNop(),
+	}
+	to := &testHouseholdFinances{
+		onSpouseA: core.NewFinancerNop(),
+		onSpouseB: core.NewFinancerNop(),
+	}
+
+	crA := &TaxCredit{AmountRemaining: 100, Ref: from.SpouseA()}
+	crB := &TaxCredit{AmountRemaining: 200, Ref: from.SpouseB()}
+	crOther := &TaxCredit{AmountRemaining: 300, Ref: core.NewFinancerNop()}
+
+	rebound := rebindCredits([]core.TaxCredit{crA, nil, crB, crOther}, from, to)
+	if len(rebound) != 3 {
+		t.Fatalf("expected 3 credits, got: %d", len(rebound))
+	}
+
+	if rebound[0].ReferenceFinancer() != to.SpouseA() {
+		t.Error("expected credit to be rebound to spouse A of the new finances")
+	}
+	if rebound[1].ReferenceFinancer() != to.SpouseB() {
+		t.Error("expected credit to be rebound to spouse B of the new finances")
+	}
+	if rebound[2].ReferenceFinancer() != crOther.Ref {
+		t.Error("expected credit referencing foreign finances to keep its reference")
+	}
+
+	rebound[0].SetAmounts(100, 100, 0)
+	if _, _, remaining := crA.Amounts(); remaining != 100 {
+		t.Error("expected changes to rebound credits to not affect the originals")
+	}
+
+	copied := rebound[0].ShallowCopy()
+	if copied.ReferenceFinancer() != to.SpouseA() {
+		t.Error("expected copies of rebound credits to keep the new reference")
+	}
+}
@@ -0,0 +1,47 @@
+package tax
+
+// LivingAloneCreditor is a Creditor that returns a tax credit for tax payers
+// who have no spouse, e.g. Quebec's amount for a person living alone. The
+// amount is reduced by a percentage of the tax payer's net income in excess of
+// a threshold
+type LivingAloneCreditor struct {
+	// the maximum amount before reduction
+	BaseAmount float64
+	// the net income above which the amount is reduced
+	ReductionThreshold float64
+	// the percentage of the net income above the threshold to reduce
+	ReductionRate float64
+	// the weight to apply on the reduced amount
+	Weight float64
+	CreditDescriptor
+}
+
+// TaxCredit returns the weighted amount after reduction. If tax payer is nil
+// or has a spouse, it returns zero
+func (lac LivingAloneCreditor) TaxCredit(tp *TaxPayer) float64 {
+
+	if tp == nil || tp.SpouseFinances != nil {
+		return 0.0
+	}
+
+	amount := lac.BaseAmount
+	if tp.NetIncome > lac.ReductionThreshold {
+		amount -= lac.ReductionRate * (tp.NetIncome - lac.ReductionThreshold)
+	}
+
+	if amount <= 0.0 {
+		return 0.0
+	}
+
+	return lac.Weight * amount
+}
+
+// Clone returns a deep copy of this creditor
+func (lac LivingAloneCreditor) Clone() Creditor {
+	return lac.clone()
+}
+
+// clone returns a copy of this creditor
+func (lac LivingAloneCreditor) clone() LivingAloneCreditor {
+	return lac
+}
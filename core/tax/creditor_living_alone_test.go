@@ -0,0 +1,65 @@
+package tax
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+)
+
+func TestLivingAloneCreditor_TaxCredit(t *testing.T) {
+
+	creditor := LivingAloneCreditor{
+		BaseAmount:         1000,
+		ReductionThreshold: 30000,
+		ReductionRate:      0.20,
+		Weight:             0.15,
+	}
+
+	cases := []struct {
+		name     string
+		tp       *TaxPayer
+		expected float64
+	}{
+		{name: "nil-tax-payer", tp: nil, expected: 0},
+		{name: "has-spouse", tp: &TaxPayer{SpouseFinances: core.NewFinancerNop()}, expected: 0},
+		{name: "below-threshold", tp: &TaxPayer{NetIncome: 20000}, expected: 150},
+		{name: "reduced", tp: &TaxPayer{NetIncome: 32000}, expected: 0.15 * 600},
+		{name: "fully-reduced", tp: &TaxPayer{NetIncome: 40000}, expected: 0},
+	}
+
+	for _, c := range cases {
+		actual := creditor.TaxCredit(c.tp)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("%s: unexpected result\nwant: %.2f\n got: %.2f", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestLivingAloneCreditor_clone(t *testing.T) {
+
+	original := LivingAloneCreditor{
+		BaseAmount:         1000,
+		ReductionThreshold: 30000,
+		ReductionRate:      0.20,
+		Weight:             0.15,
+		CreditDescriptor: CreditDescriptor{
+			CreditDescription: t.Name(),
+			CreditRule:        core.CreditRule{CrSource: "test", Type: 3},
+		},
+	}
+
+	cloneInternal := original.clone()
+	diff := deep.Equal(original, cloneInternal)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+
+	cloneExported := original.Clone()
+	diff = deep.Equal(original, cloneExported)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+}
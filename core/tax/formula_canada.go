@@ -16,6 +16,11 @@ func (cf *CanadianFormula) Apply(netIncome float64) float64 {
 	return cf.WeightedBrackets.Apply(netIncome)
 }
 
+// Slice slices the given net income into the brackets of this formula
+func (cf *CanadianFormula) Slice(netIncome float64) []core.BracketSlice {
+	return cf.WeightedBrackets.Slice(netIncome)
+}
+
 // Year returns the tax year for this formula
 func (cf *CanadianFormula) Year() uint {
 	return cf.TaxYear
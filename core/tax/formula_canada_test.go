@@ -115,3 +115,29 @@ func TestCanadianFormula_NumFieldsUnchanged(t *testing.T) {
 		)
 	}
 }
+
+func TestCanadianFormula_Slice(t *testing.T) {
+
+	formula := &CanadianFormula{
+		WeightedBrackets: core.WeightedBrackets{
+			0.10: core.Bracket{0, 1000},
+			0.20: core.Bracket{1000, math.Inf(1)},
+		},
+	}
+
+	slices := formula.Slice(1500)
+	if len(slices) != 2 {
+		t.Fatalf("expected 2 slices, got: %d", len(slices))
+	}
+
+	var total float64
+	for _, s := range slices {
+		total += s.WeightedAmount
+	}
+	if total != formula.Apply(1500) {
+		t.Errorf(
+			"expected sum of weighted amounts to equal applying the formula\nwant: %.2f\n got: %.2f",
+			formula.Apply(1500), total,
+		)
+	}
+}
@@ -49,6 +49,7 @@ func (tic *testIncomeCalculator) SetFinances(core.Financer) {
 
 type testTaxFormula struct {
 	onApply    float64
+	onSlice    []core.BracketSlice
 	onValidate error
 	onYear     uint
 	onRegion   core.Region
@@ -57,6 +58,9 @@ type testTaxFormula struct {
 func (tcb *testTaxFormula) Apply(_ float64) float64 {
 	return tcb.onApply
 }
+func (tcb *testTaxFormula) Slice(_ float64) []core.BracketSlice {
+	return tcb.onSlice
+}
 func (tcb *testTaxFormula) Validate() error {
 	return tcb.onValidate
 }
@@ -213,3 +217,46 @@ func (tf *testFinancer) AllSources() []core.FinancialSource {
 func (tf *testFinancer) Clone() core.FinanceMutator {
 	return tf.onClone
 }
+
+type testAdjuster struct {
+	onAdjustment          float64
+	onValidate            error
+	taxPassedOnAdjustment []float64
+}
+
+func (ta *testAdjuster) Adjustment(_ *TaxPayer, tax float64) float64 {
+	ta.taxPassedOnAdjustment = append(ta.taxPassedOnAdjustment, tax)
+	return ta.onAdjustment
+}
+func (ta *testAdjuster) Description() string {
+	return "test"
+}
+func (ta *testAdjuster) Validate() error {
+	return ta.onValidate
+}
+func (ta *testAdjuster) Clone() TaxAdjuster {
+	return ta
+}
+
+type testAdjustmentFormula struct {
+	onApply    []core.TaxAdjustment
+	onValidate error
+	onYear     uint
+	onRegion   core.Region
+}
+
+func (taf *testAdjustmentFormula) Apply(_ *TaxPayer, _ float64) []core.TaxAdjustment {
+	return taf.onApply
+}
+func (taf *testAdjustmentFormula) Validate() error {
+	return taf.onValidate
+}
+func (taf *testAdjustmentFormula) Region() core.Region {
+	return taf.onRegion
+}
+func (taf *testAdjustmentFormula) Year() uint {
+	return taf.onYear
+}
+func (taf *testAdjustmentFormula) Clone() AdjustmentFormula {
+	return taf
+}
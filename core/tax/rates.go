@@ -0,0 +1,77 @@
+package tax
+
+import (
+	"github.com/malkhamis/quantax/core"
+)
+
+// marginalDelta is the amount added to a financial source in order to compute
+// its effective marginal tax rate
+const marginalDelta = 1.0
+
+// statutoryRate returns the sum of the rates of all the brackets which the
+// given net income falls within
+func statutoryRate(slices []core.BracketSlice, netIncome float64) float64 {
+
+	var rate float64
+	for _, s := range slices {
+		if netIncome >= s.Bracket.Lower() && netIncome < s.Bracket.Upper() {
+			rate += s.Rate
+		}
+	}
+	return rate
+}
+
+// averageRate returns the given tax divided by the given income. If income is
+// zero or less, it returns zero
+func averageRate(tax, income float64) float64 {
+	if income <= 0.0 {
+		return 0.0
+	}
+	return tax / income
+}
+
+// effectiveMarginalRates returns the effective marginal tax rates of the given
+// sources for both spouses. The rates are computed on clones of the given
+// finances, and the calculator is set with the given finances and credits on
+// return. If a spouse's finances are nil, the spouse's rates are nil
+func effectiveMarginalRates(calc core.TaxCalculator, finances core.HouseholdFinances, credits []core.TaxCredit, sources []core.FinancialSource) (ratesA, ratesB map[core.FinancialSource]float64) {
+
+	defer calc.SetFinances(finances, credits)
+
+	if finances.SpouseA() != nil {
+		ratesA = make(map[core.FinancialSource]float64, len(sources))
+		for _, src := range sources {
+			ratesA[src] = effectiveMarginalRate(calc, finances, credits, src, false)
+		}
+	}
+
+	if finances.SpouseB() != nil {
+		ratesB = make(map[core.FinancialSource]float64, len(sources))
+		for _, src := range sources {
+			ratesB[src] = effectiveMarginalRate(calc, finances, credits, src, true)
+		}
+	}
+
+	return ratesA, ratesB
+}
+
+// effectiveMarginalRate returns the change in the household's payable tax per
+// dollar added to the given source of the target spouse. The change is
+// computed on a clone of the given finances
+func effectiveMarginalRate(calc core.TaxCalculator, finances core.HouseholdFinances, credits []core.TaxCredit, src core.FinancialSource, isTargetSpouseB bool) float64 {
+
+	clone := finances.Clone()
+	calc.SetFinances(clone, rebindCredits(credits, finances, clone))
+
+	target := clone.MutableSpouseA()
+	if isTargetSpouseB {
+		target = clone.MutableSpouseB()
+	}
+
+	taxBeforeA, taxBeforeB, _ := calc.TaxPayable()
+	target.AddAmount(src, marginalDelta)
+	taxAfterA, taxAfterB, _ := calc.TaxPayable()
+
+	diff := (taxAfterA + taxAfterB) - (taxBeforeA + taxBeforeB)
+	return diff / marginalDelta
+}
@@ -0,0 +1,132 @@
+package tax
+
+import (
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/finance"
+	"github.com/malkhamis/quantax/core/income"
+)
+
+func TestStatutoryRate(t *testing.T) {
+
+	slices := core.WeightedBrackets{
+		0.10: core.Bracket{0, 100},
+		0.20: core.Bracket{100, math.Inf(1)},
+		0.05: core.Bracket{50, 150},
+	}.Slice(0)
+
+	cases := []struct {
+		netIncome float64
+		expected  float64
+	}{
+		{netIncome: -10, expected: 0.0},
+		{netIncome: 0, expected: 0.10},
+		{netIncome: 75, expected: 0.15},
+		{netIncome: 100, expected: 0.25},
+		{netIncome: 1000, expected: 0.20},
+	}
+
+	for _, c := range cases {
+		actual := statutoryRate(slices, c.netIncome)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf(
+				"income %.2f: unexpected rate\nwant: %.4f\n got: %.4f",
+				c.netIncome, c.expected, actual,
+			)
+		}
+	}
+}
+
+func TestAverageRate(t *testing.T) {
+
+	if actual := averageRate(1000, 0); actual != 0 {
+		t.Errorf("expected zero rate for zero income, got: %.4f", actual)
+	}
+
+	if actual := averageRate(1000, 4000); actual != 0.25 {
+		t.Errorf("unexpected average rate\nwant: %.4f\n got: %.4f", 0.25, actual)
+	}
+}
+
+func TestCalculator_TaxRates(t *testing.T) {
+
+	incCalc, err := income.NewCalculator(&income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA: income.WeightedAdjuster(0.5),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewCalculator(CalcConfig{
+		TaxFormula: &CanadianFormula{
+			WeightedBrackets: core.WeightedBrackets{
+				0.10: core.Bracket{0, 10000},
+				0.20: core.Bracket{10000, math.Inf(1)},
+			},
+		},
+		ContraTaxFormula: &CanadianContraFormula{
+			OrderedCreditors: []Creditor{
+				WeightedCreditor{
+					Weight: 0.05,
+					CreditDescriptor: CreditDescriptor{
+						CreditRule:            core.CreditRule{CrSource: "div", Type: core.CrRuleTypeNotCarryForward},
+						TargetFinancialSource: core.IncSrcEligibleDividendsCA,
+					},
+				},
+			},
+		},
+		IncomeCalc: incCalc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spouseA := finance.NewIndividualFinances()
+	spouseA.SetAmount(core.IncSrcEarned, 20000)
+	spouseA.SetAmount(core.IncSrcEligibleDividendsCA, 1000)
+	finances := finance.NewHouseholdFinances(spouseA, nil)
+	c.SetFinances(finances, nil)
+
+	ratesA, ratesB := c.TaxRates(
+		core.IncSrcEarned, core.IncSrcCapitalGainCA, core.IncSrcEligibleDividendsCA,
+	)
+
+	if ratesA.Statutory != 0.20 {
+		t.Errorf("unexpected statutory rate\nwant: %.4f\n got: %.4f", 0.20, ratesA.Statutory)
+	}
+
+	expectedEffective := map[core.FinancialSource]float64{
+		core.IncSrcEarned:              0.20,
+		core.IncSrcCapitalGainCA:       0.10,
+		core.IncSrcEligibleDividendsCA: 0.15,
+	}
+	for src, expected := range expectedEffective {
+		if math.Abs(ratesA.Effective[src]-expected) > 1e-9 {
+			t.Errorf(
+				"source %d: unexpected effective rate\nwant: %.4f\n got: %.4f",
+				src, expected, ratesA.Effective[src],
+			)
+		}
+	}
+
+	expectedTax := (0.10 * 10000) + (0.20 * 11000) - (0.05 * 1000)
+	expectedAvg := expectedTax / 21000
+	if math.Abs(ratesA.Average-expectedAvg) > 1e-9 {
+		t.Errorf("unexpected average rate\nwant: %.4f\n got: %.4f", expectedAvg, ratesA.Average)
+	}
+
+	if ratesB.Effective != nil || ratesB.Statutory != 0 || ratesB.Average != 0 {
+		t.Errorf("expected zero rates for nil spouse, got: %v", ratesB)
+	}
+
+	if c.finances != finances {
+		t.Error("expected calculator to be set with the original finances on return")
+	}
+	if spouseA.TotalAmount(core.IncSrcEarned) != 20000 {
+		t.Error("expected original finances to be unchanged")
+	}
+}
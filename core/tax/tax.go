@@ -17,12 +17,16 @@ var (
 	ErrNoCalc          = errors.New("no tax calculator given")
 	ErrNoCreditor      = errors.New("no creditor given/set")
 	ErrDupCreditSource = errors.New("duplicate credit sources are not allowed")
+	ErrNoAdjuster      = errors.New("no adjuster given/set")
 )
 
 // Formula computes payable taxes on the given income
 type Formula interface {
 	// Apply applies the formula on the income
 	Apply(netIncome float64) float64
+	// Slice returns the itemized application of the formula on the income,
+	// where the sum of the weighted amounts equals Apply(netIncome)
+	Slice(netIncome float64) []core.BracketSlice
 	// Year is the tax year this contra formula is associated with
 	Year() uint
 	// Region is the tax region this contra formula is associated with
@@ -51,11 +55,33 @@ type ContraFormula interface {
 	Validate() error
 }
 
+// AdjustmentFormula computes adjustments on the payable tax after applying tax
+// credits, e.g. surtaxes that are computed on the basic tax of a jurisdiction
+type AdjustmentFormula interface {
+	// Apply returns the adjustments for the given tax payer and basic tax, in
+	// the order they were applied. The adjusted tax is the sum of the basic
+	// tax and the amounts of all returned adjustments
+	Apply(taxPayer *TaxPayer, basicTax float64) []core.TaxAdjustment
+	// Clone returns a copy of this adjustment formula
+	Clone() AdjustmentFormula
+	// Year is the tax year this adjustment formula is associated with
+	Year() uint
+	// Region is the tax region this adjustment formula is associated with
+	Region() core.Region
+	// Validate checks if the formula is valid for use
+	Validate() error
+}
+
 // CalcConfig is used to pass configurations to create new tax calculator
 type CalcConfig struct {
 	IncomeCalc       core.IncomeCalculator
 	TaxFormula       Formula
 	ContraTaxFormula ContraFormula
+	// AdjustmentFormula is optional and may be nil
+	AdjustmentFormula AdjustmentFormula
+	// Abatements are optional reductions this calculator's region grants on
+	// the basic tax of other regions when aggregated with them
+	Abatements []Abatement
 }
 
 // validate checks if the configurations are valid for use by calc constructors
@@ -91,6 +117,35 @@ func (cfg CalcConfig) validate() error {
 		return ErrNoIncCalc
 	}
 
+	for _, abatement := range cfg.Abatements {
+
+		err = abatement.Validate()
+		if err != nil {
+			return errors.Wrap(err, "invalid abatement")
+		}
+
+		if abatement.TargetRegion == cfg.TaxFormula.Region() {
+			return errors.Wrap(ErrInvalidTaxArg, "abatement cannot target the same tax region")
+		}
+	}
+
+	if cfg.AdjustmentFormula == nil {
+		return nil
+	}
+
+	err = cfg.AdjustmentFormula.Validate()
+	if err != nil {
+		return errors.Wrap(err, "invalid adjustment formula")
+	}
+
+	if cfg.TaxFormula.Year() != cfg.AdjustmentFormula.Year() {
+		return errors.Wrap(ErrInvalidTaxArg, "formula/adjustment formula tax year mismatch")
+	}
+
+	if cfg.TaxFormula.Region() != cfg.AdjustmentFormula.Region() {
+		return errors.Wrap(ErrInvalidTaxArg, "formula/adjustment formula tax region mismatch")
+	}
+
 	return nil
 }
 
@@ -7,7 +7,16 @@ import (
 )
 
 // compile-time check for interface implementation
-var _ core.TaxCalculator = (*Aggregator)(nil)
+var (
+	_ core.TaxCalculator = (*Aggregator)(nil)
+	_ abater             = (*Calculator)(nil)
+)
+
+// abater is implemented by tax calculators whose region grants reductions on
+// the basic tax of other regions
+type abater interface {
+	Abatements() []Abatement
+}
 
 // Aggregator is used to aggregate payable tax from multiple tax calculators
 type Aggregator struct {
@@ -82,22 +91,108 @@ func (agg *Aggregator) SetDependents(deps []*human.Person) {
 	agg.dependents = deps
 }
 
-// TaxPayable returns the sum of payable tax from the underlying calculators
+// TaxPayable returns the sum of payable tax from the underlying calculators,
+// which is the total net payable tax in the breakdowns of TaxBreakdown()
 func (agg *Aggregator) TaxPayable() (spouseA, spouseB float64, unusedCredits []core.TaxCredit) {
 
+	breakdownsA, breakdownsB, crAgg := agg.TaxBreakdown()
+	return totalNetPayable(breakdownsA), totalNetPayable(breakdownsB), crAgg
+}
+
+// TaxBreakdown returns the itemized payable tax from all the underlying
+// calculators, where the breakdowns of each spouse are merged into a single
+// report that is ordered in the same order of the calculators. Abatements
+// granted by the region of one calculator on the region of another calculator
+// are recorded as adjustments in the breakdowns of the targeted region
+func (agg *Aggregator) TaxBreakdown() (spouseA, spouseB []core.TaxBreakdown, unusedCredits []core.TaxCredit) {
+
 	var (
-		taxAggA float64
-		taxAggB float64
-		crAgg   []core.TaxCredit
+		breakdownsA []core.TaxBreakdown
+		breakdownsB []core.TaxBreakdown
+		crAgg       []core.TaxCredit
 	)
 
 	for _, c := range agg.calculators {
 		agg.setupTaxCalculator(c)
-		taxA, taxB, credits := c.TaxPayable()
-		taxAggA += taxA
-		taxAggB += taxB
+		bdA, bdB, credits := c.TaxBreakdown()
+		breakdownsA = append(breakdownsA, bdA...)
+		breakdownsB = append(breakdownsB, bdB...)
 		crAgg = append(crAgg, credits...)
 	}
 
-	return taxAggA, taxAggB, crAgg
+	abatements := agg.abatements()
+	applyAbatements(breakdownsA, abatements)
+	applyAbatements(breakdownsB, abatements)
+
+	return breakdownsA, breakdownsB, crAgg
+}
+
+// totalNetPayable returns the sum of the net payable tax in the given
+// breakdowns
+func totalNetPayable(breakdowns []core.TaxBreakdown) float64 {
+
+	var total float64
+	for _, breakdown := range breakdowns {
+		total += breakdown.NetPayable
+	}
+	return total
+}
+
+// abatements returns the abatements granted by the underlying calculators
+func (agg *Aggregator) abatements() []Abatement {
+
+	var abatements []Abatement
+	for _, c := range agg.calculators {
+		if a, ok := c.(abater); ok {
+			abatements = append(abatements, a.Abatements()...)
+		}
+	}
+	return abatements
+}
+
+// abatementRate returns the total rate of abatements targeting any of the
+// given regions
+func abatementRate(abatements []Abatement, regions []core.Region) float64 {
+
+	var rate float64
+	for _, abatement := range abatements {
+		for _, region := range regions {
+			if abatement.TargetRegion == region {
+				rate += abatement.Rate
+			}
+		}
+	}
+	return rate
+}
+
+// TaxRates returns the marginal and average tax rates from all the underlying
+// calculators. The statutory and average rates are the sum of the respective
+// rates of the underlying calculators, whereas the effective marginal rates of
+// the given sources are computed on the aggregate payable tax. The statutory
+// and average rates of a calculator whose region is targeted by abatements are
+// reduced by the rate of the abatements
+func (agg *Aggregator) TaxRates(sources ...core.FinancialSource) (spouseA, spouseB core.TaxRates) {
+
+	abatements := agg.abatements()
+
+	for _, c := range agg.calculators {
+		agg.setupTaxCalculator(c)
+		ratesA, ratesB := c.TaxRates()
+		factor := 1.0 - abatementRate(abatements, c.Regions())
+		spouseA.Statutory += factor * ratesA.Statutory
+		spouseA.Average += factor * ratesA.Average
+		spouseB.Statutory += factor * ratesB.Statutory
+		spouseB.Average += factor * ratesB.Average
+	}
+
+	finances := agg.finances
+	if finances == nil {
+		finances = core.NewHouseholdFinancesNop()
+	}
+
+	spouseA.Effective, spouseB.Effective = effectiveMarginalRates(
+		agg, finances, agg.credits, sources,
+	)
+
+	return spouseA, spouseB
 }
@@ -1,6 +1,7 @@
 package tax
 
 import (
+	"math"
 	"strings"
 	"testing"
 
@@ -178,3 +179,147 @@ func TestAggregator_setupTaxCalculator(t *testing.T) {
 	}
 
 }
+
+func TestAggregator_TaxBreakdown(t *testing.T) {
+
+	newCalc := func(region core.Region) *Calculator {
+		c, err := NewCalculator(CalcConfig{
+			TaxFormula:       &testTaxFormula{onApply: 1000.0, onRegion: region},
+			ContraTaxFormula: &testContraTaxFormula{onRegion: region},
+			IncomeCalc:       &testIncomeCalculator{onNetIncome: 5000.0},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	agg, err := NewAggregator(newCalc(core.RegionCA), newCalc(core.RegionBC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finances := &testHouseholdFinances{onSpouseA: core.NewFinancerNop()}
+	agg.SetFinances(finances, nil)
+
+	actualA, actualB, _ := agg.TaxBreakdown()
+	if len(actualA) != 2 {
+		t.Fatalf("expected 2 breakdowns for spouse A, got: %d", len(actualA))
+	}
+	if actualA[0].Region != core.RegionCA || actualA[1].Region != core.RegionBC {
+		t.Errorf(
+			"expected breakdowns in the order of the calculators, got: %q, %q",
+			actualA[0].Region, actualA[1].Region,
+		)
+	}
+	if len(actualB) != 0 {
+		t.Errorf("expected no breakdowns for nil spouse, got: %d", len(actualB))
+	}
+
+	taxA, _, _ := agg.TaxPayable()
+	if actualA[0].NetPayable+actualA[1].NetPayable != taxA {
+		t.Errorf(
+			"expected merged breakdowns to add up to payable tax\nwant: %.2f\n got: %.2f",
+			taxA, actualA[0].NetPayable+actualA[1].NetPayable,
+		)
+	}
+}
+
+func TestAggregator_TaxRates(t *testing.T) {
+
+	newCalc := func(rate float64) *Calculator {
+		c, err := NewCalculator(CalcConfig{
+			TaxFormula: &CanadianFormula{
+				WeightedBrackets: core.WeightedBrackets{rate: core.Bracket{0, math.Inf(1)}},
+			},
+			ContraTaxFormula: &CanadianContraFormula{},
+			IncomeCalc:       &testIncomeCalculator{onNetIncome: 1000, onTotalIncome: 1000},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	agg, err := NewAggregator(newCalc(0.15), newCalc(0.05))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ratesA, _ := agg.TaxRates(core.IncSrcEarned)
+	if math.Abs(ratesA.Statutory-0.20) > 1e-9 {
+		t.Errorf("unexpected statutory rate\nwant: %.4f\n got: %.4f", 0.20, ratesA.Statutory)
+	}
+	if math.Abs(ratesA.Average-0.20) > 1e-9 {
+		t.Errorf("unexpected average rate\nwant: %.4f\n got: %.4f", 0.20, ratesA.Average)
+	}
+	if _, ok := ratesA.Effective[core.IncSrcEarned]; !ok {
+		t.Error("expected effective rate to be computed for the given source")
+	}
+}
+
+func TestAggregator_Abatements(t *testing.T) {
+
+	incCalc := &testIncomeCalculator{onNetIncome: 10000, onTotalIncome: 10000}
+	newCalc := func(region core.Region, abatements []Abatement) *Calculator {
+		c, err := NewCalculator(CalcConfig{
+			IncomeCalc: incCalc,
+			TaxFormula: &testTaxFormula{
+				onApply:  1000,
+				onSlice:  []core.BracketSlice{{Bracket: core.Bracket{0, 20000}, Rate: 0.1}},
+				onRegion: region,
+			},
+			ContraTaxFormula: &testContraTaxFormula{onRegion: region},
+			Abatements:       abatements,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	abatements := []Abatement{{TargetRegion: core.RegionCA, Rate: 0.165, Desc: "abatement"}}
+	finances := &testHouseholdFinances{onSpouseA: core.NewFinancerNop()}
+
+	// without the abating region
+	agg, err := NewAggregator(newCalc(core.RegionCA, nil), newCalc(core.RegionBC, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	agg.SetFinances(finances, nil)
+	taxA, _, _ := agg.TaxPayable()
+	if taxA != 2000 {
+		t.Errorf("unexpected payable tax\nwant: %.2f\n got: %.2f", 2000.0, taxA)
+	}
+
+	// with the abating region
+	agg, err = NewAggregator(newCalc(core.RegionCA, nil), newCalc(core.RegionQC, abatements))
+	if err != nil {
+		t.Fatal(err)
+	}
+	agg.SetFinances(finances, nil)
+
+	taxA, _, _ = agg.TaxPayable()
+	if taxA != 1835 {
+		t.Errorf("unexpected payable tax\nwant: %.2f\n got: %.2f", 1835.0, taxA)
+	}
+
+	bdA, _, _ := agg.TaxBreakdown()
+	if len(bdA) != 2 {
+		t.Fatalf("expected 2 breakdowns, got: %d", len(bdA))
+	}
+	expectedAdj := []core.TaxAdjustment{{Description: "abatement", Amount: -165}}
+	diff := deep.Equal(bdA[0].Adjustments, expectedAdj)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+	if bdA[0].NetPayable+bdA[1].NetPayable != taxA {
+		t.Error("expected the sum of breakdowns to match the payable tax")
+	}
+
+	ratesA, _ := agg.TaxRates()
+	expectedStatutory := 0.1*(1-0.165) + 0.1
+	if math.Abs(ratesA.Statutory-expectedStatutory) > 1e-9 {
+		t.Errorf("unexpected statutory rate\nwant: %.4f\n got: %.4f", expectedStatutory, ratesA.Statutory)
+	}
+}
@@ -13,6 +13,8 @@ var _ core.TaxCalculator = (*Calculator)(nil)
 type Calculator struct {
 	formula          Formula
 	contraFormula    ContraFormula
+	adjFormula       AdjustmentFormula
+	abatements       []Abatement
 	incomeCalculator core.IncomeCalculator
 	finances         core.HouseholdFinances
 	credits          []core.TaxCredit
@@ -37,6 +39,11 @@ func NewCalculator(cfg CalcConfig) (*Calculator, error) {
 		finances:         core.NewHouseholdFinancesNop(),
 		taxYear:          cfg.TaxFormula.Year(),
 		taxRegion:        cfg.TaxFormula.Region(),
+		abatements:       cloneAbatements(cfg.Abatements),
+	}
+
+	if cfg.AdjustmentFormula != nil {
+		c.adjFormula = cfg.AdjustmentFormula.Clone()
 	}
 
 	return c, nil
@@ -52,6 +59,13 @@ func (c *Calculator) Regions() []core.Region {
 	return []core.Region{c.taxRegion}
 }
 
+// Abatements returns the reductions which the region of this calculator grants
+// on the basic tax of other regions. They are only applied by an aggregator
+// that also computes the taxes of the targeted regions
+func (c *Calculator) Abatements() []Abatement {
+	return cloneAbatements(c.abatements)
+}
+
 // SetFinances stores the given financial data in this calculator. Subsequent
 // calls to other calculator functions will be based on the the given finances.
 // Changes to the given finances after calling this function will affect future
@@ -83,7 +97,7 @@ func (c *Calculator) SetDependents(dependents []*human.Person) {
 }
 
 // TaxPayable computes the tax on the net income for the previously set finances
-// and any relevent credits.
+// and any relevent credits and adjustments.
 func (c *Calculator) TaxPayable() (spouseA, spouseB float64, combinedCredits []core.TaxCredit) {
 
 	c.panicIfEqNonNilSpouses()
@@ -96,9 +110,120 @@ func (c *Calculator) TaxPayable() (spouseA, spouseB float64, combinedCredits []c
 	netPayableTaxB := c.netPayableTax(totalTaxB, taxCrB)
 	finalCr := append(taxCrA, taxCrB...)
 
+	adjA, adjB := c.adjustments(netIncomeA, netIncomeB, netPayableTaxA, netPayableTaxB)
+	netPayableTaxA += totalAdjustments(adjA)
+	netPayableTaxB += totalAdjustments(adjB)
+
 	return netPayableTaxA, netPayableTaxB, finalCr
 }
 
+// TaxBreakdown computes the itemized tax on the net income for the previously
+// set finances and any relevent credits. The returned breakdowns for a spouse
+// contain a single item for the region of this calculator, unless the spouse's
+// finances are nil, in which case the spouse's breakdowns are nil
+func (c *Calculator) TaxBreakdown() (spouseA, spouseB []core.TaxBreakdown, combinedCredits []core.TaxCredit) {
+
+	c.panicIfEqNonNilSpouses()
+
+	netIncomeA, netIncomeB := c.netIncome()
+	totalTaxA, totalTaxB := c.totalTax(netIncomeA, netIncomeB)
+	taxCrA, taxCrB := c.totalCredits(netIncomeA, netIncomeB)
+
+	netPayableTaxA := c.netPayableTax(totalTaxA, taxCrA)
+	netPayableTaxB := c.netPayableTax(totalTaxB, taxCrB)
+	finalCr := append(taxCrA, taxCrB...)
+
+	adjA, adjB := c.adjustments(netIncomeA, netIncomeB, netPayableTaxA, netPayableTaxB)
+
+	if c.finances.SpouseA() != nil {
+		spouseA = []core.TaxBreakdown{
+			c.breakdown(netIncomeA, totalTaxA, netPayableTaxA, adjA),
+		}
+	}
+
+	if c.finances.SpouseB() != nil {
+		spouseB = []core.TaxBreakdown{
+			c.breakdown(netIncomeB, totalTaxB, netPayableTaxB, adjB),
+		}
+	}
+
+	return spouseA, spouseB, finalCr
+}
+
+// TaxRates computes the marginal and average tax rates for the previously set
+// finances and any relevent credits. The effective marginal rates are computed
+// for the given sources by adding an extra dollar to each source on a copy of
+// the set finances. If a spouse's finances are nil, the spouse's rates are zero
+func (c *Calculator) TaxRates(sources ...core.FinancialSource) (spouseA, spouseB core.TaxRates) {
+
+	c.panicIfEqNonNilSpouses()
+
+	netIncomeA, netIncomeB := c.netIncome()
+	taxA, taxB, _ := c.TaxPayable()
+
+	if financesA := c.finances.SpouseA(); financesA != nil {
+		spouseA.Statutory = statutoryRate(c.formula.Slice(netIncomeA), netIncomeA)
+		spouseA.Average = averageRate(taxA, c.totalIncome(financesA))
+	}
+
+	if financesB := c.finances.SpouseB(); financesB != nil {
+		spouseB.Statutory = statutoryRate(c.formula.Slice(netIncomeB), netIncomeB)
+		spouseB.Average = averageRate(taxB, c.totalIncome(financesB))
+	}
+
+	spouseA.Effective, spouseB.Effective = effectiveMarginalRates(
+		c, c.finances, c.credits, sources,
+	)
+
+	return spouseA, spouseB
+}
+
+// breakdown returns the itemized tax for the given net income, where the
+// given total tax, net payable tax before adjustments, and the adjustments
+// were calculated from that net income
+func (c *Calculator) breakdown(netIncome, totalTax, netPayableTax float64, adjustments []core.TaxAdjustment) core.TaxBreakdown {
+	return core.TaxBreakdown{
+		Year:           c.taxYear,
+		Region:         c.taxRegion,
+		NetIncome:      netIncome,
+		Brackets:       c.formula.Slice(netIncome),
+		GrossTax:       totalTax,
+		CreditsApplied: totalTax - netPayableTax,
+		Adjustments:    adjustments,
+		NetPayable:     netPayableTax + totalAdjustments(adjustments),
+	}
+}
+
+// adjustments returns the adjustments on the given basic tax amounts for both
+// spouses in the set finances. If no adjustment formula is set or a spouse's
+// finances are nil, the adjustments of the spouse are nil
+func (c *Calculator) adjustments(netIncomeA, netIncomeB, basicTaxA, basicTaxB float64) (adjA, adjB []core.TaxAdjustment) {
+
+	if c.adjFormula == nil {
+		return nil, nil
+	}
+
+	taxPayerA, taxPayerB := c.makeTaxPayers(netIncomeA, netIncomeB)
+	if taxPayerA != nil {
+		adjA = c.adjFormula.Apply(taxPayerA, basicTaxA)
+	}
+	if taxPayerB != nil {
+		adjB = c.adjFormula.Apply(taxPayerB, basicTaxB)
+	}
+
+	return adjA, adjB
+}
+
+// totalAdjustments returns the sum of the amounts of the given adjustments
+func totalAdjustments(adjustments []core.TaxAdjustment) float64 {
+
+	var total float64
+	for _, adj := range adjustments {
+		total += adj.Amount
+	}
+	return total
+}
+
 // netIncome returns the net income for both spouses in the set finances
 func (c *Calculator) netIncome() (spouseA, spouseB float64) {
 
@@ -111,6 +236,12 @@ func (c *Calculator) netIncome() (spouseA, spouseB float64) {
 	return spouseA, spouseB
 }
 
+// totalIncome returns the total income of the given finances
+func (c *Calculator) totalIncome(finances core.Financer) float64 {
+	c.incomeCalculator.SetFinances(finances)
+	return c.incomeCalculator.TotalIncome()
+}
+
 // totalTax returns the total tax amount for both spouses in the set finances
 func (c *Calculator) totalTax(netIncomeA, netIncomeB float64) (totalTaxA, totalTaxB float64) {
 	totalTaxA = c.formula.Apply(netIncomeA)
@@ -222,11 +353,13 @@ func (c *Calculator) isValidTaxCredit(cr core.TaxCredit) bool {
 }
 
 // makeTaxPayers returns dual tax payers from the given net income amounts and
-// the finances stored in this calculator
+// the finances stored in this calculator. Dependents with birth dates are of
+// their ages at the end of the tax year
 func (c *Calculator) makeTaxPayers(netIncomeA, netIncomeB float64) (taxPayerA, taxPayerB *TaxPayer) {
 
 	financesA := c.finances.SpouseA()
 	financesB := c.finances.SpouseB()
+	dependents := human.PeopleAtDate(c.dependents, human.TaxYearEnd(c.taxYear))
 
 	if financesA != nil {
 		taxPayerA = &TaxPayer{
@@ -234,7 +367,7 @@ func (c *Calculator) makeTaxPayers(netIncomeA, netIncomeB float64) (taxPayerA, t
 			NetIncome:       netIncomeA,
 			SpouseFinances:  financesB,
 			SpouseNetIncome: netIncomeB,
-			Dependents:      c.dependents,
+			Dependents:      dependents,
 		}
 	}
 
@@ -244,7 +377,7 @@ func (c *Calculator) makeTaxPayers(netIncomeA, netIncomeB float64) (taxPayerA, t
 			NetIncome:       netIncomeB,
 			SpouseFinances:  financesA,
 			SpouseNetIncome: netIncomeA,
-			Dependents:      c.dependents,
+			Dependents:      dependents,
 		}
 	}
 
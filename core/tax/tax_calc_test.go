@@ -3,6 +3,7 @@ package tax
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/malkhamis/quantax/core"
@@ -474,6 +475,38 @@ func TestCalculator_makeTaxPayers_singleB(t *testing.T) {
 
 }
 
+func TestCalculator_makeTaxPayers_birthDates(t *testing.T) {
+
+	birthDate := time.Date(2006, time.December, 31, 0, 0, 0, 0, time.UTC)
+	calc := &Calculator{
+		finances: core.NewHouseholdFinancesNop(),
+		dependents: []*human.Person{
+			{AgeMonths: 10, BirthDate: birthDate, DisabilityEligible: true, CustodyPercent: 50},
+			{AgeMonths: 10, Student: human.FullTimeStudent, Residency: human.PartYearResident, DaysResident: 100},
+		},
+		taxYear: 2024,
+	}
+	taxPayerA, taxPayerB := calc.makeTaxPayers(1000, 2000)
+
+	expected := []*human.Person{
+		{AgeMonths: 18 * 12, BirthDate: birthDate, DisabilityEligible: true, CustodyPercent: 50},
+		{AgeMonths: 10, Student: human.FullTimeStudent, Residency: human.PartYearResident, DaysResident: 100},
+	}
+	diff := deep.Equal(taxPayerA.Dependents, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+
+	diff = deep.Equal(taxPayerB.Dependents, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+
+	if calc.dependents[0].AgeMonths != 10 {
+		t.Error("expected the set dependents to be unchanged")
+	}
+}
+
 func TestNewCalculator_Error(t *testing.T) {
 
 	cfg := CalcConfig{
@@ -506,3 +539,148 @@ func TestCalculator_panicIfEqNonNilSpouses(t *testing.T) {
 
 	c.panicIfEqNonNilSpouses()
 }
+
+func TestCalculator_TaxBreakdown(t *testing.T) {
+
+	slices := []core.BracketSlice{
+		{Bracket: core.Bracket{0, 5000}, Rate: 0.5, SlicedAmount: 3000, WeightedAmount: 1500},
+	}
+	incCalc := &testIncomeCalculator{onNetIncome: 3000.0}
+	formula := &testTaxFormula{onApply: 1500.0, onSlice: slices, onYear: 2019, onRegion: core.RegionBC}
+	cformula := &testContraTaxFormula{
+		onApply: []*TaxCredit{
+			&TaxCredit{
+				AmountInitial:   100,
+				AmountRemaining: 100,
+				CrRule:          core.CreditRule{Type: core.CrRuleTypeNotCarryForward},
+			},
+		},
+		onYear:   2019,
+		onRegion: core.RegionBC,
+	}
+
+	c, err := NewCalculator(CalcConfig{
+		TaxFormula:       formula,
+		ContraTaxFormula: cformula,
+		IncomeCalc:       incCalc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.SetFinances(&testHouseholdFinances{onSpouseA: core.NewFinancerNop()}, nil)
+	actualA, actualB, _ := c.TaxBreakdown()
+
+	expectedA := []core.TaxBreakdown{
+		{
+			Year:           2019,
+			Region:         core.RegionBC,
+			NetIncome:      3000,
+			Brackets:       slices,
+			GrossTax:       1500,
+			CreditsApplied: 100,
+			NetPayable:     1400,
+		},
+	}
+
+	diff := deep.Equal(actualA, expectedA)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+
+	if actualB != nil {
+		t.Errorf("expected nil breakdowns for nil spouse, got: %v", actualB)
+	}
+}
+
+func TestCalculator_TaxBreakdown_MatchesTaxPayable(t *testing.T) {
+
+	incCalc := &testIncomeCalculator{onNetIncome: 3000.0}
+	cfg := CalcConfig{
+		TaxFormula: &testTaxFormula{onApply: 1500.0},
+		ContraTaxFormula: &testContraTaxFormula{
+			onApply: []*TaxCredit{
+				&TaxCredit{
+					AmountInitial:   2000,
+					AmountRemaining: 2000,
+					CrRule:          core.CreditRule{Type: core.CrRuleTypeCanCarryForward},
+				},
+			},
+		},
+		IncomeCalc: incCalc,
+	}
+
+	c, err := NewCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taxA, taxB, _ := c.TaxPayable()
+	bdA, bdB, _ := c.TaxBreakdown()
+	if len(bdA) != 1 || len(bdB) != 1 {
+		t.Fatalf("expected one breakdown per spouse, got: %d, %d", len(bdA), len(bdB))
+	}
+
+	if bdA[0].NetPayable != taxA {
+		t.Errorf("unexpected net payable tax\nwant: %.2f\n got: %.2f", taxA, bdA[0].NetPayable)
+	}
+	if bdB[0].NetPayable != taxB {
+		t.Errorf("unexpected net payable tax\nwant: %.2f\n got: %.2f", taxB, bdB[0].NetPayable)
+	}
+	if bdA[0].CreditsApplied != 1500 {
+		t.Errorf("unexpected applied credits\nwant: %.2f\n got: %.2f", 1500.0, bdA[0].CreditsApplied)
+	}
+}
+
+func TestCalculator_Adjustments(t *testing.T) {
+
+	incCalc := &testIncomeCalculator{onNetIncome: 3000.0}
+	adjustments := []core.TaxAdjustment{
+		{Description: "surtax", Amount: 200},
+		{Description: "reduction", Amount: -50},
+	}
+
+	c, err := NewCalculator(CalcConfig{
+		TaxFormula: &testTaxFormula{onApply: 1500.0},
+		ContraTaxFormula: &testContraTaxFormula{
+			onApply: []*TaxCredit{
+				&TaxCredit{
+					AmountInitial:   100,
+					AmountRemaining: 100,
+					CrRule:          core.CreditRule{Type: core.CrRuleTypeNotCarryForward},
+				},
+			},
+		},
+		AdjustmentFormula: &testAdjustmentFormula{onApply: adjustments},
+		IncomeCalc:        incCalc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.SetFinances(&testHouseholdFinances{onSpouseA: core.NewFinancerNop()}, nil)
+
+	taxA, _, _ := c.TaxPayable()
+	if taxA != 1550 {
+		t.Errorf("unexpected payable tax\nwant: %.2f\n got: %.2f", 1550.0, taxA)
+	}
+
+	bdA, bdB, _ := c.TaxBreakdown()
+	expectedA := []core.TaxBreakdown{
+		{
+			NetIncome:      3000,
+			GrossTax:       1500,
+			CreditsApplied: 100,
+			Adjustments:    adjustments,
+			NetPayable:     1550,
+		},
+	}
+
+	diff := deep.Equal(bdA, expectedA)
+	if diff != nil {
+		t.Error("actual does not match expected\n", strings.Join(diff, "\n"))
+	}
+	if bdB != nil {
+		t.Errorf("expected nil breakdowns for nil spouse, got: %v", bdB)
+	}
+}
@@ -107,6 +107,66 @@ func TestCalcConfig_validate(t *testing.T) {
 			},
 			err: ErrNoIncCalc,
 		},
+		{
+			name: "invalid-abatement",
+			cfg: CalcConfig{
+				IncomeCalc:       &testIncomeCalculator{},
+				TaxFormula:       &testTaxFormula{},
+				ContraTaxFormula: &testContraTaxFormula{},
+				Abatements:       []Abatement{{Rate: 0.165}},
+			},
+			err: ErrInvalidTaxArg,
+		},
+		{
+			name: "abatement-targets-same-region",
+			cfg: CalcConfig{
+				IncomeCalc:       &testIncomeCalculator{},
+				TaxFormula:       &testTaxFormula{onRegion: core.RegionQC},
+				ContraTaxFormula: &testContraTaxFormula{onRegion: core.RegionQC},
+				Abatements:       []Abatement{{TargetRegion: core.RegionQC, Rate: 0.165}},
+			},
+			err: ErrInvalidTaxArg,
+		},
+		{
+			name: "valid-adjustment-formula",
+			cfg: CalcConfig{
+				IncomeCalc:        &testIncomeCalculator{},
+				TaxFormula:        &testTaxFormula{},
+				ContraTaxFormula:  &testContraTaxFormula{},
+				AdjustmentFormula: &testAdjustmentFormula{},
+			},
+			err: nil,
+		},
+		{
+			name: "invalid-adjustment-formula",
+			cfg: CalcConfig{
+				IncomeCalc:        &testIncomeCalculator{},
+				TaxFormula:        &testTaxFormula{},
+				ContraTaxFormula:  &testContraTaxFormula{},
+				AdjustmentFormula: &testAdjustmentFormula{onValidate: simulatedErr},
+			},
+			err: simulatedErr,
+		},
+		{
+			name: "adjustment-formula-year-mismatch",
+			cfg: CalcConfig{
+				IncomeCalc:        &testIncomeCalculator{},
+				TaxFormula:        &testTaxFormula{},
+				ContraTaxFormula:  &testContraTaxFormula{},
+				AdjustmentFormula: &testAdjustmentFormula{onYear: 2020},
+			},
+			err: ErrInvalidTaxArg,
+		},
+		{
+			name: "adjustment-formula-region-mismatch",
+			cfg: CalcConfig{
+				IncomeCalc:        &testIncomeCalculator{},
+				TaxFormula:        &testTaxFormula{},
+				ContraTaxFormula:  &testContraTaxFormula{},
+				AdjustmentFormula: &testAdjustmentFormula{onRegion: "NoMan"},
+			},
+			err: ErrInvalidTaxArg,
+		},
 	}
 
 	for i, c := range cases {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"math"
+	"sort"
 
 	"github.com/pkg/errors"
 )
@@ -42,13 +43,21 @@ type WeightedBrackets map[float64]Bracket
 
 // Apply slices the given param into this formula's brackets. Then, it applies
 // the rate asscoiated with the bracket to the sliced amounts and returns the
-// sum of applying the rates on all sliced amounts
+// sum of applying the rates on all sliced amounts. The weighted amounts are
+// summed in ascending order of the rates so that the result is deterministic
 func (wb WeightedBrackets) Apply(param float64) float64 {
 
+	rates := make([]float64, 0, len(wb))
+	for rate := range wb {
+		rates = append(rates, rate)
+	}
+	sort.Float64s(rates)
+
 	var result float64
 
-	for rate, bracket := range wb {
+	for _, rate := range rates {
 
+		bracket := wb[rate]
 		if param <= bracket.Lower() {
 			continue
 		}
@@ -64,6 +73,56 @@ func (wb WeightedBrackets) Apply(param float64) float64 {
 	return result
 }
 
+// BracketSlice is the portion of an amount that falls within a weighted bracket
+type BracketSlice struct {
+	// the bracket which the amount was sliced into
+	Bracket Bracket
+	// the rate associated with the bracket
+	Rate float64
+	// the portion of the amount that falls within the bracket
+	SlicedAmount float64
+	// the result of applying the rate on the sliced amount
+	WeightedAmount float64
+}
+
+// Slice slices the given param into this formula's brackets and returns one
+// item per bracket, including the brackets that the param does not reach. The
+// returned slices are sorted by the lower bound of the brackets then by rate.
+// The sum of the weighted amounts of all slices is equal to Apply(param)
+func (wb WeightedBrackets) Slice(param float64) []BracketSlice {
+
+	slices := make([]BracketSlice, 0, len(wb))
+
+	for rate, bracket := range wb {
+
+		var sliced float64
+		switch {
+		case param <= bracket.Lower():
+			sliced = 0.0
+		case param >= bracket.Upper():
+			sliced = bracket.Amount()
+		default:
+			sliced = param - bracket.Lower()
+		}
+
+		slices = append(slices, BracketSlice{
+			Bracket:        bracket,
+			Rate:           rate,
+			SlicedAmount:   sliced,
+			WeightedAmount: rate * sliced,
+		})
+	}
+
+	sort.Slice(slices, func(i, j int) bool {
+		if slices[i].Bracket.Lower() != slices[j].Bracket.Lower() {
+			return slices[i].Bracket.Lower() < slices[j].Bracket.Lower()
+		}
+		return slices[i].Rate < slices[j].Rate
+	})
+
+	return slices
+}
+
 // Validate ensures that this weighted brackets object is valid for use
 func (wb WeightedBrackets) Validate() error {
 
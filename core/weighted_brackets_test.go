@@ -34,6 +34,30 @@ func TestWeightedBrackets_Apply(t *testing.T) {
 	}
 }
 
+func TestWeightedBrackets_Apply_Deterministic(t *testing.T) {
+
+	formula := WeightedBrackets{
+		0.0505: Bracket{0, 51446},
+		0.0915: Bracket{51446, 102894},
+		0.1116: Bracket{102894, 150000},
+		0.1216: Bracket{150000, 220000},
+		0.1316: Bracket{220000, math.Inf(1)},
+	}
+
+	// rates are summed in ascending order regardless of map iteration order
+	expected := 0.0
+	for _, rate := range []float64{0.0505, 0.0915, 0.1116, 0.1216, 0.1316} {
+		expected += WeightedBrackets{rate: formula[rate]}.Apply(250000.01)
+	}
+
+	for i := 0; i < 100; i++ {
+		actual := formula.Apply(250000.01)
+		if actual != expected {
+			t.Fatalf("actual does not match expected\nwant: %v\n got: %v", expected, actual)
+		}
+	}
+}
+
 func TestWeightedBrackets_Clone(t *testing.T) {
 
 	originalBracket := Bracket{100, 200}
@@ -96,3 +120,50 @@ func TestWeightedBrackets_Validate(t *testing.T) {
 		t.Fatal("expected an error validating an bracket rates with invalid brackets")
 	}
 }
+
+func TestWeightedBrackets_Slice(t *testing.T) {
+
+	formula := WeightedBrackets{
+		0.50: Bracket{200, math.Inf(1)},
+		0.10: Bracket{0, 100},
+		0.20: Bracket{100, 200},
+	}
+
+	expected := []BracketSlice{
+		{Bracket: Bracket{0, 100}, Rate: 0.10, SlicedAmount: 100, WeightedAmount: 10},
+		{Bracket: Bracket{100, 200}, Rate: 0.20, SlicedAmount: 50, WeightedAmount: 10},
+		{Bracket: Bracket{200, math.Inf(1)}, Rate: 0.50, SlicedAmount: 0, WeightedAmount: 0},
+	}
+
+	actual := formula.Slice(150)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d slices, got: %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf(
+				"slice %d: actual does not match expected\nwant: %v\n got: %v",
+				i, expected[i], actual[i],
+			)
+		}
+	}
+
+	var total float64
+	for _, slice := range actual {
+		total += slice.WeightedAmount
+	}
+	if total != formula.Apply(150) {
+		t.Errorf(
+			"expected sum of weighted amounts to equal applying the formula\nwant: %.2f\n got: %.2f",
+			formula.Apply(150), total,
+		)
+	}
+}
+
+func TestWeightedBrackets_Slice_Nil(t *testing.T) {
+
+	var formula WeightedBrackets
+	if actual := formula.Slice(1000); len(actual) != 0 {
+		t.Errorf("expected no slices for nil brackets, got: %v", actual)
+	}
+}
@@ -0,0 +1,207 @@
+package factory
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// benefitFactoryCase describes the constructors of a benefit factory whose
+// tests are otherwise identical
+type benefitFactoryCase struct {
+	name         string
+	year         uint
+	calcType     interface{}
+	zero         func() error
+	new          func(year uint, region core.Region) (interface{}, error)
+	withRegistry func(registry *history.Registry, year uint, region core.Region) error
+}
+
+var benefitFactoryCases = []benefitFactoryCase{
+	{
+		name:     "OAS",
+		year:     2018,
+		calcType: &benefits.OASCalculator{},
+		zero: func() error {
+			_, err := (&OASFactory{}).NewCalculator()
+			return err
+		},
+		new: func(year uint, region core.Region) (interface{}, error) {
+			return NewOASFactory(year, region).NewCalculator()
+		},
+		withRegistry: func(registry *history.Registry, year uint, region core.Region) error {
+			_, err := NewOASFactoryWithRegistry(registry, year, region).NewCalculator()
+			return err
+		},
+	},
+	{
+		name:     "GIS",
+		year:     2018,
+		calcType: &benefits.GISCalculator{},
+		zero: func() error {
+			_, err := (&GISFactory{}).NewCalculator()
+			return err
+		},
+		new: func(year uint, region core.Region) (interface{}, error) {
+			return NewGISFactory(year, region).NewCalculator()
+		},
+		withRegistry: func(registry *history.Registry, year uint, region core.Region) error {
+			_, err := NewGISFactoryWithRegistry(registry, year, region).NewCalculator()
+			return err
+		},
+	},
+	{
+		name:     "GST-credit",
+		year:     2018,
+		calcType: &benefits.HouseholdBenefitCalculator{},
+		zero: func() error {
+			_, err := (&GSTCreditFactory{}).NewCalculator()
+			return err
+		},
+		new: func(year uint, region core.Region) (interface{}, error) {
+			return NewGSTCreditFactory(year, region).NewCalculator()
+		},
+		withRegistry: func(registry *history.Registry, year uint, region core.Region) error {
+			_, err := NewGSTCreditFactoryWithRegistry(registry, year, region).NewCalculator()
+			return err
+		},
+	},
+	{
+		name:     "CWB",
+		year:     2019,
+		calcType: &benefits.CWBCalculator{},
+		zero: func() error {
+			_, err := (&CWBFactory{}).NewCalculator()
+			return err
+		},
+		new: func(year uint, region core.Region) (interface{}, error) {
+			return NewCWBFactory(year, region).NewCalculator()
+		},
+		withRegistry: func(registry *history.Registry, year uint, region core.Region) error {
+			_, err := NewCWBFactoryWithRegistry(registry, year, region).NewCalculator()
+			return err
+		},
+	},
+}
+
+func TestBenefitFactories_NewCalculator(t *testing.T) {
+
+	for i, c := range benefitFactoryCases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			calc, err := c.new(2025, core.RegionCA)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if reflect.TypeOf(calc) != reflect.TypeOf(c.calcType) {
+				t.Errorf("unexpected type\nwant: %T\n got: %T", c.calcType, calc)
+			}
+		})
+	}
+}
+
+func TestBenefitFactories_NewCalculator_Errors(t *testing.T) {
+
+	for i, c := range benefitFactoryCases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			err := c.zero()
+			if err != ErrFactoryNotInit {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", ErrFactoryNotInit, err)
+			}
+		})
+	}
+}
+
+func TestNewBenefitFactories_Errors(t *testing.T) {
+
+	for i, c := range benefitFactoryCases {
+		c := c
+
+		inputs := []struct {
+			name   string
+			year   uint
+			region core.Region
+			err    error
+		}{
+			{
+				name:   "invalid-year",
+				year:   1000,
+				region: core.RegionCA,
+				err:    history.ErrParamsNotExist,
+			},
+			{
+				name:   "invalid-region",
+				year:   c.year,
+				region: core.RegionBC,
+				err:    history.ErrRegionNotExist,
+			},
+			{
+				name:   "valid",
+				year:   c.year,
+				region: core.RegionCA,
+				err:    nil,
+			},
+		}
+
+		for _, in := range inputs {
+			in := in
+			t.Run(fmt.Sprintf("case%d-%s-%s", i, c.name, in.name), func(t *testing.T) {
+
+				_, err := c.new(in.year, in.region)
+				if errors.Cause(err) != in.err {
+					t.Errorf("unexpected error\nwant: %v\n got: %v", in.err, err)
+				}
+			})
+		}
+	}
+}
+
+func TestNewBenefitFactoriesWithRegistry(t *testing.T) {
+
+	for i, c := range benefitFactoryCases {
+		c := c
+
+		registries := []struct {
+			name     string
+			registry *history.Registry
+			err      error
+		}{
+			{
+				name:     "default-clone",
+				registry: history.DefaultRegistry().Clone(),
+				err:      nil,
+			},
+			{
+				name:     "empty",
+				registry: history.NewRegistry(),
+				err:      history.ErrRegionNotExist,
+			},
+			{
+				name:     "nil",
+				registry: nil,
+				err:      ErrNoRegistry,
+			},
+		}
+
+		for _, r := range registries {
+			r := r
+			t.Run(fmt.Sprintf("case%d-%s-%s", i, c.name, r.name), func(t *testing.T) {
+
+				err := c.withRegistry(r.registry, c.year, core.RegionCA)
+				if errors.Cause(err) != r.err {
+					t.Errorf("unexpected error\nwant: %v\n got: %v", r.err, err)
+				}
+			})
+		}
+	}
+}
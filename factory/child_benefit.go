@@ -1,8 +1,11 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
 	"github.com/malkhamis/quantax/core/income"
 	"github.com/malkhamis/quantax/history"
 
@@ -19,12 +22,24 @@ type ChildBenefitFactory struct {
 // from the given params. If multiple regions are specified, the returned
 // calculator aggregates the benefits for all beneficiaries
 func NewChildBenefitFactory(year uint, regions ...core.Region) *ChildBenefitFactory {
+	return NewChildBenefitFactoryWithRegistry(history.DefaultRegistry(), year, regions...)
+}
+
+// NewChildBenefitFactoryWithRegistry is like NewChildBenefitFactory, except
+// that the child benefit params are looked up in the given registry instead
+// of the default one
+func NewChildBenefitFactoryWithRegistry(registry *history.Registry, year uint, regions ...core.Region) *ChildBenefitFactory {
 
 	calcFactory := &ChildBenefitFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
 	allParams := make([]history.CBParams, len(regions))
 	for i, region := range regions {
 
-		foundParams, err := history.GetChildBenefitParams(year, region)
+		foundParams, err := registry.ChildBenefitParams(year, region)
 		if err != nil {
 			calcFactory.setFailingConstructor(
 				errors.Wrapf(err, "child benefit formula for region %q", region),
@@ -35,7 +50,7 @@ func NewChildBenefitFactory(year uint, regions ...core.Region) *ChildBenefitFact
 		allParams[i] = foundParams
 	}
 
-	calcFactory.initConstructor(allParams...)
+	calcFactory.initConstructor(human.BenefitYearStart(year), allParams...)
 	return calcFactory
 }
 
@@ -56,8 +71,8 @@ func (f *ChildBenefitFactory) setFailingConstructor(err error) {
 }
 
 // initConstructor initializes this factory's 'newCalculator' function from the
-// given formulas
-func (f *ChildBenefitFactory) initConstructor(allParams ...history.CBParams) {
+// given formulas, where the reference date is the first day of the benefit year
+func (f *ChildBenefitFactory) initConstructor(referenceDate time.Time, allParams ...history.CBParams) {
 
 	switch {
 
@@ -74,7 +89,11 @@ func (f *ChildBenefitFactory) initConstructor(allParams ...history.CBParams) {
 			if err != nil {
 				return nil, errors.Wrap(err, "error creating income calculator")
 			}
-			cfg := benefits.CalcConfigCB{Formula: formula, IncomeCalc: incomeCalc}
+			cfg := benefits.CalcConfigCB{
+				Formula:       formula,
+				IncomeCalc:    incomeCalc,
+				ReferenceDate: referenceDate,
+			}
 			return benefits.NewChildBenefitCalculator(cfg)
 		}
 
@@ -88,7 +107,11 @@ func (f *ChildBenefitFactory) initConstructor(allParams ...history.CBParams) {
 				if err != nil {
 					return nil, errors.Wrap(err, "error creating income calculator")
 				}
-				cfg := benefits.CalcConfigCB{Formula: formula, IncomeCalc: incomeCalc}
+				cfg := benefits.CalcConfigCB{
+					Formula:       formula,
+					IncomeCalc:    incomeCalc,
+					ReferenceDate: referenceDate,
+				}
 				cbCalcs[i], err = benefits.NewChildBenefitCalculator(cfg)
 				if err != nil {
 					return nil, errors.Wrap(err, "error creating child benefit calculator")
@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// ChildBenefitCalendarFactory is a type used to conveniently create calendar
+// year child benefit calculators
+type ChildBenefitCalendarFactory struct {
+	newCalculator func() (core.CalendarChildBenefitCalculator, error)
+}
+
+// NewChildBenefitCalendarFactory returns a new factory of calculators which
+// compute the child benefits paid in each month of the given calendar year.
+// The payments from January to June use the params of the benefit year that
+// starts in the previous year and the payments from July to December use the
+// params of the benefit year that starts in the given year. If multiple regions
+// are specified, the returned calculator aggregates the benefits for all
+// beneficiaries
+func NewChildBenefitCalendarFactory(year uint, regions ...core.Region) *ChildBenefitCalendarFactory {
+	return NewChildBenefitCalendarFactoryWithRegistry(history.DefaultRegistry(), year, regions...)
+}
+
+// NewChildBenefitCalendarFactoryWithRegistry is like
+// NewChildBenefitCalendarFactory, except that the child benefit params are
+// looked up in the given registry instead of the default one
+func NewChildBenefitCalendarFactoryWithRegistry(registry *history.Registry, year uint, regions ...core.Region) *ChildBenefitCalendarFactory {
+
+	calcFactory := &ChildBenefitCalendarFactory{}
+	if year == 0 {
+		calcFactory.setFailingConstructor(
+			errors.Wrap(history.ErrParamsNotExist, "no benefit year before year 0"),
+		)
+		return calcFactory
+	}
+
+	previous := NewChildBenefitFactoryWithRegistry(registry, year-1, regions...)
+	current := NewChildBenefitFactoryWithRegistry(registry, year, regions...)
+
+	calcFactory.newCalculator = func() (core.CalendarChildBenefitCalculator, error) {
+
+		previousCalc, err := previous.NewCalculator()
+		if err != nil {
+			return nil, errors.Wrapf(err, "benefit year %d", year-1)
+		}
+
+		currentCalc, err := current.NewCalculator()
+		if err != nil {
+			return nil, errors.Wrapf(err, "benefit year %d", year)
+		}
+
+		return benefits.NewChildBenefitCalendar(previousCalc, currentCalc)
+	}
+	return calcFactory
+}
+
+// NewCalculator creates a new calendar year child benefit calculator that is
+// configured with the params set in this factory
+func (f *ChildBenefitCalendarFactory) NewCalculator() (core.CalendarChildBenefitCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *ChildBenefitCalendarFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.CalendarChildBenefitCalculator, error) {
+		return nil, errors.Wrap(err, "child benefit calendar factory error")
+	}
+}
@@ -0,0 +1,99 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+func TestNewChildBenefitCalendarFactory_NewCalculator(t *testing.T) {
+
+	c, err := NewChildBenefitCalendarFactory(2024, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := c.(*benefits.ChildBenefitCalendar)
+	if !ok {
+		t.Fatalf("unexpected type\nwant: %T\n got: %T", (&benefits.ChildBenefitCalendar{}), c)
+	}
+}
+
+func TestChildBenefitCalendarFactory_NewCalculator_Errors(t *testing.T) {
+
+	_, err := (&ChildBenefitCalendarFactory{}).NewCalculator()
+	if err != ErrFactoryNotInit {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrFactoryNotInit, err)
+	}
+}
+
+func TestNewChildBenefitCalendarFactory_Errors(t *testing.T) {
+
+	cases := []struct {
+		name    string
+		year    uint
+		regions []core.Region
+		err     error
+	}{
+		{
+			name:    "year-zero",
+			year:    0,
+			regions: []core.Region{core.RegionCA},
+			err:     history.ErrParamsNotExist,
+		},
+		{
+			name:    "no-previous-benefit-year",
+			year:    2017,
+			regions: []core.Region{core.RegionCA},
+			err:     history.ErrParamsNotExist,
+		},
+		{
+			name:    "no-current-benefit-year",
+			year:    2100,
+			regions: []core.Region{core.RegionCA},
+			err:     history.ErrParamsNotExist,
+		},
+		{
+			name:    "no-regions",
+			year:    2024,
+			regions: nil,
+			err:     benefits.ErrNoFormula,
+		},
+		{
+			name:    "valid",
+			year:    2024,
+			regions: []core.Region{core.RegionCA},
+			err:     nil,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			_, err := NewChildBenefitCalendarFactory(c.year, c.regions...).NewCalculator()
+			cause := errors.Cause(err)
+			if cause != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestNewChildBenefitCalendarFactoryWithRegistry(t *testing.T) {
+
+	_, err := NewChildBenefitCalendarFactoryWithRegistry(history.DefaultRegistry().Clone(), 2024, core.RegionCA).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewChildBenefitCalendarFactoryWithRegistry(nil, 2024, core.RegionCA).NewCalculator()
+	if errors.Cause(err) != ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoRegistry, err)
+	}
+}
@@ -82,3 +82,21 @@ func TestNewChildBenefitCalculatorFactory_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestNewChildBenefitFactoryWithRegistry(t *testing.T) {
+
+	_, err := NewChildBenefitFactoryWithRegistry(history.DefaultRegistry().Clone(), 2018, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewChildBenefitFactoryWithRegistry(history.NewRegistry(), 2018, core.RegionBC).NewCalculator()
+	if errors.Cause(err) != history.ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", history.ErrRegionNotExist, err)
+	}
+
+	_, err = NewChildBenefitFactoryWithRegistry(nil, 2018, core.RegionBC).NewCalculator()
+	if errors.Cause(err) != ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoRegistry, err)
+	}
+}
@@ -0,0 +1,82 @@
+package factory
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// CWBFactory is a type used to conveniently create Canada Workers Benefit
+// calculators
+type CWBFactory struct {
+	newCalculator func() (core.CWBCalculator, error)
+}
+
+// NewCWBFactory returns a new Canada Workers Benefit calculator factory from the
+// params of the given tax year and region
+func NewCWBFactory(year uint, region core.Region) *CWBFactory {
+	return NewCWBFactoryWithRegistry(history.DefaultRegistry(), year, region)
+}
+
+// NewCWBFactoryWithRegistry is like NewCWBFactory, except that the CWB params
+// are looked up in the given registry instead of the default one
+func NewCWBFactoryWithRegistry(registry *history.Registry, year uint, region core.Region) *CWBFactory {
+
+	calcFactory := &CWBFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
+	foundParams, err := registry.CWBParams(year, region)
+	if err != nil {
+		calcFactory.setFailingConstructor(
+			errors.Wrapf(err, "CWB formula for region %q", region),
+		)
+		return calcFactory
+	}
+
+	calcFactory.initConstructor(foundParams)
+	return calcFactory
+}
+
+// NewCalculator creates a new CWB calculator that is configured with the params
+// set in this factory
+func (f *CWBFactory) NewCalculator() (core.CWBCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *CWBFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.CWBCalculator, error) {
+		return nil, errors.Wrap(err, "CWB factory error")
+	}
+}
+
+// initConstructor initializes this factory's 'newCalculator' function from the
+// given params
+func (f *CWBFactory) initConstructor(params history.CWBParams) {
+
+	f.newCalculator = func() (core.CWBCalculator, error) {
+		incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating income calculator")
+		}
+		workingCalc, err := income.NewCalculator(params.WorkingIncomeRecipe)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating working income calculator")
+		}
+		cfg := benefits.CalcConfigCWB{
+			Formula:           params.Formula,
+			IncomeCalc:        incomeCalc,
+			WorkingIncomeCalc: workingCalc,
+		}
+		return benefits.NewCWBCalculator(cfg)
+	}
+}
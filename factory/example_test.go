@@ -1,10 +1,12 @@
 package factory
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
 )
 
 func ExampleNewTaxFactory() {
@@ -68,6 +70,85 @@ func ExampleNewChildBenefitFactory() {
 	fmt.Printf("%.2f", total) // Output: 6742.54
 }
 
+func ExampleNewChildBenefitFactory_bcFamilyBenefit() {
+
+	// a single parent recieves the single-parent supplement, which is reduced
+	// along with the maximum benefit by 4% of their income above $35902
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 40000},
+	)
+	children := []*human.Person{{AgeMonths: 4 * 12}}
+
+	calculator, err := NewChildBenefitFactory(2023, core.RegionBC).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetBeneficiaries(children)
+	calculator.SetFinances(finances)
+
+	fmt.Printf("BC family benefit: %.2f\n", calculator.BenefitRecievable())
+	// Output:
+	// BC family benefit: 2524.08
+}
+
+func ExampleNewChildBenefitFactory_paymentSchedule() {
+
+	// the child turns 6 in April, which is the 10th month of the benefit year
+	// that starts in July, after which the maximum benefits are lower
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 40000},
+	)
+	children := []*human.Person{{AgeMonths: 5*12 + 3}}
+
+	calculator, err := NewChildBenefitFactory(2024, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetBeneficiaries(children)
+	calculator.SetFinances(finances)
+
+	schedule := calculator.PaymentSchedule()
+	fmt.Printf("July: %.2f\n", schedule[0])
+	fmt.Printf("June: %.2f\n", schedule[11])
+	fmt.Printf("changes in months: %v\n", schedule.ChangeMonths())
+	// Output:
+	// July: 628.50
+	// June: 527.10
+	// changes in months: [9]
+}
+
+func ExampleNewChildBenefitCalendarFactory() {
+
+	// the payments from January to June are based on the income of 2022 and
+	// those from July to December are based on the income of 2023. The child
+	// turns 6 in July, which reduces the maximum Canada child benefit
+	finFactory := NewFinanceFactory()
+	previousBase := finFactory.NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 40000},
+	)
+	currentBase := finFactory.NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 45000},
+	)
+	children := []*human.Person{{AgeMonths: 5*12 + 6}}
+
+	calculator, err := NewChildBenefitCalendarFactory(2024, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetBeneficiaries(children)
+	calculator.SetFinances(previousBase, currentBase)
+
+	payments := calculator.MonthlyBenefits()
+	fmt.Printf("January: %.2f\n", payments[0])
+	fmt.Printf("July: %.2f\n", payments[6])
+	// Output:
+	// January: 589.78
+	// July: 497.93
+}
+
 func ExampleNewRRSPFactory() {
 
 	config := RRSPFactoryConfig{
@@ -105,3 +186,331 @@ func ExampleNewRRSPFactory() {
 	// 5182.74
 	// 3468.28
 }
+
+func ExampleNewTaxFactory_marginalRates() {
+
+	initAmounts := map[core.FinancialSource]float64{
+		core.IncSrcEarned: 100000.0,
+	}
+	myFinances := NewFinanceFactory().NewHouseholdFinancesForSingle(initAmounts)
+
+	calculator, err := NewTaxFactory(2018, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(myFinances, nil)
+
+	rates, _ := calculator.TaxRates(core.IncSrcEarned, core.IncSrcCapitalGainCA)
+	fmt.Printf("statutory: %.4f\n", rates.Statutory)
+	fmt.Printf("earned: %.4f\n", rates.Effective[core.IncSrcEarned])
+	fmt.Printf("capital gains: %.4f\n", rates.Effective[core.IncSrcCapitalGainCA])
+
+	// Output:
+	// statutory: 0.3829
+	// earned: 0.3829
+	// capital gains: 0.1915
+}
+
+func ExampleNewPayrollFactory() {
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 100000},
+	)
+
+	payrollCalc, err := NewPayrollFactory(2022, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	taxCalc, err := NewTaxFactory(2022, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	taxCalc.SetFinances(finances, nil)
+	taxBefore, _, _ := taxCalc.TaxPayable()
+
+	// record the contributions so that the tax calculator can use them as
+	// deductions and tax credits
+	payrollCalc.SetFinances(finances)
+	contributions, _ := payrollCalc.Contributions()
+	for source, amount := range contributions {
+		finances.MutableSpouseA().AddAmount(source, amount)
+	}
+	taxAfter, _, _ := taxCalc.TaxPayable()
+
+	fmt.Printf("CPP (base): %.2f\n", contributions[core.MiscSrcCPPBase])
+	fmt.Printf("CPP (enhanced): %.2f\n", contributions[core.DeducSrcCPPEnhanced])
+	fmt.Printf("EI: %.2f\n", contributions[core.MiscSrcEIPremiums])
+	fmt.Printf("tax reduction: %.2f\n", taxBefore-taxAfter)
+	// Output:
+	// CPP (base): 3039.30
+	// CPP (enhanced): 460.50
+	// EI: 952.74
+	// tax reduction: 693.21
+}
+
+func ExampleNewOASFactory() {
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 100000},
+	)
+	pensioner := &human.Pensioner{
+		Person:         human.Person{AgeMonths: 70 * 12},
+		ResidenceYears: 40,
+	}
+
+	oasCalc, err := NewOASFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	taxCalc, err := NewTaxFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// record the pension so that the recovery tax can be computed on it
+	oasCalc.SetPensioners(pensioner, nil)
+	pension, _ := oasCalc.PensionRecievable()
+	finances.MutableSpouseA().AddAmount(core.IncSrcOAS, pension)
+
+	oasCalc.SetFinances(finances)
+	recovery, _ := oasCalc.RecoveryTax()
+
+	taxCalc.SetFinances(finances, nil)
+	breakdowns, _, _ := taxCalc.TaxBreakdown()
+
+	fmt.Printf("pension: %.2f\n", pension)
+	fmt.Printf("recovery tax: %.2f\n", recovery)
+	for _, adj := range breakdowns[0].Adjustments {
+		fmt.Printf("%s: %.2f\n", adj.Description, adj.Amount)
+	}
+	// Output:
+	// pension: 8791.14
+	// recovery tax: 2300.57
+	// OAS recovery tax: 2300.57
+}
+
+func ExampleNewGISFactory() {
+
+	// the supplement of a couple of pensioners is tested against their combined
+	// income, which excludes the OAS pension, the first $5000 of earnings, and
+	// half of the next $10000 of earnings
+	finances := NewFinanceFactory().NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{core.IncSrcOAS: 8791.14, core.IncSrcEarned: 7000},
+		map[core.FinancialSource]float64{core.IncSrcOAS: 8791.14, core.IncSrcInterest: 1000},
+	)
+	spouseA := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 40}
+	spouseB := &human.Pensioner{Person: human.Person{AgeMonths: 68 * 12}, ResidenceYears: 40}
+
+	calculator, err := NewGISFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances)
+	calculator.SetPensioners(spouseA, spouseB)
+
+	supplementA, supplementB := calculator.BenefitRecievable()
+	fmt.Printf("spouse A: %.2f\n", supplementA)
+	fmt.Printf("spouse B: %.2f\n", supplementB)
+	// Output:
+	// spouse A: 7403.95
+	// spouse B: 7403.95
+}
+
+func ExampleNewGSTCreditFactory() {
+
+	// a single parent recieves the adult amount for their first child and the
+	// full single supplement
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 50000},
+	)
+	children := []*human.Person{{AgeMonths: 5 * 12}, {AgeMonths: 8 * 12}}
+
+	calculator, err := NewGSTCreditFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances)
+	calculator.SetDependents(children)
+
+	fmt.Printf("GST/HST credit: %.2f\n", calculator.BenefitRecievable())
+	// Output:
+	// GST/HST credit: 842.05
+}
+
+func ExampleNewCWBFactory() {
+
+	// a single worker who is eligible for the disability tax credit recieves
+	// the maximum disability supplement and a basic amount that is reduced by
+	// 15% of their net income above $26855
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 30000},
+	)
+
+	calculator, err := NewCWBFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances)
+	calculator.SetDisabilities(true, false)
+
+	benefit, _ := calculator.BenefitRecievable()
+	fmt.Printf("CWB: %.2f\n", benefit)
+	// Output:
+	// CWB: 2004.25
+}
+
+func ExampleNewCWBFactory_couple() {
+
+	// the spouse who is eligible for the disability supplement is designated
+	// to claim the basic amount, and the first $12000 of the lower working
+	// income is excluded from the family net income
+	finances := NewFinanceFactory().NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 28000},
+		map[core.FinancialSource]float64{core.IncSrcEarned: 12000},
+	)
+
+	calculator, err := NewCWBFactory(2025, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances)
+	calculator.SetDisabilities(false, true)
+
+	benefitA, benefitB := calculator.BenefitRecievable()
+	fmt.Printf("spouse A: %.2f\n", benefitA)
+	fmt.Printf("spouse B: %.2f\n", benefitB)
+	// Output:
+	// spouse A: 0.00
+	// spouse B: 3656.00
+}
+
+func ExampleNewTaxFactory_ontario() {
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 60000},
+	)
+
+	calculator, err := NewTaxFactory(2019, core.RegionON).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances, nil)
+
+	breakdowns, _, _ := calculator.TaxBreakdown()
+	for _, bd := range breakdowns {
+		fmt.Printf("basic tax: %.2f\n", bd.GrossTax-bd.CreditsApplied)
+		for _, adj := range bd.Adjustments {
+			fmt.Printf("%s: %.2f\n", adj.Description, adj.Amount)
+		}
+		fmt.Printf("net payable: %.2f\n", bd.NetPayable)
+	}
+	// Output:
+	// basic tax: 3155.46
+	// Ontario surtax: 0.00
+	// Ontario dividend tax credit for eligible dividends: 0.00
+	// Ontario dividend tax credit for non-eligible dividends: 0.00
+	// Ontario tax reduction: 0.00
+	// Ontario health premium: 600.00
+	// net payable: 3755.46
+}
+
+func ExampleNewTaxFactory_quebec() {
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 60000},
+	)
+
+	calculator, err := NewTaxFactory(2019, core.RegionCA, core.RegionQC).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(finances, nil)
+
+	breakdowns, _, _ := calculator.TaxBreakdown()
+	for _, bd := range breakdowns {
+		fmt.Printf("%s basic tax: %.2f\n", bd.Region, bd.GrossTax-bd.CreditsApplied)
+		for _, adj := range bd.Adjustments {
+			fmt.Printf("%s: %.2f\n", adj.Description, adj.Amount)
+		}
+	}
+
+	total, _, _ := calculator.TaxPayable()
+	fmt.Printf("total: %.2f\n", total)
+	// Output:
+	// Canada basic tax: 7870.00
+	// OAS recovery tax: 0.00
+	// refundable Quebec abatement: -1298.55
+	// Quebec basic tax: 7285.15
+	// total: 13856.60
+}
+
+func ExampleNewTaxFactory_carryForwardCredits() {
+
+	// a student whose tuition credits exceed the tax payable in 2019
+	lastYear := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned:   20000,
+			core.MiscSrcTuition: 30000,
+		},
+	)
+
+	calculator, err := NewTaxFactory(2019, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(lastYear, nil)
+	_, _, credits := calculator.TaxPayable()
+
+	// the unused credits can be saved along with the tax return...
+	saved, err := json.Marshal(tax.NewCreditRecords(credits, lastYear))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// ...and used to reduce the payable tax of the following year
+	thisYear := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 60000},
+	)
+
+	var records []tax.CreditRecord
+	err = json.Unmarshal(saved, &records)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	carried, err := tax.BindCreditRecords(records, thisYear)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	calculator, err = NewTaxFactory(2020, core.RegionCA).NewCalculator()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	calculator.SetFinances(thisYear, nil)
+	taxBefore, _, _ := calculator.TaxPayable()
+
+	calculator.SetFinances(thisYear, carried)
+	taxAfter, _, _ := calculator.TaxPayable()
+
+	fmt.Printf("without carried credits: %.2f\n", taxBefore)
+	fmt.Printf("with carried credits: %.2f\n", taxAfter)
+	// Output:
+	// without carried credits: 7646.23
+	// with carried credits: 4335.88
+}
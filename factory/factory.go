@@ -5,5 +5,7 @@ import "errors"
 
 // Errors this package may return and can be checked with errors.Cause()
 var (
-	ErrFactoryNotInit = errors.New("factory is improperly initialized")
+	ErrFactoryNotInit   = errors.New("factory is improperly initialized")
+	ErrNoRegistry       = errors.New("no params registry is given")
+	ErrInvalidHousehold = errors.New("invalid household description")
 )
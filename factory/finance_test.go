@@ -1,8 +1,11 @@
 package factory
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
+	"github.com/go-test/deep"
 	"github.com/malkhamis/quantax/core"
 )
 
@@ -81,3 +84,34 @@ func TestFinanceFactory_NewHouseholFinancesForSingle(t *testing.T) {
 		t.Errorf("expected spouse B finances to be nil")
 	}
 }
+
+func TestFinanceFactory_JSON(t *testing.T) {
+
+	amounts := map[core.FinancialSource]float64{
+		core.IncSrcEarned:   1000,
+		core.DeducSrcRRSP:   200,
+		core.MiscSrcTuition: 30,
+	}
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(amounts)
+
+	data, err := json.Marshal(finances.SpouseA())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[core.FinancialSource]float64
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(decoded, amounts)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	diff = deep.Equal(NewFinanceFactory().NewFinances(decoded), finances.SpouseA())
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
@@ -0,0 +1,81 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// GISFactory is a type used to conveniently create Guaranteed Income Supplement
+// calculators
+type GISFactory struct {
+	newCalculator func() (core.GISCalculator, error)
+}
+
+// NewGISFactory returns a new GIS calculator factory from the params of the
+// given year and region
+func NewGISFactory(year uint, region core.Region) *GISFactory {
+	return NewGISFactoryWithRegistry(history.DefaultRegistry(), year, region)
+}
+
+// NewGISFactoryWithRegistry is like NewGISFactory, except that the GIS params
+// are looked up in the given registry instead of the default one
+func NewGISFactoryWithRegistry(registry *history.Registry, year uint, region core.Region) *GISFactory {
+
+	calcFactory := &GISFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
+	foundParams, err := registry.GISParams(year, region)
+	if err != nil {
+		calcFactory.setFailingConstructor(
+			errors.Wrapf(err, "GIS formula for region %q", region),
+		)
+		return calcFactory
+	}
+
+	calcFactory.initConstructor(human.TaxYearStart(year), foundParams)
+	return calcFactory
+}
+
+// NewCalculator creates a new GIS calculator that is configured with the
+// params set in this factory
+func (f *GISFactory) NewCalculator() (core.GISCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *GISFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.GISCalculator, error) {
+		return nil, errors.Wrap(err, "GIS factory error")
+	}
+}
+
+// initConstructor initializes this factory's 'newCalculator' function from the
+// given params, where the reference date is the first day of the year
+func (f *GISFactory) initConstructor(referenceDate time.Time, params history.GISParams) {
+
+	f.newCalculator = func() (core.GISCalculator, error) {
+		incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating income calculator")
+		}
+		cfg := benefits.CalcConfigGIS{
+			Formula:       params.Formula,
+			IncomeCalc:    incomeCalc,
+			ReferenceDate: referenceDate,
+		}
+		return benefits.NewGISCalculator(cfg)
+	}
+}
@@ -0,0 +1,83 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// GSTCreditFactory is a type used to conveniently create GST/HST credit
+// calculators
+type GSTCreditFactory struct {
+	newCalculator func() (core.BenefitCalculator, error)
+}
+
+// NewGSTCreditFactory returns a new GST/HST credit calculator factory from the
+// params of the given year and region. The params of a year are for the benefit
+// year that starts in July of that year
+func NewGSTCreditFactory(year uint, region core.Region) *GSTCreditFactory {
+	return NewGSTCreditFactoryWithRegistry(history.DefaultRegistry(), year, region)
+}
+
+// NewGSTCreditFactoryWithRegistry is like NewGSTCreditFactory, except that the
+// GST/HST credit params are looked up in the given registry instead of the
+// default one
+func NewGSTCreditFactoryWithRegistry(registry *history.Registry, year uint, region core.Region) *GSTCreditFactory {
+
+	calcFactory := &GSTCreditFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
+	foundParams, err := registry.GSTCreditParams(year, region)
+	if err != nil {
+		calcFactory.setFailingConstructor(
+			errors.Wrapf(err, "GST/HST credit formula for region %q", region),
+		)
+		return calcFactory
+	}
+
+	calcFactory.initConstructor(human.BenefitYearStart(year), foundParams)
+	return calcFactory
+}
+
+// NewCalculator creates a new GST/HST credit calculator that is configured with
+// the params set in this factory
+func (f *GSTCreditFactory) NewCalculator() (core.BenefitCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *GSTCreditFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.BenefitCalculator, error) {
+		return nil, errors.Wrap(err, "GST/HST credit factory error")
+	}
+}
+
+// initConstructor initializes this factory's 'newCalculator' function from the
+// given params, where the reference date is the first day of the benefit year
+func (f *GSTCreditFactory) initConstructor(referenceDate time.Time, params history.GSTCreditParams) {
+
+	f.newCalculator = func() (core.BenefitCalculator, error) {
+		incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating income calculator")
+		}
+		cfg := benefits.CalcConfigHB{
+			Formula:       params.Formula,
+			IncomeCalc:    incomeCalc,
+			ReferenceDate: referenceDate,
+		}
+		return benefits.NewHouseholdBenefitCalculator(cfg)
+	}
+}
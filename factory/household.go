@@ -0,0 +1,142 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/pkg/errors"
+)
+
+// BirthDateLayout is the layout of birth dates in person descriptions
+const BirthDateLayout = "2006-01-02"
+
+// HouseholdDesc is the description of a household as given by users, e.g. in
+// JSON documents, where the amounts of the spouses are keyed by financial
+// source names
+type HouseholdDesc struct {
+	// Year is the tax year of the calculations
+	Year uint `json:"year"`
+	// Regions are the regions to calculate taxes or benefits for
+	Regions []core.Region `json:"regions"`
+	// SpouseA are the amounts of spouse A keyed by financial source names
+	SpouseA map[core.FinancialSource]float64 `json:"spouse_a"`
+	// SpouseB are the amounts of spouse B keyed by financial source names. If
+	// not set, the household is a single-person household
+	SpouseB map[core.FinancialSource]float64 `json:"spouse_b,omitempty"`
+	// Dependents are the dependents of the household
+	Dependents []PersonDesc `json:"dependents,omitempty"`
+}
+
+// Validate ensures that this description has all the required fields and
+// that its dependents are valid. Otherwise, it returns wrapped
+// ErrInvalidHousehold
+func (h *HouseholdDesc) Validate() error {
+
+	if h.Year == 0 {
+		return errors.Wrap(ErrInvalidHousehold, "missing year")
+	}
+
+	if len(h.Regions) == 0 {
+		return errors.Wrap(ErrInvalidHousehold, "missing regions")
+	}
+
+	if h.SpouseA == nil {
+		return errors.Wrap(ErrInvalidHousehold, "missing spouse_a")
+	}
+
+	_, err := h.DependentPeople()
+	return err
+}
+
+// IsCouple returns true if the described household has two spouses
+func (h *HouseholdDesc) IsCouple() bool {
+	return h.SpouseB != nil
+}
+
+// Finances returns new household finances initialized with the amounts of the
+// spouses, where the finances of spouse B are nil for single-person households
+func (h *HouseholdDesc) Finances() core.HouseholdFinanceMutator {
+
+	ff := NewFinanceFactory()
+	if !h.IsCouple() {
+		return ff.NewHouseholdFinancesForSingle(h.SpouseA)
+	}
+	return ff.NewHouseholdFinancesForCouple(h.SpouseA, h.SpouseB)
+}
+
+// DependentPeople returns the described dependents of the household. If one
+// of them is invalid, it returns wrapped ErrInvalidHousehold
+func (h *HouseholdDesc) DependentPeople() ([]*human.Person, error) {
+
+	var dependents []*human.Person
+	for i, d := range h.Dependents {
+		person, err := d.Person()
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidHousehold, "dependent %d: %v", i, err)
+		}
+		dependents = append(dependents, person)
+	}
+	return dependents, nil
+}
+
+// PersonDesc is the description of a person as given by users, e.g. in JSON
+// documents, where dates and enumerations are given by their names
+type PersonDesc struct {
+	Name      string `json:"name"`
+	AgeMonths uint   `json:"age_months"`
+	// BirthDate is the optional birth date of the person as YYYY-MM-DD, which
+	// takes precedence over the age in months if set
+	BirthDate string `json:"birth_date,omitempty"`
+	// DisabilityEligible is true if the person is eligible for the disability
+	// tax credit
+	DisabilityEligible bool `json:"disability_eligible,omitempty"`
+	// Student is either empty, "part_time", or "full_time"
+	Student string `json:"student,omitempty"`
+	// Residency is either empty, "resident", "part_year", or "non_resident"
+	Residency string `json:"residency,omitempty"`
+	// DaysResident is the number of days a part-year resident resided in
+	// Canada in the year
+	DaysResident uint `json:"days_resident,omitempty"`
+	// CustodyPercent is the percentage of time the person lives with the
+	// household under shared custody, where zero means full custody
+	CustodyPercent uint `json:"custody_percent,omitempty"`
+}
+
+// Person returns the person of this description, or an error if the
+// description is invalid
+func (d PersonDesc) Person() (*human.Person, error) {
+
+	person := &human.Person{
+		Name:               d.Name,
+		AgeMonths:          d.AgeMonths,
+		DisabilityEligible: d.DisabilityEligible,
+		DaysResident:       d.DaysResident,
+		CustodyPercent:     d.CustodyPercent,
+	}
+
+	var err error
+	if d.BirthDate != "" {
+		person.BirthDate, err = time.Parse(BirthDateLayout, d.BirthDate)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid birth_date")
+		}
+	}
+
+	person.Student, err = human.ParseStudentStatus(d.Student)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid student %q", d.Student)
+	}
+
+	person.Residency, err = human.ParseResidency(d.Residency)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid residency %q", d.Residency)
+	}
+
+	err = person.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
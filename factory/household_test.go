@@ -0,0 +1,183 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+
+	"github.com/pkg/errors"
+)
+
+func TestHouseholdDesc(t *testing.T) {
+
+	h := &HouseholdDesc{
+		Year:       2019,
+		Regions:    []core.Region{core.RegionCA},
+		SpouseA:    map[core.FinancialSource]float64{core.IncSrcEarned: 50000},
+		Dependents: []PersonDesc{{Name: "A", AgeMonths: 12}},
+	}
+
+	err := h.Validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h.IsCouple() {
+		t.Error("expected household without spouse_b not to be a couple")
+	}
+
+	finances := h.Finances()
+	if finances.SpouseB() != nil {
+		t.Error("expected nil finances for spouse b of a single-person household")
+	}
+	actual := finances.SpouseA().TotalAmount(core.IncSrcEarned)
+	if actual != 50000 {
+		t.Errorf("unexpected amounts\nwant: %.2f\n got: %.2f", 50000.0, actual)
+	}
+
+	h.SpouseB = map[core.FinancialSource]float64{}
+	if !h.IsCouple() {
+		t.Error("expected household with an empty spouse_b to be a couple")
+	}
+	if h.Finances().SpouseB() == nil {
+		t.Error("expected non-nil finances for spouse b of a couple")
+	}
+
+	dependents, err := h.DependentPeople()
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := deep.Equal(dependents, []*human.Person{{Name: "A", AgeMonths: 12}})
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestHouseholdDesc_Validate_Errors(t *testing.T) {
+
+	cases := []struct {
+		name string
+		desc HouseholdDesc
+	}{
+		{
+			name: "missing-year",
+			desc: HouseholdDesc{
+				Regions: []core.Region{core.RegionCA},
+				SpouseA: map[core.FinancialSource]float64{},
+			},
+		},
+		{
+			name: "missing-regions",
+			desc: HouseholdDesc{
+				Year:    2019,
+				SpouseA: map[core.FinancialSource]float64{},
+			},
+		},
+		{
+			name: "missing-spouse-a",
+			desc: HouseholdDesc{
+				Year:    2019,
+				Regions: []core.Region{core.RegionCA},
+			},
+		},
+		{
+			name: "invalid-dependent",
+			desc: HouseholdDesc{
+				Year:       2019,
+				Regions:    []core.Region{core.RegionCA},
+				SpouseA:    map[core.FinancialSource]float64{},
+				Dependents: []PersonDesc{{Residency: "abroad"}},
+			},
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			err := c.desc.Validate()
+			if errors.Cause(err) != ErrInvalidHousehold {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidHousehold, err)
+			}
+		})
+	}
+}
+
+func TestPersonDesc_Person(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		desc     PersonDesc
+		expected *human.Person
+		err      error
+	}{
+		{
+			name:     "age-months",
+			desc:     PersonDesc{Name: "A", AgeMonths: 30},
+			expected: &human.Person{Name: "A", AgeMonths: 30},
+		},
+		{
+			name: "all-attributes",
+			desc: PersonDesc{
+				Name:               "B",
+				BirthDate:          "2018-10-15",
+				DisabilityEligible: true,
+				Student:            "full_time",
+				Residency:          "part_year",
+				DaysResident:       100,
+				CustodyPercent:     50,
+			},
+			expected: &human.Person{
+				Name:               "B",
+				BirthDate:          time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC),
+				DisabilityEligible: true,
+				Student:            human.FullTimeStudent,
+				Residency:          human.PartYearResident,
+				DaysResident:       100,
+				CustodyPercent:     50,
+			},
+		},
+		{
+			name: "invalid-student",
+			desc: PersonDesc{Student: "always"},
+			err:  human.ErrInvalidStudentStatus,
+		},
+		{
+			name: "invalid-residency",
+			desc: PersonDesc{Residency: "abroad"},
+			err:  human.ErrInvalidResidency,
+		},
+		{
+			name: "invalid-custody",
+			desc: PersonDesc{CustodyPercent: 101},
+			err:  human.ErrInvalidCustody,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual, err := c.desc.Person()
+			if errors.Cause(err) != c.err {
+				t.Fatalf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+			if diff := deep.Equal(actual, c.expected); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPersonDesc_Person_InvalidBirthDate(t *testing.T) {
+
+	_, err := PersonDesc{BirthDate: "15/10/2018"}.Person()
+	if err == nil {
+		t.Error("expected an error for an invalid birth date")
+	}
+}
@@ -0,0 +1,80 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// OASFactory is a type used to conveniently create Old Age Security calculators
+type OASFactory struct {
+	newCalculator func() (core.OASCalculator, error)
+}
+
+// NewOASFactory returns a new OAS calculator factory from the params of the
+// given year and region
+func NewOASFactory(year uint, region core.Region) *OASFactory {
+	return NewOASFactoryWithRegistry(history.DefaultRegistry(), year, region)
+}
+
+// NewOASFactoryWithRegistry is like NewOASFactory, except that the OAS params
+// are looked up in the given registry instead of the default one
+func NewOASFactoryWithRegistry(registry *history.Registry, year uint, region core.Region) *OASFactory {
+
+	calcFactory := &OASFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
+	foundParams, err := registry.OASParams(year, region)
+	if err != nil {
+		calcFactory.setFailingConstructor(
+			errors.Wrapf(err, "OAS formula for region %q", region),
+		)
+		return calcFactory
+	}
+
+	calcFactory.initConstructor(human.TaxYearStart(year), foundParams)
+	return calcFactory
+}
+
+// NewCalculator creates a new OAS calculator that is configured with the
+// params set in this factory
+func (f *OASFactory) NewCalculator() (core.OASCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *OASFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.OASCalculator, error) {
+		return nil, errors.Wrap(err, "OAS factory error")
+	}
+}
+
+// initConstructor initializes this factory's 'newCalculator' function from the
+// given params, where the reference date is the first day of the year
+func (f *OASFactory) initConstructor(referenceDate time.Time, params history.OASParams) {
+
+	f.newCalculator = func() (core.OASCalculator, error) {
+		incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating income calculator")
+		}
+		cfg := benefits.CalcConfigOAS{
+			Formula:       params.Formula,
+			IncomeCalc:    incomeCalc,
+			ReferenceDate: referenceDate,
+		}
+		return benefits.NewOASCalculator(cfg)
+	}
+}
@@ -0,0 +1,68 @@
+package factory
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/payroll"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+// PayrollFactory is a type used to conveniently create payroll calculators
+type PayrollFactory struct {
+	newCalculator func() (core.PayrollCalculator, error)
+}
+
+// NewPayrollFactory returns a new payroll calculator factory for the given year
+// and region. The region should be RegionQC for employees working in Quebec
+// and RegionCA otherwise
+func NewPayrollFactory(year uint, region core.Region) *PayrollFactory {
+	return NewPayrollFactoryWithRegistry(history.DefaultRegistry(), year, region)
+}
+
+// NewPayrollFactoryWithRegistry is like NewPayrollFactory, except that the
+// payroll params are looked up in the given registry instead of the default
+// one
+func NewPayrollFactoryWithRegistry(registry *history.Registry, year uint, region core.Region) *PayrollFactory {
+
+	calcFactory := &PayrollFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
+	foundParams, err := registry.PayrollParams(year, region)
+	if err != nil {
+		calcFactory.setFailingConstructor(
+			errors.Wrapf(err, "payroll formulas for region %q", region),
+		)
+		return calcFactory
+	}
+
+	calcFactory.initConstructor(foundParams)
+	return calcFactory
+}
+
+// NewCalculator creates a new payroll calculator that is configured with the
+// params set in this factory
+func (f *PayrollFactory) NewCalculator() (core.PayrollCalculator, error) {
+	if f.newCalculator == nil {
+		return nil, ErrFactoryNotInit
+	}
+	return f.newCalculator()
+}
+
+// setFailingConstructor makes calls to NewCalculator returns nil, err
+func (f *PayrollFactory) setFailingConstructor(err error) {
+	f.newCalculator = func() (core.PayrollCalculator, error) {
+		return nil, errors.Wrap(err, "payroll factory error")
+	}
+}
+
+// initConstructor initializes this factory's 'newCalculator' function from the
+// given params
+func (f *PayrollFactory) initConstructor(params history.PayrollParams) {
+	f.newCalculator = func() (core.PayrollCalculator, error) {
+		return payroll.NewCalculator(payroll.CalcConfig{Formulas: params.Formulas})
+	}
+}
@@ -0,0 +1,67 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/history"
+
+	"github.com/pkg/errors"
+)
+
+func TestPayrollFactory_Uninitialized(t *testing.T) {
+
+	_, err := (&PayrollFactory{}).NewCalculator()
+	if err != ErrFactoryNotInit {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrFactoryNotInit, err)
+	}
+
+}
+
+func TestPayrollFactory_Errors(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		year   uint
+		region core.Region
+		err    error
+	}{
+		{name: "invalid-year", year: 1000, region: core.RegionCA, err: history.ErrParamsNotExist},
+		{name: "invalid-region", year: 2018, region: "1000", err: history.ErrRegionNotExist},
+		{name: "valid-canada", year: 2018, region: core.RegionCA, err: nil},
+		{name: "valid-quebec", year: 2025, region: core.RegionQC, err: nil},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			f := NewPayrollFactory(c.year, c.region)
+			_, err := f.NewCalculator()
+			cause := errors.Cause(err)
+			if cause != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+
+		})
+	}
+}
+
+func TestNewPayrollFactoryWithRegistry(t *testing.T) {
+
+	_, err := NewPayrollFactoryWithRegistry(history.DefaultRegistry().Clone(), 2022, core.RegionCA).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewPayrollFactoryWithRegistry(history.NewRegistry(), 2022, core.RegionCA).NewCalculator()
+	if errors.Cause(err) != history.ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", history.ErrRegionNotExist, err)
+	}
+
+	_, err = NewPayrollFactoryWithRegistry(nil, 2022, core.RegionCA).NewCalculator()
+	if errors.Cause(err) != ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoRegistry, err)
+	}
+}
@@ -25,12 +25,23 @@ type RRSPFactory struct {
 // If more than a single tax region is specified, the underlying RRSP calculator
 // will use a tax aggregator
 func NewRRSPFactory(config RRSPFactoryConfig) *RRSPFactory {
+	return NewRRSPFactoryWithRegistry(history.DefaultRegistry(), config)
+}
+
+// NewRRSPFactoryWithRegistry is like NewRRSPFactory, except that the RRSP and
+// tax params are looked up in the given registry instead of the default one
+func NewRRSPFactoryWithRegistry(registry *history.Registry, config RRSPFactoryConfig) *RRSPFactory {
 
 	calcFactory := &RRSPFactory{
-		taxFactory: NewTaxFactory(config.Year, config.TaxRegions...),
+		taxFactory: NewTaxFactoryWithRegistry(registry, config.Year, config.TaxRegions...),
+	}
+
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
 	}
 
-	foundParams, err := history.GetRRSPParams(config.Year, config.RRSPRegion)
+	foundParams, err := registry.RRSPParams(config.Year, config.RRSPRegion)
 	if err != nil {
 		calcFactory.setFailingConstructor(errors.Wrap(err, "RRSP formula"))
 		return calcFactory
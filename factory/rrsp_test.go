@@ -78,3 +78,27 @@ func TestRRSPFactory_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRRSPFactoryWithRegistry(t *testing.T) {
+
+	config := RRSPFactoryConfig{
+		Year:       2018,
+		RRSPRegion: core.RegionCA,
+		TaxRegions: []core.Region{core.RegionCA, core.RegionBC},
+	}
+
+	_, err := NewRRSPFactoryWithRegistry(history.DefaultRegistry().Clone(), config).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewRRSPFactoryWithRegistry(history.NewRegistry(), config).NewCalculator()
+	if errors.Cause(err) != history.ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", history.ErrRegionNotExist, err)
+	}
+
+	_, err = NewRRSPFactoryWithRegistry(nil, config).NewCalculator()
+	if errors.Cause(err) != ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoRegistry, err)
+	}
+}
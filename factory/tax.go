@@ -19,12 +19,23 @@ type TaxFactory struct {
 // for all the given regions
 // TODO: make it mandatory to give one region
 func NewTaxFactory(year uint, regions ...core.Region) *TaxFactory {
+	return NewTaxFactoryWithRegistry(history.DefaultRegistry(), year, regions...)
+}
+
+// NewTaxFactoryWithRegistry is like NewTaxFactory, except that the tax params
+// are looked up in the given registry instead of the default one
+func NewTaxFactoryWithRegistry(registry *history.Registry, year uint, regions ...core.Region) *TaxFactory {
 
 	calcFactory := &TaxFactory{}
+	if registry == nil {
+		calcFactory.setFailingConstructor(ErrNoRegistry)
+		return calcFactory
+	}
+
 	allParams := make([]history.TaxParams, len(regions))
 	for i, region := range regions {
 
-		foundParams, err := history.GetTaxParams(year, region)
+		foundParams, err := registry.TaxParams(year, region)
 		if err != nil {
 			calcFactory.setFailingConstructor(
 				errors.Wrapf(err, "tax formula for region %q", region),
@@ -72,9 +83,11 @@ func (f *TaxFactory) initConstructor(allParams ...history.TaxParams) {
 				return nil, errors.Wrap(err, "error creating income calculator")
 			}
 			cfg := tax.CalcConfig{
-				IncomeCalc:       incomeCalc,
-				TaxFormula:       allParams[0].Formula,
-				ContraTaxFormula: allParams[0].ContraFormula,
+				IncomeCalc:        incomeCalc,
+				TaxFormula:        allParams[0].Formula,
+				ContraTaxFormula:  allParams[0].ContraFormula,
+				AdjustmentFormula: allParams[0].AdjustmentFormula,
+				Abatements:        allParams[0].Abatements,
 			}
 			return tax.NewCalculator(cfg)
 		}
@@ -89,9 +102,11 @@ func (f *TaxFactory) initConstructor(allParams ...history.TaxParams) {
 					return nil, errors.Wrap(err, "error creating income calculator")
 				}
 				cfg := tax.CalcConfig{
-					IncomeCalc:       incomeCalc,
-					TaxFormula:       p.Formula,
-					ContraTaxFormula: p.ContraFormula,
+					IncomeCalc:        incomeCalc,
+					TaxFormula:        p.Formula,
+					ContraTaxFormula:  p.ContraFormula,
+					AdjustmentFormula: p.AdjustmentFormula,
+					Abatements:        p.Abatements,
 				}
 				taxCalcs[i], err = tax.NewCalculator(cfg)
 				if err != nil {
@@ -2,6 +2,8 @@ package factory
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/malkhamis/quantax/core"
@@ -26,6 +28,49 @@ func TestNewTaxFactory_NewCalculator_SingleFormula(t *testing.T) {
 
 }
 
+func TestNewTaxFactoryWithRegistry(t *testing.T) {
+
+	rules := `
+version: 1
+tax:
+  - region: Canada
+    year: 2099
+    brackets: [{rate: 0.10, lower: 0}]
+    creditors:
+      - {kind: const, amount: 100, credit_source: personal-amount, credit_type: not-carry-forward}
+`
+	registry := history.DefaultRegistry().Clone()
+	err := registry.LoadRules(strings.NewReader(rules), history.FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewTaxFactoryWithRegistry(registry, 2099, core.RegionCA).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 10000},
+	)
+	c.SetFinances(finances, nil)
+
+	actual, _, _ := c.TaxPayable()
+	if actual != 900 {
+		t.Errorf("unexpected payable tax\nwant: %.2f\n got: %.2f", 900.0, actual)
+	}
+
+	_, err = NewTaxFactory(2099, core.RegionCA).NewCalculator()
+	if errors.Cause(err) != history.ErrParamsNotExist {
+		t.Errorf("expected the default registry to be unaffected\nwant: %v\n got: %v", history.ErrParamsNotExist, err)
+	}
+
+	_, err = NewTaxFactoryWithRegistry(nil, 2018, core.RegionCA).NewCalculator()
+	if errors.Cause(err) != ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrNoRegistry, err)
+	}
+}
+
 func TestTaxFactory_Uninitialized(t *testing.T) {
 
 	_, err := (&TaxFactory{}).NewCalculator()
@@ -67,6 +112,18 @@ func TestTaxFactory_Errors(t *testing.T) {
 			regions: []core.Region{core.RegionBC},
 			err:     nil,
 		},
+		{
+			name:    "valid-quebec",
+			year:    2019,
+			regions: []core.Region{core.RegionCA, core.RegionQC},
+			err:     nil,
+		},
+		{
+			name:    "valid-ontario",
+			year:    2022,
+			regions: []core.Region{core.RegionCA, core.RegionON},
+			err:     nil,
+		},
 	}
 
 	for i, c := range cases {
@@ -83,3 +140,33 @@ func TestTaxFactory_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestTaxFactory_ontarioSurtaxBeforeDividendCredits(t *testing.T) {
+
+	finances := NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned:              90000,
+			core.IncSrcEligibleDividendsCA: 20000,
+		},
+	)
+
+	calculator, err := NewTaxFactory(2024, core.RegionON).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(finances, nil)
+
+	// ON428 for 2024, where the taxable income is 90000 + 1.38 * 20000
+	taxOnIncome := 0.0505*51446 + 0.0915*(102894-51446) + 0.1116*(117600-102894)
+	nonRefundableCredits := 0.0505 * 12399
+	basicTax := taxOnIncome - nonRefundableCredits
+	surtax := 0.20*(basicTax-5554) + 0.36*(basicTax-7108)
+	dividendCredit := 0.10 * 1.38 * 20000
+	healthPremium := 750.0
+	expected := basicTax + surtax - dividendCredit + healthPremium
+
+	actual, _, _ := calculator.TaxPayable()
+	if math.Abs(actual-expected) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
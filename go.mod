@@ -5,4 +5,6 @@ require (
 	github.com/pkg/errors v0.8.1
 )
 
+require gopkg.in/yaml.v2 v2.4.0
+
 go 1.17
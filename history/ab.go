@@ -0,0 +1,270 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsAB = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaAB2025,
+		ContraFormula: taxContraFormulaAB2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaAB2024,
+		ContraFormula: taxContraFormulaAB2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaAB2023,
+		ContraFormula: taxContraFormulaAB2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaAB2022,
+		ContraFormula: taxContraFormulaAB2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaAB2021,
+		ContraFormula: taxContraFormulaAB2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaAB2020,
+		ContraFormula: taxContraFormulaAB2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaAB2019,
+		ContraFormula: taxContraFormulaAB2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaAB2018,
+		ContraFormula: taxContraFormulaAB2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaAB2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0800: core.Bracket{0, 60000},
+		0.1000: core.Bracket{60000, 151234},
+		0.1200: core.Bracket{151234, 181481},
+		0.1300: core.Bracket{181481, 241974},
+		0.1400: core.Bracket{241974, 362961},
+		0.1500: core.Bracket{362961, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaAB2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0800 * 22323, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 22323, Weight: 0.0800, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0800, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0800, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2024 */
+
+var taxFormulaAB2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 148269},
+		0.1200: core.Bracket{148269, 177922},
+		0.1300: core.Bracket{177922, 237230},
+		0.1400: core.Bracket{237230, 355845},
+		0.1500: core.Bracket{355845, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaAB2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 21885, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 21885, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2023 */
+
+var taxFormulaAB2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 142292},
+		0.1200: core.Bracket{142292, 170751},
+		0.1300: core.Bracket{170751, 227668},
+		0.1400: core.Bracket{227668, 341502},
+		0.1500: core.Bracket{341502, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaAB2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 21003, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 21003, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2022 */
+
+var taxFormulaAB2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 134238},
+		0.1200: core.Bracket{134238, 161086},
+		0.1300: core.Bracket{161086, 214781},
+		0.1400: core.Bracket{214781, 322171},
+		0.1500: core.Bracket{322171, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaAB2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 19814, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19814, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2021 */
+
+var taxFormulaAB2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 131220},
+		0.1200: core.Bracket{131220, 157464},
+		0.1300: core.Bracket{157464, 209952},
+		0.1400: core.Bracket{209952, 314928},
+		0.1500: core.Bracket{314928, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaAB2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 19369, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19369, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2020 */
+
+var taxFormulaAB2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 131220},
+		0.1200: core.Bracket{131220, 157464},
+		0.1300: core.Bracket{157464, 209952},
+		0.1400: core.Bracket{209952, 314928},
+		0.1500: core.Bracket{314928, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaAB2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 19369, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19369, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2019 */
+
+var taxFormulaAB2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 131220},
+		0.1200: core.Bracket{131220, 157464},
+		0.1300: core.Bracket{157464, 209952},
+		0.1400: core.Bracket{209952, 314928},
+		0.1500: core.Bracket{314928, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaAB2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 19369, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19369, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0218, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionAB,
+}
+
+/* 2018 */
+
+var taxFormulaAB2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1000: core.Bracket{0, 128145},
+		0.1200: core.Bracket{128145, 153773},
+		0.1300: core.Bracket{153773, 205031},
+		0.1400: core.Bracket{205031, 307547},
+		0.1500: core.Bracket{307547, math.Inf(1)},
+	},
+	TaxRegion: core.RegionAB,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaAB2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1000 * 18915, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 18915, Weight: 0.1000, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.1000, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1000, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0216, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionAB,
+}
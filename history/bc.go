@@ -11,11 +11,36 @@ import (
 
 var (
 	taxParamsBC = yearlyTaxParams{
+		2025: TaxParams{
+			Formula:       taxFormulaBC2025,
+			ContraFormula: taxContraFormulaBC2025,
+			IncomeRecipe:  incomeRecipeNetCA2025,
+		},
+		2024: TaxParams{
+			Formula:       taxFormulaBC2024,
+			ContraFormula: taxContraFormulaBC2024,
+			IncomeRecipe:  incomeRecipeNetCA2024,
+		},
+		2023: TaxParams{
+			Formula:       taxFormulaBC2023,
+			ContraFormula: taxContraFormulaBC2023,
+			IncomeRecipe:  incomeRecipeNetCA2023,
+		},
 		2022: TaxParams{
 			Formula:       taxFormulaBC2022,
 			ContraFormula: taxContraFormulaBC2022,
 			IncomeRecipe:  incomeRecipeNetCA2022,
 		},
+		2021: TaxParams{
+			Formula:       taxFormulaBC2021,
+			ContraFormula: taxContraFormulaBC2021,
+			IncomeRecipe:  incomeRecipeNetCA2021,
+		},
+		2020: TaxParams{
+			Formula:       taxFormulaBC2020,
+			ContraFormula: taxContraFormulaBC2020,
+			IncomeRecipe:  incomeRecipeNetCA2020,
+		},
 		2019: TaxParams{
 			Formula:       taxFormulaBC2019,
 			ContraFormula: taxContraFormulaBC2019,
@@ -29,10 +54,98 @@ var (
 	}
 
 	cbParamsBC = yearlyCBParams{
+		2025: CBParams{cbFormulaBC2025, incomeRecipeAFNICA2025},
+		2024: CBParams{cbFormulaBC2024, incomeRecipeAFNICA2024},
+		2023: CBParams{cbFormulaBC2023, incomeRecipeAFNICA2023},
+		2022: CBParams{cbFormulaBC2022, incomeRecipeAFNICA2022},
+		2021: CBParams{cbFormulaBC2021, incomeRecipeAFNICA2021},
+		2020: CBParams{cbFormulaBC2020, incomeRecipeAFNICA2020},
+		2019: CBParams{cbFormulaBC2018, incomeRecipeAFNICA2019},
 		2018: CBParams{cbFormulaBC2018, incomeRecipeAFNICA2018},
 	}
 )
 
+var taxFormulaBC2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0506: core.Bracket{0, 49279},
+		0.0770: core.Bracket{49279, 98560},
+		0.1050: core.Bracket{98560, 113158},
+		0.1229: core.Bracket{113158, 137407},
+		0.1470: core.Bracket{137407, 186306},
+		0.1680: core.Bracket{186306, 259829},
+		0.2050: core.Bracket{259829, math.Inf(1)},
+	},
+	TaxRegion: core.RegionBC,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaBC2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0506 * 12932, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11073, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionBC,
+}
+
+var taxFormulaBC2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0506: core.Bracket{0, 47937},
+		0.0770: core.Bracket{47937, 95875},
+		0.1050: core.Bracket{95875, 110076},
+		0.1229: core.Bracket{110076, 133664},
+		0.1470: core.Bracket{133664, 181232},
+		0.1680: core.Bracket{181232, 252752},
+		0.2050: core.Bracket{252752, math.Inf(1)},
+	},
+	TaxRegion: core.RegionBC,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaBC2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0506 * 12580, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10772, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionBC,
+}
+
+var taxFormulaBC2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0506: core.Bracket{0, 45654},
+		0.0770: core.Bracket{45654, 91310},
+		0.1050: core.Bracket{91310, 104835},
+		0.1229: core.Bracket{104835, 127299},
+		0.1470: core.Bracket{127299, 172602},
+		0.1680: core.Bracket{172602, 240716},
+		0.2050: core.Bracket{240716, math.Inf(1)},
+	},
+	TaxRegion: core.RegionBC,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaBC2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0506 * 11981, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10259, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionBC,
+}
+
 var taxFormulaBC2022 = &tax.CanadianFormula{
 	WeightedBrackets: core.WeightedBrackets{
 		0.0506: core.Bracket{0, 43070},
@@ -51,11 +164,69 @@ var taxContraFormulaBC2022 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
 		tax.ConstCreditor{Amount: 0.0506 * 11302, CreditDescriptor: crDescPersonalAmount},
 		tax.CanadianSpouseCreditor{BaseAmount: 11302, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
 	},
 	TaxYear:   2022,
 	TaxRegion: core.RegionBC,
 }
 
+var taxFormulaBC2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0506: core.Bracket{0, 42184},
+		0.0770: core.Bracket{42184, 84369},
+		0.1050: core.Bracket{84369, 96866},
+		0.1229: core.Bracket{96866, 117623},
+		0.1470: core.Bracket{117623, 159483},
+		0.1680: core.Bracket{159483, 222420},
+		0.2050: core.Bracket{222420, math.Inf(1)},
+	},
+	TaxRegion: core.RegionBC,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaBC2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0506 * 11070, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9479, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionBC,
+}
+
+var taxFormulaBC2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0506: core.Bracket{0, 41725},
+		0.0770: core.Bracket{41725, 83451},
+		0.1050: core.Bracket{83451, 95812},
+		0.1229: core.Bracket{95812, 116344},
+		0.1470: core.Bracket{116344, 157748},
+		0.1680: core.Bracket{157748, 220000},
+		0.2050: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionBC,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaBC2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0506 * 10949, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9375, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionBC,
+}
+
 var taxFormulaBC2019 = &tax.CanadianFormula{
 	WeightedBrackets: core.WeightedBrackets{
 		0.0506: core.Bracket{0, 40707},
@@ -73,6 +244,8 @@ var taxContraFormulaBC2019 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
 		tax.ConstCreditor{Amount: 0.0506 * 10682, CreditDescriptor: crDescPersonalAmount},
 		tax.CanadianSpouseCreditor{BaseAmount: 9147, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
 		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescTuitionAmount},
 		tax.WeightedCreditor{Weight: 1.38 * 0.12, CreditDescriptor: crDescCanadianEligibleDividends},
 		tax.WeightedCreditor{Weight: 1.15 * 0.0196, CreditDescriptor: crDescCanadianNonEligibleDividends},
@@ -98,6 +271,8 @@ var taxContraFormulaBC2018 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
 		tax.ConstCreditor{Amount: 0.0506 * 10412, CreditDescriptor: crDescPersonalAmount},
 		tax.CanadianSpouseCreditor{BaseAmount: 8915, Weight: 0.0506, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescEIPremiums},
 		tax.WeightedCreditor{Weight: 0.0506, CreditDescriptor: crDescTuitionAmount},
 		tax.WeightedCreditor{Weight: 1.38 * 0.10, CreditDescriptor: crDescCanadianEligibleDividends},
 		tax.WeightedCreditor{Weight: 1.16 * 0.0207, CreditDescriptor: crDescCanadianNonEligibleDividends},
@@ -117,3 +292,62 @@ var cbFormulaBC2018 = &benefits.BCECTBMaxReducer{
 		0.0132: core.Bracket{100000, math.Inf(1)},
 	},
 }
+
+// The BC Family Benefit replaced the BC Early Childhood Tax Benefit in October
+// 2020, which is the fourth month of the benefit year that starts in July 2020.
+// It was named the BC Child Opportunity Benefit until the benefit year that
+// starts in July 2023, when the single-parent supplement was introduced
+
+var cbFormulaBC2025 = &benefits.BCFamilyBenefitFormula{
+	MaxAmounts:             []float64{2188, 1375, 1125},
+	MinAmounts:             []float64{775, 750, 725},
+	MaxChildAgeMonths:      (monthsInYear * 18) - 1,
+	ReductionRate:          0.04,
+	LowerThreshold:         38458,
+	UpperThreshold:         123069,
+	SingleParentSupplement: 500,
+}
+
+var cbFormulaBC2024 = &benefits.BCFamilyBenefitFormula{
+	MaxAmounts:             []float64{2188, 1375, 1125},
+	MinAmounts:             []float64{775, 750, 725},
+	MaxChildAgeMonths:      (monthsInYear * 18) - 1,
+	ReductionRate:          0.04,
+	LowerThreshold:         37336,
+	UpperThreshold:         119475,
+	SingleParentSupplement: 500,
+}
+
+var cbFormulaBC2023 = &benefits.BCFamilyBenefitFormula{
+	MaxAmounts:             []float64{2188, 1375, 1125},
+	MinAmounts:             []float64{775, 750, 725},
+	MaxChildAgeMonths:      (monthsInYear * 18) - 1,
+	ReductionRate:          0.04,
+	LowerThreshold:         35902,
+	UpperThreshold:         114887,
+	SingleParentSupplement: 500,
+}
+
+var cbFormulaBC2022 = &benefits.BCFamilyBenefitFormula{
+	MaxAmounts:        []float64{1600, 1000, 800},
+	MinAmounts:        []float64{700, 680, 660},
+	MaxChildAgeMonths: (monthsInYear * 18) - 1,
+	ReductionRate:     0.04,
+	LowerThreshold:    25806,
+	UpperThreshold:    82578,
+}
+
+var cbFormulaBC2021 = &benefits.BCFamilyBenefitFormula{
+	MaxAmounts:        []float64{1600, 1000, 800},
+	MinAmounts:        []float64{700, 680, 660},
+	MaxChildAgeMonths: (monthsInYear * 18) - 1,
+	ReductionRate:     0.04,
+	LowerThreshold:    25000,
+	UpperThreshold:    80000,
+}
+
+var cbFormulaBC2020 = &benefits.ChildBenefitTransition{
+	Before:          cbFormulaBC2018,
+	After:           cbFormulaBC2021,
+	TransitionMonth: 3,
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/malkhamis/quantax/core"
 	"github.com/malkhamis/quantax/core/benefits"
 	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/payroll"
 	"github.com/malkhamis/quantax/core/rrsp"
 	"github.com/malkhamis/quantax/core/tax"
 )
@@ -55,154 +56,1855 @@ var (
 			Type:     core.CrRuleTypeNotCarryForward,
 		},
 	}
+
+	crDescCPPBaseContributions = tax.CreditDescriptor{
+		CreditDescription:     "credits for base CPP/QPP contributions through employment",
+		TargetFinancialSource: core.MiscSrcCPPBase,
+		CreditRule: core.CreditRule{
+			CrSource: "cpp-base-contributions",
+			Type:     core.CrRuleTypeNotCarryForward,
+		},
+	}
+
+	crDescEIPremiums = tax.CreditDescriptor{
+		CreditDescription:     "credits for employment insurance premiums",
+		TargetFinancialSource: core.MiscSrcEIPremiums,
+		CreditRule: core.CreditRule{
+			CrSource: "ei-premiums",
+			Type:     core.CrRuleTypeNotCarryForward,
+		},
+	}
+
+	crDescQPIPPremiums = tax.CreditDescriptor{
+		CreditDescription:     "credits for Quebec parental insurance plan premiums",
+		TargetFinancialSource: core.MiscSrcQPIPPremiums,
+		CreditRule: core.CreditRule{
+			CrSource: "qpip-premiums",
+			Type:     core.CrRuleTypeNotCarryForward,
+		},
+	}
 )
 
 var (
 	taxParamsCanada = yearlyTaxParams{
+		2025: TaxParams{
+			Formula:           taxFormulaCanada2025,
+			ContraFormula:     taxContraFormulaCanada2025,
+			AdjustmentFormula: taxAdjFormulaCanada2025,
+			IncomeRecipe:      incomeRecipeNetCA2025,
+		},
+		2024: TaxParams{
+			Formula:           taxFormulaCanada2024,
+			ContraFormula:     taxContraFormulaCanada2024,
+			AdjustmentFormula: taxAdjFormulaCanada2024,
+			IncomeRecipe:      incomeRecipeNetCA2024,
+		},
+		2023: TaxParams{
+			Formula:           taxFormulaCanada2023,
+			ContraFormula:     taxContraFormulaCanada2023,
+			AdjustmentFormula: taxAdjFormulaCanada2023,
+			IncomeRecipe:      incomeRecipeNetCA2023,
+		},
 		2022: TaxParams{
-			Formula:       taxFormulaCanada2022,
-			ContraFormula: taxContraFormulaCanada2022,
-			IncomeRecipe:  incomeRecipeNetCA2022,
+			Formula:           taxFormulaCanada2022,
+			ContraFormula:     taxContraFormulaCanada2022,
+			AdjustmentFormula: taxAdjFormulaCanada2022,
+			IncomeRecipe:      incomeRecipeNetCA2022,
+		},
+		2021: TaxParams{
+			Formula:           taxFormulaCanada2021,
+			ContraFormula:     taxContraFormulaCanada2021,
+			AdjustmentFormula: taxAdjFormulaCanada2021,
+			IncomeRecipe:      incomeRecipeNetCA2021,
+		},
+		2020: TaxParams{
+			Formula:           taxFormulaCanada2020,
+			ContraFormula:     taxContraFormulaCanada2020,
+			AdjustmentFormula: taxAdjFormulaCanada2020,
+			IncomeRecipe:      incomeRecipeNetCA2020,
 		},
 		2019: TaxParams{
-			Formula:       taxFormulaCanada2019,
-			ContraFormula: taxContraFormulaCanada2019,
-			IncomeRecipe:  incomeRecipeNetCA2019,
+			Formula:           taxFormulaCanada2019,
+			ContraFormula:     taxContraFormulaCanada2019,
+			AdjustmentFormula: taxAdjFormulaCanada2019,
+			IncomeRecipe:      incomeRecipeNetCA2019,
 		},
 		2018: TaxParams{
-			Formula:       taxFormulaCanada2018,
-			ContraFormula: taxContraFormulaCanada2018,
-			IncomeRecipe:  incomeRecipeNetCA2018,
+			Formula:           taxFormulaCanada2018,
+			ContraFormula:     taxContraFormulaCanada2018,
+			AdjustmentFormula: taxAdjFormulaCanada2018,
+			IncomeRecipe:      incomeRecipeNetCA2018,
 		},
 	}
 
 	cbParamsCanada = yearlyCBParams{
+		2025: CBParams{cbFormulaCanada2025, incomeRecipeAFNICA2025},
+		2024: CBParams{cbFormulaCanada2024, incomeRecipeAFNICA2024},
+		2023: CBParams{cbFormulaCanada2023, incomeRecipeAFNICA2023},
+		2022: CBParams{cbFormulaCanada2022, incomeRecipeAFNICA2022},
+		2021: CBParams{cbFormulaCanada2021, incomeRecipeAFNICA2021},
+		2020: CBParams{cbFormulaCanada2020, incomeRecipeAFNICA2020},
+		2019: CBParams{cbFormulaCanada2019, incomeRecipeAFNICA2019},
 		2018: CBParams{cbFormulaCanada2018, incomeRecipeAFNICA2018},
 	}
 
 	rrspParamsCanada = yearlyRRSPParams{
+		2025: RRSPParams{rrspFormulaCanada2025},
+		2024: RRSPParams{rrspFormulaCanada2024},
+		2023: RRSPParams{rrspFormulaCanada2023},
+		2022: RRSPParams{rrspFormulaCanada2022},
+		2021: RRSPParams{rrspFormulaCanada2021},
+		2020: RRSPParams{rrspFormulaCanada2020},
 		2019: RRSPParams{rrspFormulaCanada2019},
 		2018: RRSPParams{rrspFormulaCanada2018},
 	}
+
+	payrollParamsCanada = yearlyPayrollParams{
+		2025: PayrollParams{[]payroll.Formula{cppFormula2025, eiFormulaCanada2025}},
+		2024: PayrollParams{[]payroll.Formula{cppFormula2024, eiFormulaCanada2024}},
+		2023: PayrollParams{[]payroll.Formula{cppFormula2023, eiFormulaCanada2023}},
+		2022: PayrollParams{[]payroll.Formula{cppFormula2022, eiFormulaCanada2022}},
+		2021: PayrollParams{[]payroll.Formula{cppFormula2021, eiFormulaCanada2021}},
+		2020: PayrollParams{[]payroll.Formula{cppFormula2020, eiFormulaCanada2020}},
+		2019: PayrollParams{[]payroll.Formula{cppFormula2019, eiFormulaCanada2019}},
+		2018: PayrollParams{[]payroll.Formula{cppFormula2018, eiFormulaCanada2018}},
+	}
+
+	oasParamsCanada = yearlyOASParams{
+		2025: OASParams{oasFormulaCanada2025, incomeRecipeNetCA2025},
+		2024: OASParams{oasFormulaCanada2024, incomeRecipeNetCA2024},
+		2023: OASParams{oasFormulaCanada2023, incomeRecipeNetCA2023},
+		2022: OASParams{oasFormulaCanada2022, incomeRecipeNetCA2022},
+		2021: OASParams{oasFormulaCanada2021, incomeRecipeNetCA2021},
+		2020: OASParams{oasFormulaCanada2020, incomeRecipeNetCA2020},
+		2019: OASParams{oasFormulaCanada2019, incomeRecipeNetCA2019},
+		2018: OASParams{oasFormulaCanada2018, incomeRecipeNetCA2018},
+	}
+
+	gisParamsCanada = yearlyGISParams{
+		2025: GISParams{gisFormulaCanada2025, incomeRecipeGISCA2025},
+		2024: GISParams{gisFormulaCanada2024, incomeRecipeGISCA2024},
+		2023: GISParams{gisFormulaCanada2023, incomeRecipeGISCA2023},
+		2022: GISParams{gisFormulaCanada2022, incomeRecipeGISCA2022},
+		2021: GISParams{gisFormulaCanada2021, incomeRecipeGISCA2021},
+		2020: GISParams{gisFormulaCanada2020, incomeRecipeGISCA2020},
+		2019: GISParams{gisFormulaCanada2019, incomeRecipeGISCA2019},
+		2018: GISParams{gisFormulaCanada2018, incomeRecipeGISCA2018},
+	}
+
+	gstParamsCanada = yearlyGSTParams{
+		2025: GSTCreditParams{gstCreditFormulaCanada2025, incomeRecipeAFNICA2025},
+		2024: GSTCreditParams{gstCreditFormulaCanada2024, incomeRecipeAFNICA2024},
+		2023: GSTCreditParams{gstCreditFormulaCanada2023, incomeRecipeAFNICA2023},
+		2022: GSTCreditParams{gstCreditFormulaCanada2022, incomeRecipeAFNICA2022},
+		2021: GSTCreditParams{gstCreditFormulaCanada2021, incomeRecipeAFNICA2021},
+		2020: GSTCreditParams{gstCreditFormulaCanada2020, incomeRecipeAFNICA2020},
+		2019: GSTCreditParams{gstCreditFormulaCanada2019, incomeRecipeAFNICA2019},
+		2018: GSTCreditParams{gstCreditFormulaCanada2018, incomeRecipeAFNICA2018},
+	}
+
+	cwbParamsCanada = yearlyCWBParams{
+		2025: CWBParams{cwbFormulaCanada2025, incomeRecipeAFNICA2025, incomeRecipeWorkingCA},
+		2024: CWBParams{cwbFormulaCanada2024, incomeRecipeAFNICA2024, incomeRecipeWorkingCA},
+		2023: CWBParams{cwbFormulaCanada2023, incomeRecipeAFNICA2023, incomeRecipeWorkingCA},
+		2022: CWBParams{cwbFormulaCanada2022, incomeRecipeAFNICA2022, incomeRecipeWorkingCA},
+		2021: CWBParams{cwbFormulaCanada2021, incomeRecipeAFNICA2021, incomeRecipeWorkingCA},
+		2020: CWBParams{cwbFormulaCanada2020, incomeRecipeAFNICA2020, incomeRecipeWorkingCA},
+		2019: CWBParams{cwbFormulaCanada2019, incomeRecipeAFNICA2019, incomeRecipeWorkingCA},
+	}
 )
 
-/* 2022 */
+/* 2025 */
 
-var taxFormulaCanada2022 = &tax.CanadianFormula{
+var taxFormulaCanada2025 = &tax.CanadianFormula{
 	WeightedBrackets: core.WeightedBrackets{
-		0.1500: core.Bracket{0, 50197},
-		0.2050: core.Bracket{50197, 100392},
-		0.2600: core.Bracket{100392, 155625},
-		0.2938: core.Bracket{155625, 221708},
-		0.3300: core.Bracket{221708, math.Inf(1)},
+		0.145: core.Bracket{0, 57375},
+		0.205: core.Bracket{57375, 114750},
+		0.260: core.Bracket{114750, 177882},
+		0.290: core.Bracket{177882, 253414},
+		0.330: core.Bracket{253414, math.Inf(1)},
 	},
 	TaxRegion: core.RegionCA,
-	TaxYear:   2022,
+	TaxYear:   2025,
 }
 
-var taxContraFormulaCanada2022 = &tax.CanadianContraFormula{
+var taxContraFormulaCanada2025 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
-		tax.ConstCreditor{Amount: 0.150 * 14398, CreditDescriptor: crDescPersonalAmount},
-		tax.CanadianSpouseCreditor{BaseAmount: 14398, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.ConstCreditor{Amount: 0.145 * 16129, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16129, Weight: 0.145, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.145, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.145, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.145, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.145, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
 	},
-	TaxYear:   2022,
+	TaxYear:   2025,
 	TaxRegion: core.RegionCA,
 }
-/* 2019 */
 
-var taxFormulaCanada2019 = &tax.CanadianFormula{
+var cbFormulaCanada2025 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 666.41},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 562.33},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{37487, 81222},
+			0.032: core.Bracket{81222, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{37487, 81222},
+			0.057: core.Bracket{81222, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{37487, 81222},
+			0.080: core.Bracket{81222, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{37487, 81222},
+			0.095: core.Bracket{81222, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      3411,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{81222, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{81222, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2025 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            32490,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* 2024 */
+
+var taxFormulaCanada2024 = &tax.CanadianFormula{
 	WeightedBrackets: core.WeightedBrackets{
-		0.150: core.Bracket{0, 47630},
-		0.205: core.Bracket{47630, 95259},
-		0.260: core.Bracket{95259, 147667},
-		0.290: core.Bracket{147667, 210371},
-		0.330: core.Bracket{210371, math.Inf(1)},
+		0.150: core.Bracket{0, 55867},
+		0.205: core.Bracket{55867, 111733},
+		0.260: core.Bracket{111733, 173205},
+		0.290: core.Bracket{173205, 246752},
+		0.330: core.Bracket{246752, math.Inf(1)},
 	},
 	TaxRegion: core.RegionCA,
-	TaxYear:   2019,
+	TaxYear:   2024,
 }
 
-var taxContraFormulaCanada2019 = &tax.CanadianContraFormula{
+var taxContraFormulaCanada2024 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
-		tax.ConstCreditor{Amount: 0.150 * 12069, CreditDescriptor: crDescPersonalAmount},
-		tax.CanadianSpouseCreditor{BaseAmount: 12069, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.ConstCreditor{Amount: 0.150 * 15705, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15705, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
 		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
 		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
 		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
 	},
-	TaxYear:   2019,
+	TaxYear:   2024,
 	TaxRegion: core.RegionCA,
 }
 
-/* 2018 */
+var cbFormulaCanada2024 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 648.91},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 547.50},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{36502, 79087},
+			0.032: core.Bracket{79087, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{36502, 79087},
+			0.057: core.Bracket{79087, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{36502, 79087},
+			0.080: core.Bracket{79087, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{36502, 79087},
+			0.095: core.Bracket{79087, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      3322,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{79087, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{79087, math.Inf(1)},
+		},
+	},
+}
 
-var taxFormulaCanada2018 = &tax.CanadianFormula{
+var rrspFormulaCanada2024 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            31560,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* 2023 */
+
+var taxFormulaCanada2023 = &tax.CanadianFormula{
 	WeightedBrackets: core.WeightedBrackets{
-		0.150: core.Bracket{0, 46605},
-		0.205: core.Bracket{46605, 93208},
-		0.260: core.Bracket{93208, 144489},
-		0.290: core.Bracket{144489, 205842},
-		0.330: core.Bracket{205842, math.Inf(1)},
+		0.150: core.Bracket{0, 53359},
+		0.205: core.Bracket{53359, 106717},
+		0.260: core.Bracket{106717, 165430},
+		0.290: core.Bracket{165430, 235675},
+		0.330: core.Bracket{235675, math.Inf(1)},
 	},
 	TaxRegion: core.RegionCA,
-	TaxYear:   2018,
+	TaxYear:   2023,
 }
 
-var taxContraFormulaCanada2018 = &tax.CanadianContraFormula{
+var taxContraFormulaCanada2023 = &tax.CanadianContraFormula{
 	OrderedCreditors: []tax.Creditor{
-		tax.ConstCreditor{Amount: 0.150 * 11809, CreditDescriptor: crDescPersonalAmount},
-		tax.CanadianSpouseCreditor{BaseAmount: 11809, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.ConstCreditor{Amount: 0.150 * 15000, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15000, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
 		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
 		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
-		tax.WeightedCreditor{Weight: 1.16 * 0.100313, CreditDescriptor: crDescCanadianNonEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
 	},
-	TaxYear:   2018,
+	TaxYear:   2023,
 	TaxRegion: core.RegionCA,
 }
 
-var cbFormulaCanada2018 = &benefits.CCBMaxReducer{
+var cbFormulaCanada2023 = &benefits.CCBMaxReducer{
 	BeneficiaryClasses: []benefits.AgeGroupBenefits{
 		benefits.AgeGroupBenefits{
 			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
-			AmountsPerMonth: core.Bracket{0, 541.33},
+			AmountsPerMonth: core.Bracket{0, 619.75},
 		},
 		benefits.AgeGroupBenefits{
 			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
-			AmountsPerMonth: core.Bracket{0, 456.75},
+			AmountsPerMonth: core.Bracket{0, 522.91},
 		},
 	},
 	Reducers: []core.WeightedBrackets{
 		core.WeightedBrackets{ // 1 child
-			0.070: core.Bracket{30450, 65976},
-			0.032: core.Bracket{65976, math.Inf(1)},
+			0.070: core.Bracket{34863, 75537},
+			0.032: core.Bracket{75537, math.Inf(1)},
 		},
 		core.WeightedBrackets{ // 2 children
-			0.135: core.Bracket{30450, 65976},
-			0.057: core.Bracket{65976, math.Inf(1)},
+			0.135: core.Bracket{34863, 75537},
+			0.057: core.Bracket{75537, math.Inf(1)},
 		},
 		core.WeightedBrackets{ // 3 children
-			0.190: core.Bracket{30450, 65976},
-			0.080: core.Bracket{65976, math.Inf(1)},
+			0.190: core.Bracket{34863, 75537},
+			0.080: core.Bracket{75537, math.Inf(1)},
 		},
 		core.WeightedBrackets{ // 4+ children
-			0.230: core.Bracket{30450, 65976},
-			0.095: core.Bracket{65976, math.Inf(1)},
+			0.230: core.Bracket{34863, 75537},
+			0.095: core.Bracket{75537, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      3173,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{75537, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{75537, math.Inf(1)},
 		},
 	},
 }
 
-var rrspFormulaCanada2019 = &rrsp.MaxCapper{
+var rrspFormulaCanada2023 = &rrsp.MaxCapper{
 	Rate:                           0.18,
-	Cap:                            26500,
+	Cap:                            30780,
 	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
 	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
 	DeductionSourceForContribution: core.DeducSrcRRSP,
 }
 
-var rrspFormulaCanada2018 = &rrsp.MaxCapper{
+/* 2022 */
+
+var taxFormulaCanada2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.150: core.Bracket{0, 50197},
+		0.205: core.Bracket{50197, 100392},
+		0.260: core.Bracket{100392, 155625},
+		0.290: core.Bracket{155625, 221708},
+		0.330: core.Bracket{221708, math.Inf(1)},
+	},
+	TaxRegion: core.RegionCA,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaCanada2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.150 * 14398, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 14398, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionCA,
+}
+
+var cbFormulaCanada2022 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 583.08},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 491.91},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{32797, 71060},
+			0.032: core.Bracket{71060, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{32797, 71060},
+			0.057: core.Bracket{71060, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{32797, 71060},
+			0.080: core.Bracket{71060, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{32797, 71060},
+			0.095: core.Bracket{71060, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      2985,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{71060, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{71060, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2022 = &rrsp.MaxCapper{
 	Rate:                           0.18,
-	Cap:                            26230.00,
+	Cap:                            29210,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* 2021 */
+
+var taxFormulaCanada2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.150: core.Bracket{0, 49020},
+		0.205: core.Bracket{49020, 98040},
+		0.260: core.Bracket{98040, 151978},
+		0.290: core.Bracket{151978, 216511},
+		0.330: core.Bracket{216511, math.Inf(1)},
+	},
+	TaxRegion: core.RegionCA,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaCanada2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.150 * 13808, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 13808, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionCA,
+}
+
+var cbFormulaCanada2021 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 569.41},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 480.41},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{32028, 69395},
+			0.032: core.Bracket{69395, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{32028, 69395},
+			0.057: core.Bracket{69395, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{32028, 69395},
+			0.080: core.Bracket{69395, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{32028, 69395},
+			0.095: core.Bracket{69395, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      2915,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{69395, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{69395, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2021 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            27830,
 	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
 	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
 	DeductionSourceForContribution: core.DeducSrcRRSP,
 }
+
+/* 2020 */
+
+var taxFormulaCanada2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.150: core.Bracket{0, 48535},
+		0.205: core.Bracket{48535, 97069},
+		0.260: core.Bracket{97069, 150473},
+		0.290: core.Bracket{150473, 214368},
+		0.330: core.Bracket{214368, math.Inf(1)},
+	},
+	TaxRegion: core.RegionCA,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaCanada2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.150 * 13229, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 13229, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionCA,
+}
+
+var cbFormulaCanada2020 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 563.75},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 475.66},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{31711, 68708},
+			0.032: core.Bracket{68708, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{31711, 68708},
+			0.057: core.Bracket{68708, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{31711, 68708},
+			0.080: core.Bracket{68708, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{31711, 68708},
+			0.095: core.Bracket{68708, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      2886,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{68708, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{68708, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2020 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            27230,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* 2019 */
+
+var taxFormulaCanada2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.150: core.Bracket{0, 47630},
+		0.205: core.Bracket{47630, 95259},
+		0.260: core.Bracket{95259, 147667},
+		0.290: core.Bracket{147667, 210371},
+		0.330: core.Bracket{210371, math.Inf(1)},
+	},
+	TaxRegion: core.RegionCA,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaCanada2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.150 * 12069, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 12069, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.090301, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionCA,
+}
+
+var cbFormulaCanada2019 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 553.25},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 466.83},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{31120, 67426},
+			0.032: core.Bracket{67426, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{31120, 67426},
+			0.057: core.Bracket{67426, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{31120, 67426},
+			0.080: core.Bracket{67426, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{31120, 67426},
+			0.095: core.Bracket{67426, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      2832,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{67426, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{67426, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2019 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            26500,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* 2018 */
+
+var taxFormulaCanada2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.150: core.Bracket{0, 46605},
+		0.205: core.Bracket{46605, 93208},
+		0.260: core.Bracket{93208, 144489},
+		0.290: core.Bracket{144489, 205842},
+		0.330: core.Bracket{205842, math.Inf(1)},
+	},
+	TaxRegion: core.RegionCA,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaCanada2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.150 * 11809, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11809, Weight: 0.150, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescQPIPPremiums},
+		tax.WeightedCreditor{Weight: 0.150, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.150198, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.100313, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionCA,
+}
+
+var cbFormulaCanada2018 = &benefits.CCBMaxReducer{
+	BeneficiaryClasses: []benefits.AgeGroupBenefits{
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{0, (monthsInYear * 6) - 1},
+			AmountsPerMonth: core.Bracket{0, 541.33},
+		},
+		benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{monthsInYear * 6, monthsInYear * 17},
+			AmountsPerMonth: core.Bracket{0, 456.75},
+		},
+	},
+	Reducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.070: core.Bracket{30450, 65976},
+			0.032: core.Bracket{65976, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2 children
+			0.135: core.Bracket{30450, 65976},
+			0.057: core.Bracket{65976, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 3 children
+			0.190: core.Bracket{30450, 65976},
+			0.080: core.Bracket{65976, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 4+ children
+			0.230: core.Bracket{30450, 65976},
+			0.095: core.Bracket{65976, math.Inf(1)},
+		},
+	},
+	SmallPaymentThreshold: 240,
+	DisabilityAmount:      2771,
+	DisabilityReducers: []core.WeightedBrackets{
+		core.WeightedBrackets{ // 1 child
+			0.032: core.Bracket{65976, math.Inf(1)},
+		},
+		core.WeightedBrackets{ // 2+ children
+			0.057: core.Bracket{65976, math.Inf(1)},
+		},
+	},
+}
+
+var rrspFormulaCanada2018 = &rrsp.MaxCapper{
+	Rate:                           0.18,
+	Cap:                            26230.00,
+	IncomeSources:                  []core.FinancialSource{core.IncSrcEarned},
+	IncomeSourceForWithdrawal:      core.IncSrcRRSP,
+	DeductionSourceForContribution: core.DeducSrcRRSP,
+}
+
+/* payroll */
+
+var cppFormula2025 = &payroll.PensionPlanFormula{
+	BasicExemption:                   3500,
+	MaxPensionableEarnings:           71300,
+	AdditionalMaxPensionableEarnings: 81200,
+	BaseRate:                         0.0495,
+	FirstAdditionalRate:              0.0100,
+	SecondAdditionalRate:             0.0400,
+	IncomeSources:                    []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:           core.MiscSrcCPPBase,
+	AdditionalContributionSource:     core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2025 = &payroll.InsuranceFormula{
+	Rate:                 0.0164,
+	MaxInsurableEarnings: 65700,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2024 = &payroll.PensionPlanFormula{
+	BasicExemption:                   3500,
+	MaxPensionableEarnings:           68500,
+	AdditionalMaxPensionableEarnings: 73200,
+	BaseRate:                         0.0495,
+	FirstAdditionalRate:              0.0100,
+	SecondAdditionalRate:             0.0400,
+	IncomeSources:                    []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:           core.MiscSrcCPPBase,
+	AdditionalContributionSource:     core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2024 = &payroll.InsuranceFormula{
+	Rate:                 0.0166,
+	MaxInsurableEarnings: 63200,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2023 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       66600,
+	BaseRate:                     0.0495,
+	FirstAdditionalRate:          0.0100,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2023 = &payroll.InsuranceFormula{
+	Rate:                 0.0163,
+	MaxInsurableEarnings: 61500,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2022 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       64900,
+	BaseRate:                     0.0495,
+	FirstAdditionalRate:          0.0075,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2022 = &payroll.InsuranceFormula{
+	Rate:                 0.0158,
+	MaxInsurableEarnings: 60300,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2021 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       61600,
+	BaseRate:                     0.0495,
+	FirstAdditionalRate:          0.0050,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2021 = &payroll.InsuranceFormula{
+	Rate:                 0.0158,
+	MaxInsurableEarnings: 56300,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2020 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       58700,
+	BaseRate:                     0.0495,
+	FirstAdditionalRate:          0.0030,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2020 = &payroll.InsuranceFormula{
+	Rate:                 0.0158,
+	MaxInsurableEarnings: 54200,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2019 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       57400,
+	BaseRate:                     0.0495,
+	FirstAdditionalRate:          0.0015,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2019 = &payroll.InsuranceFormula{
+	Rate:                 0.0162,
+	MaxInsurableEarnings: 53100,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var cppFormula2018 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       55900,
+	BaseRate:                     0.0495,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaCanada2018 = &payroll.InsuranceFormula{
+	Rate:                 0.0166,
+	MaxInsurableEarnings: 51700,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+/* old age security */
+
+var oasFormulaCanada2025 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (727.67 + 727.67 + 734.95 + 740.09) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (800.44 + 800.44 + 808.45 + 814.10) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    93454,
+}
+
+var taxAdjFormulaCanada2025 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2025.RecoveryAdjuster(),
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2024 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (713.34 + 718.33 + 718.33 + 727.67) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (784.67 + 790.16 + 790.16 + 800.44) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    90997,
+}
+
+var taxAdjFormulaCanada2024 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2024.RecoveryAdjuster(),
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2023 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (687.56 + 691.00 + 698.60 + 707.68) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (756.32 + 760.10 + 768.46 + 778.45) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    86912,
+}
+
+var taxAdjFormulaCanada2023 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2023.RecoveryAdjuster(),
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2022 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (642.25 + 648.67 + 666.83 + 687.56) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (642.25 + 648.67 + 733.51 + 756.32) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    81761,
+}
+
+var taxAdjFormulaCanada2022 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2022.RecoveryAdjuster(),
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2021 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (615.37 + 618.45 + 626.49 + 635.26) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (615.37 + 618.45 + 626.49 + 635.26) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    79845,
+}
+
+var taxAdjFormulaCanada2021 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2021.RecoveryAdjuster(),
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2020 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (613.53 + 613.53 + 614.14 + 614.14) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (613.53 + 613.53 + 614.14 + 614.14) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    79054,
+}
+
+var taxAdjFormulaCanada2020 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2020.RecoveryAdjuster(),
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2019 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (601.45 + 601.45 + 607.46 + 611.72) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (601.45 + 601.45 + 607.46 + 611.72) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    77580,
+}
+
+var taxAdjFormulaCanada2019 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2019.RecoveryAdjuster(),
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionCA,
+}
+
+var oasFormulaCanada2018 = &benefits.OASFormula{
+	AgeGroups: []benefits.OASAgeGroup{
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 65, (monthsInYear * 75) - 1},
+			AmountPerMonth: (586.66 + 589.59 + 590.77 + 596.67) / 4,
+		},
+		{
+			AgesMonths:     human.AgeRange{monthsInYear * 75, math.MaxUint32},
+			AmountPerMonth: (586.66 + 589.59 + 590.77 + 596.67) / 4,
+		},
+	},
+	EligibilityAgeMonths: monthsInYear * 65,
+	DeferralRate:         0.006,
+	MaxDeferralMonths:    60,
+	FullResidenceYears:   40,
+	MinResidenceYears:    10,
+	RecoveryRate:         0.15,
+	RecoveryThreshold:    75910,
+}
+
+var taxAdjFormulaCanada2018 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		oasFormulaCanada2018.RecoveryAdjuster(),
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionCA,
+}
+
+/* guaranteed income supplement */
+
+// The reducers of each class of recipients are the reduction of the basic
+// supplement and the reduction of the annual top-up, which is the amount that
+// is multiplied by the inverse of its reduction rate. The OAS-equivalent
+// portion of the Allowance is reduced first at 75% of the combined income
+
+var gisFormulaCanada2025 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1086.88, 1086.88, 1097.75, 1105.43},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 2014.56)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{654.23, 654.23, 660.78, 665.41},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 566.76)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{654.23, 654.23, 660.78, 665.41},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8732.04 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 566.76)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1086.88, 1086.88, 1097.75, 1105.43},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8732.04, math.Inf(1)},
+			0.125: core.Bracket{8732.04 + 4000, 8732.04 + 4000 + (8 * 2014.56)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			727.67 + 654.23,
+			727.67 + 654.23,
+			734.95 + 660.78,
+			740.09 + 665.41,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 8732.04 * 4 / 3},
+			0.250: core.Bracket{8732.04 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 566.76)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2024 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1065.47, 1072.93, 1072.93, 1086.88},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1974.88)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{641.35, 645.84, 645.84, 654.23},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 555.60)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{641.35, 645.84, 645.84, 654.23},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8560.08 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 555.60)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1065.47, 1072.93, 1072.93, 1086.88},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8560.08, math.Inf(1)},
+			0.125: core.Bracket{8560.08 + 4000, 8560.08 + 4000 + (8 * 1974.88)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			713.34 + 641.35,
+			718.33 + 645.84,
+			718.33 + 645.84,
+			727.67 + 654.23,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 8560.08 * 4 / 3},
+			0.250: core.Bracket{8560.08 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 555.60)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2023 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1026.96, 1032.10, 1043.45, 1057.01},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1903.50)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{618.38, 621.47, 628.31, 636.47},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 535.70)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{618.38, 621.47, 628.31, 636.47},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8250.72 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 535.70)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{1026.96, 1032.10, 1043.45, 1057.01},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{8250.72, math.Inf(1)},
+			0.125: core.Bracket{8250.72 + 4000, 8250.72 + 4000 + (8 * 1903.50)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			687.56 + 618.38,
+			691.00 + 621.47,
+			698.60 + 628.31,
+			707.68 + 636.47,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 8250.72 * 4 / 3},
+			0.250: core.Bracket{8250.72 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 535.70)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2022 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{959.26, 968.86, 995.99, 1026.96},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1778.01)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{577.60, 583.38, 599.71, 618.38},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 500.38)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{577.60, 583.38, 599.71, 618.38},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7707.00 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 500.38)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{959.26, 968.86, 995.99, 1026.96},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7707.00, math.Inf(1)},
+			0.125: core.Bracket{7707.00 + 4000, 7707.00 + 4000 + (8 * 1778.01)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			642.25 + 577.60,
+			648.67 + 583.38,
+			666.83 + 599.71,
+			687.56 + 618.38,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 7707.00 * 4 / 3},
+			0.250: core.Bracket{7707.00 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 500.38)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2021 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{919.12, 923.72, 935.72, 948.82},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1703.61)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{553.43, 556.20, 563.43, 571.32},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 479.44)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{553.43, 556.20, 563.43, 571.32},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7384.44 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 479.44)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{919.12, 923.72, 935.72, 948.82},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7384.44, math.Inf(1)},
+			0.125: core.Bracket{7384.44 + 4000, 7384.44 + 4000 + (8 * 1703.61)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			615.37 + 553.43,
+			618.45 + 556.20,
+			626.49 + 563.43,
+			635.26 + 571.32,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 7384.44 * 4 / 3},
+			0.250: core.Bracket{7384.44 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 479.44)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2020 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{916.38, 916.38, 917.29, 917.29},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1698.53)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{551.78, 551.78, 552.33, 552.33},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 478.01)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{551.78, 551.78, 552.33, 552.33},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7362.36 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 478.01)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{916.38, 916.38, 917.29, 917.29},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7362.36, math.Inf(1)},
+			0.125: core.Bracket{7362.36 + 4000, 7362.36 + 4000 + (8 * 1698.53)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			613.53 + 551.78,
+			613.53 + 551.78,
+			614.14 + 552.33,
+			614.14 + 552.33,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 7362.36 * 4 / 3},
+			0.250: core.Bracket{7362.36 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 478.01)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2019 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{898.32, 898.32, 907.30, 913.67},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1665.06)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{540.90, 540.90, 546.31, 550.15},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 468.58)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{540.90, 540.90, 546.31, 550.15},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7217.40 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 468.58)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{898.32, 898.32, 907.30, 913.67},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7217.40, math.Inf(1)},
+			0.125: core.Bracket{7217.40 + 4000, 7217.40 + 4000 + (8 * 1665.06)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			601.45 + 540.90,
+			601.45 + 540.90,
+			607.46 + 546.31,
+			611.72 + 550.15,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 7217.40 * 4 / 3},
+			0.250: core.Bracket{7217.40 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 468.58)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+var gisFormulaCanada2018 = &benefits.GISFormula{
+	Single: benefits.GISRates{
+		AmountsPerMonth: [4]float64{872.20, 876.53, 878.29, 887.05},
+		Reducers: core.WeightedBrackets{
+			0.500: core.Bracket{0, math.Inf(1)},
+			0.250: core.Bracket{2000, 2000 + (4 * 1616.65)},
+		},
+	},
+	SpouseOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{525.17, 527.78, 528.84, 534.11},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{0, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 454.96)},
+		},
+	},
+	SpouseAllowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{525.17, 527.78, 528.84, 534.11},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7039.92 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 454.96)},
+		},
+	},
+	SpouseNoOAS: benefits.GISRates{
+		AmountsPerMonth: [4]float64{872.20, 876.53, 878.29, 887.05},
+		Reducers: core.WeightedBrackets{
+			0.250: core.Bracket{7039.92, math.Inf(1)},
+			0.125: core.Bracket{7039.92 + 4000, 7039.92 + 4000 + (8 * 1616.65)},
+		},
+	},
+	Allowance: benefits.GISRates{
+		AmountsPerMonth: [4]float64{
+			586.66 + 525.17,
+			589.59 + 527.78,
+			590.77 + 528.84,
+			596.67 + 534.11,
+		},
+		Reducers: core.WeightedBrackets{
+			0.750: core.Bracket{0, 7039.92 * 4 / 3},
+			0.250: core.Bracket{7039.92 * 4 / 3, math.Inf(1)},
+			0.125: core.Bracket{4000, 4000 + (8 * 454.96)},
+		},
+	},
+	OASAgeMonths:        monthsInYear * 65,
+	AllowanceAgesMonths: human.AgeRange{monthsInYear * 60, (monthsInYear * 65) - 1},
+}
+
+/* GST/HST credit */
+
+// The params of a year are for the benefit year that starts in July of that
+// year, which is based on the income of the previous year
+
+var gstCreditFormulaCanada2025 = &benefits.GSTCreditFormula{
+	AdultAmount:           349,
+	ChildAmount:           184,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      184,
+	SupplementRate:        0.02,
+	SupplementThreshold:   11337,
+	ReductionRate:         0.05,
+	ReductionThreshold:    45521,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2024 = &benefits.GSTCreditFormula{
+	AdultAmount:           340,
+	ChildAmount:           179,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      179,
+	SupplementRate:        0.02,
+	SupplementThreshold:   11039,
+	ReductionRate:         0.05,
+	ReductionThreshold:    44324,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2023 = &benefits.GSTCreditFormula{
+	AdultAmount:           325,
+	ChildAmount:           171,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      171,
+	SupplementRate:        0.02,
+	SupplementThreshold:   10544,
+	ReductionRate:         0.05,
+	ReductionThreshold:    42335,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2022 = &benefits.GSTCreditFormula{
+	AdultAmount:           306,
+	ChildAmount:           161,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      161,
+	SupplementRate:        0.02,
+	SupplementThreshold:   9919,
+	ReductionRate:         0.05,
+	ReductionThreshold:    39826,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2021 = &benefits.GSTCreditFormula{
+	AdultAmount:           299,
+	ChildAmount:           157,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      157,
+	SupplementRate:        0.02,
+	SupplementThreshold:   9687,
+	ReductionRate:         0.05,
+	ReductionThreshold:    38892,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2020 = &benefits.GSTCreditFormula{
+	AdultAmount:           296,
+	ChildAmount:           155,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      155,
+	SupplementRate:        0.02,
+	SupplementThreshold:   9591,
+	ReductionRate:         0.05,
+	ReductionThreshold:    38507,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2019 = &benefits.GSTCreditFormula{
+	AdultAmount:           290,
+	ChildAmount:           153,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      153,
+	SupplementRate:        0.02,
+	SupplementThreshold:   9412,
+	ReductionRate:         0.05,
+	ReductionThreshold:    37789,
+	SmallPaymentThreshold: 200,
+}
+
+var gstCreditFormulaCanada2018 = &benefits.GSTCreditFormula{
+	AdultAmount:           284,
+	ChildAmount:           149,
+	MaxChildAgeMonths:     (monthsInYear * 19) - 1,
+	SingleSupplement:      149,
+	SupplementRate:        0.02,
+	SupplementThreshold:   9208,
+	ReductionRate:         0.05,
+	ReductionThreshold:    36976,
+	SmallPaymentThreshold: 200,
+}
+
+/* Canada workers benefit */
+
+// The Canada workers benefit replaced the working income tax benefit in 2019.
+// The disability supplement starts phasing out where the basic amount is fully
+// phased out
+
+var cwbFormulaCanada2025 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1633,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 26855,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2813,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 30639,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         843,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 37742,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         843,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 49392,
+	},
+	SecondaryEarnerExemption: 14000,
+}
+
+var cwbFormulaCanada2024 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1590,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 26149,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2739,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 29833,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         821,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 36749,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         821,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 48093,
+	},
+	SecondaryEarnerExemption: 14000,
+}
+
+var cwbFormulaCanada2023 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1518,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 24975,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2616,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 28494,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         784,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 35095,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         784,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 45934,
+	},
+	SecondaryEarnerExemption: 14000,
+}
+
+var cwbFormulaCanada2022 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1428,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 23495,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2461,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 26805,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         737,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 33015,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         737,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 43212,
+	},
+	SecondaryEarnerExemption: 14000,
+}
+
+var cwbFormulaCanada2021 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1395,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 22944,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2403,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 26177,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         720,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 32244,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         720,
+		PhaseInRate:       0.27,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.15,
+		PhaseOutThreshold: 42197,
+	},
+	SecondaryEarnerExemption: 14000,
+}
+
+var cwbFormulaCanada2020 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1381,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 13064,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2379,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 17348,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         713,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 24573,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         713,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 37173,
+	},
+}
+
+var cwbFormulaCanada2019 = &benefits.CWBFormula{
+	Single: benefits.CWBPhases{
+		MaxAmount:         1355,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 12820,
+	},
+	Family: benefits.CWBPhases{
+		MaxAmount:         2335,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  3000,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 17025,
+	},
+	DisabilitySingle: benefits.CWBPhases{
+		MaxAmount:         700,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 24111,
+	},
+	DisabilityFamily: benefits.CWBPhases{
+		MaxAmount:         700,
+		PhaseInRate:       0.26,
+		PhaseInThreshold:  1150,
+		PhaseOutRate:      0.12,
+		PhaseOutThreshold: 36483,
+	},
+}
@@ -0,0 +1,86 @@
+package history
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+// discontinuedCredits maps the credit sources that a jurisdiction eliminated to
+// the first tax year they are no longer available in
+var discontinuedCredits = map[core.Region]map[string]uint{
+	core.RegionBC: {crDescTuitionAmount.CreditRule.CrSource: 2020},
+	core.RegionAB: {crDescTuitionAmount.CreditRule.CrSource: 2020},
+}
+
+func TestTaxParams_NoMissingYears(t *testing.T) {
+
+	for region, yearlyParams := range taxParamsAll {
+
+		years := sortedTaxYears(yearlyParams)
+		for i := 1; i < len(years); i++ {
+			if years[i] != years[i-1]+1 {
+				t.Errorf(
+					"%s: tax params are missing for the years between %d and %d",
+					region, years[i-1], years[i],
+				)
+			}
+		}
+	}
+}
+
+func TestTaxParams_NoMissingCreditors(t *testing.T) {
+
+	for region, yearlyParams := range taxParamsAll {
+
+		// maps credit sources to the first year they were seen
+		seen := make(map[string]uint)
+
+		for _, year := range sortedTaxYears(yearlyParams) {
+
+			contraFormula, ok := yearlyParams[year].ContraFormula.(*tax.CanadianContraFormula)
+			if !ok {
+				t.Errorf("%s-%d: unexpected contra formula type", region, year)
+				continue
+			}
+
+			current := make(map[string]bool)
+			for _, creditor := range contraFormula.OrderedCreditors {
+				current[creditor.Rule().CrSource] = true
+			}
+
+			for crSource, since := range seen {
+				if current[crSource] {
+					continue
+				}
+				discontinuedSince, ok := discontinuedCredits[region][crSource]
+				if ok && year >= discontinuedSince {
+					continue
+				}
+				t.Errorf(
+					"%s-%d: missing creditor for %q, which exists since %d",
+					region, year, crSource, since,
+				)
+			}
+
+			for crSource := range current {
+				if _, ok := seen[crSource]; !ok {
+					seen[crSource] = year
+				}
+			}
+		}
+	}
+}
+
+func sortedTaxYears(yearlyParams yearlyTaxParams) []uint {
+
+	years := make([]uint, 0, len(yearlyParams))
+	for year := range yearlyParams {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	return years
+}
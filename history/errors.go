@@ -4,9 +4,14 @@ import "errors"
 
 // Sentinel errors that can be wrapped and returned
 var (
-	ErrParamsNotExist = errors.New("no parameters exists")
-	ErrRegionNotExist = errors.New("unknown region")
+	ErrParamsNotExist     = errors.New("no parameters exists")
+	ErrRegionNotExist     = errors.New("unknown region")
+	ErrUnknownFormat      = errors.New("unknown rule file format")
+	ErrUnsupportedVersion = errors.New("unsupported rule file version")
+	ErrInvalidRule        = errors.New("invalid rule")
+	ErrInvalidParams      = errors.New("invalid params")
 
 	errNilFormula       = errors.New("nil formula encountered")
 	errNilContraFormula = errors.New("nil contra-formula encountered")
+	errNilIncomeRecipe  = errors.New("nil income recipe encountered")
 )
@@ -7,6 +7,18 @@ var (
 	taxParamsAll = map[core.Region]yearlyTaxParams{
 		core.RegionBC: taxParamsBC,
 		core.RegionCA: taxParamsCanada,
+		core.RegionON: taxParamsON,
+		core.RegionQC: taxParamsQC,
+		core.RegionAB: taxParamsAB,
+		core.RegionSK: taxParamsSK,
+		core.RegionMB: taxParamsMB,
+		core.RegionNB: taxParamsNB,
+		core.RegionNS: taxParamsNS,
+		core.RegionPE: taxParamsPE,
+		core.RegionNL: taxParamsNL,
+		core.RegionYT: taxParamsYT,
+		core.RegionNT: taxParamsNT,
+		core.RegionNU: taxParamsNU,
 	}
 
 	cbParamsAll = map[core.Region]yearlyCBParams{
@@ -17,53 +29,77 @@ var (
 	rrspParamsAll = map[core.Region]yearlyRRSPParams{
 		core.RegionCA: rrspParamsCanada,
 	}
-)
-
-// GetTaxParams returns a copy of the tax params for the given year and region
-func GetTaxParams(year uint, region core.Region) (TaxParams, error) {
 
-	jurisdictionParams, ok := taxParamsAll[region]
-	if !ok {
-		return TaxParams{}, ErrRegionNotExist
+	payrollParamsAll = map[core.Region]yearlyPayrollParams{
+		core.RegionCA: payrollParamsCanada,
+		core.RegionQC: payrollParamsQC,
 	}
 
-	params, ok := jurisdictionParams[year]
-	if !ok {
-		return TaxParams{}, ErrParamsNotExist
+	oasParamsAll = map[core.Region]yearlyOASParams{
+		core.RegionCA: oasParamsCanada,
 	}
 
-	return params.Clone(), nil
-}
-
-// GetChildBenefitParams returns a copy of the child benefit parameters for
-// the given year and region
-func GetChildBenefitParams(year uint, region core.Region) (CBParams, error) {
+	gisParamsAll = map[core.Region]yearlyGISParams{
+		core.RegionCA: gisParamsCanada,
+	}
 
-	jurisdictionParams, ok := cbParamsAll[region]
-	if !ok {
-		return CBParams{}, ErrRegionNotExist
+	gstParamsAll = map[core.Region]yearlyGSTParams{
+		core.RegionCA: gstParamsCanada,
 	}
 
-	params, ok := jurisdictionParams[year]
-	if !ok {
-		return CBParams{}, ErrParamsNotExist
+	cwbParamsAll = map[core.Region]yearlyCWBParams{
+		core.RegionCA: cwbParamsCanada,
 	}
+)
 
-	return params.Clone(), nil
+// GetTaxParams returns a copy of the tax params for the given year and region
+// from the default registry
+func GetTaxParams(year uint, region core.Region) (TaxParams, error) {
+	return defaultRegistry.TaxParams(year, region)
+}
+
+// GetChildBenefitParams returns a copy of the child benefit parameters for
+// the given year and region from the default registry
+func GetChildBenefitParams(year uint, region core.Region) (CBParams, error) {
+	return defaultRegistry.ChildBenefitParams(year, region)
 }
 
 // GetRRSOParams returns a copy of the RRSP parameters for the given year/region
+// from the default registry
 func GetRRSPParams(year uint, region core.Region) (RRSPParams, error) {
+	return defaultRegistry.RRSPParams(year, region)
+}
 
-	jurisdictionParams, ok := rrspParamsAll[region]
-	if !ok {
-		return RRSPParams{}, ErrRegionNotExist
-	}
+// GetPayrollParams returns a copy of the payroll parameters for the given year
+// and region from the default registry. Since Quebec administers its own
+// pension and parental insurance plans, the parameters of employees working in
+// Quebec are under RegionQC while the parameters for all other employees are
+// under RegionCA
+func GetPayrollParams(year uint, region core.Region) (PayrollParams, error) {
+	return defaultRegistry.PayrollParams(year, region)
+}
 
-	params, ok := jurisdictionParams[year]
-	if !ok {
-		return RRSPParams{}, ErrParamsNotExist
-	}
+// GetOASParams returns a copy of the Old Age Security parameters for the given
+// year and region from the default registry
+func GetOASParams(year uint, region core.Region) (OASParams, error) {
+	return defaultRegistry.OASParams(year, region)
+}
+
+// GetGISParams returns a copy of the Guaranteed Income Supplement parameters
+// for the given year and region from the default registry
+func GetGISParams(year uint, region core.Region) (GISParams, error) {
+	return defaultRegistry.GISParams(year, region)
+}
+
+// GetGSTCreditParams returns a copy of the GST/HST credit parameters for the
+// given year and region from the default registry. The parameters of a year
+// are for the benefit year that starts in July of that year
+func GetGSTCreditParams(year uint, region core.Region) (GSTCreditParams, error) {
+	return defaultRegistry.GSTCreditParams(year, region)
+}
 
-	return params.Clone(), nil
+// GetCWBParams returns a copy of the Canada Workers Benefit parameters for the
+// given year and region from the default registry
+func GetCWBParams(year uint, region core.Region) (CWBParams, error) {
+	return defaultRegistry.CWBParams(year, region)
 }
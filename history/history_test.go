@@ -1,9 +1,15 @@
 package history
 
 import (
+	"math"
 	"testing"
 
 	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/finance"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/tax"
 	"github.com/pkg/errors"
 )
 
@@ -67,6 +73,34 @@ func TestGetChildBenefitParams(t *testing.T) {
 	}
 }
 
+func TestGetChildBenefitParams_BCFamilyBenefit(t *testing.T) {
+
+	child := &human.Person{AgeMonths: 5 * 12}
+
+	// the BC Early Childhood Tax Benefit applies to the first three months of
+	// the benefit year, and the BC Family Benefit to the remaining nine
+	params, err := GetChildBenefitParams(2020, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := (55.0*12*3 + 1600*9) / 12
+	if actual := params.Formula.Apply(20000, child); math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	params, err = GetChildBenefitParams(2023, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formula, ok := params.Formula.(benefits.SingleParentFormula)
+	if !ok {
+		t.Fatalf("expected a formula with a single-parent supplement, got: %T", params.Formula)
+	}
+	if actual := formula.ApplySingleParent(30000, child); actual != 2188+500 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", 2188.0+500.0, actual)
+	}
+}
+
 func TestGetChildBenefitParams_Errors(t *testing.T) {
 
 	_, err := GetChildBenefitParams(2018, core.Region("OhCanada"))
@@ -81,6 +115,238 @@ func TestGetChildBenefitParams_Errors(t *testing.T) {
 
 }
 
+func TestGetPayrollParams(t *testing.T) {
+
+	params, err := GetPayrollParams(2024, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(params.Formulas) != 2 {
+		t.Fatalf("expected CPP and EI formulas, got %d formulas", len(params.Formulas))
+	}
+
+	params, err = GetPayrollParams(2024, core.RegionQC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(params.Formulas) != 3 {
+		t.Fatalf("expected QPP, EI, and QPIP formulas, got %d formulas", len(params.Formulas))
+	}
+}
+
+func TestGetPayrollParams_Errors(t *testing.T) {
+
+	_, err := GetPayrollParams(2018, core.Region("OhCanada"))
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = GetPayrollParams(2108, core.RegionCA)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+
+}
+
+func TestPayrollParams_Clone(t *testing.T) {
+
+	original, err := GetPayrollParams(2024, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := original.Clone()
+	for i := range original.Formulas {
+		if clone.Formulas[i] == original.Formulas[i] {
+			t.Errorf("expected formula at index %d to be copied", i)
+		}
+	}
+
+	if (PayrollParams{}).Clone().Formulas != nil {
+		t.Error("expected cloning empty params to return empty params")
+	}
+}
+
+func TestGetOASParams(t *testing.T) {
+
+	params, err := GetOASParams(2025, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taxParams, err := GetTaxParams(2025, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pensioner := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}, ResidenceYears: 40}
+	expected := 3*727.67 + 3*727.67 + 3*734.95 + 3*740.09
+	actual := params.Formula.Apply(pensioner)
+	if math.Abs(actual-expected) > 1e-6 {
+		t.Errorf("unexpected pension\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	// the recovery tax is part of the federal tax
+	finances := finance.NewIndividualFinances()
+	finances.AddAmount(core.IncSrcOAS, actual)
+	tp := &tax.TaxPayer{Finances: finances, NetIncome: 100000 + actual}
+	recovery := params.Formula.RecoveryAdjuster().Adjustment(tp, 0)
+	adjustments := taxParams.AdjustmentFormula.Apply(tp, 0)
+	if len(adjustments) != 1 || adjustments[0].Amount != recovery || recovery == 0 {
+		t.Errorf("unexpected adjustments for recovery tax %.2f: %v", recovery, adjustments)
+	}
+}
+
+func TestGetOASParams_Errors(t *testing.T) {
+
+	_, err := GetOASParams(2018, core.RegionBC)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = GetOASParams(2108, core.RegionCA)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+}
+
+func TestGetGISParams(t *testing.T) {
+
+	params, err := GetGISParams(2025, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the OAS pension and the first portion of employment income are exempt
+	finances := finance.NewIndividualFinances()
+	finances.AddAmount(core.IncSrcOAS, 8791.14)
+	finances.AddAmount(core.IncSrcEarned, 9000)
+	incomeCalc.SetFinances(finances)
+	if actual := incomeCalc.NetIncome(); actual != 2000 {
+		t.Errorf("unexpected income\nwant: %.2f\n got: %.2f", 2000.0, actual)
+	}
+
+	// the supplement of a single pensioner and a couple of pensioners is fully
+	// reduced at the published maximum annual incomes of the first quarter
+	single := &human.Pensioner{Person: human.Person{AgeMonths: 70 * 12}}
+	formula := params.Formula.(*benefits.GISFormula)
+	cases := []struct {
+		name      string
+		rates     benefits.GISRates
+		maxIncome float64
+	}{
+		{"single", formula.Single, 22056},
+		{"spouse-oas", formula.SpouseOAS, 29136},
+		{"spouse-allowance", formula.SpouseAllowance, 40800},
+		{"spouse-no-oas", formula.SpouseNoOAS, 52848},
+	}
+	for _, c := range cases {
+		reduction := c.rates.Reducers.Apply(c.maxIncome) / 12
+		if math.Abs(reduction-c.rates.AmountsPerMonth[0]) > 1.0 {
+			t.Errorf("%s: unexpected monthly reduction\nwant: %.2f\n got: %.2f",
+				c.name, c.rates.AmountsPerMonth[0], reduction)
+		}
+	}
+
+	expected := 3 * (1086.88 + 1086.88 + 1097.75 + 1105.43)
+	if actual := params.Formula.Apply(0, single, nil); math.Abs(actual-expected) > 1e-6 {
+		t.Errorf("unexpected supplement\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+}
+
+func TestGetGISParams_Errors(t *testing.T) {
+
+	_, err := GetGISParams(2018, core.RegionBC)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = GetGISParams(2108, core.RegionCA)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+}
+
+func TestGetGSTCreditParams(t *testing.T) {
+
+	params, err := GetGSTCreditParams(2024, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a single tax payer with the full single supplement
+	finances := finance.NewHouseholdFinances(finance.NewIndividualFinances(), nil)
+	household := &benefits.Household{Finances: finances, NetIncomeA: 30000}
+	if actual := params.Formula.Apply(household); actual != 340+179 {
+		t.Errorf("unexpected credit\nwant: %.2f\n got: %.2f", 340.0+179.0, actual)
+	}
+}
+
+func TestGetGSTCreditParams_Errors(t *testing.T) {
+
+	_, err := GetGSTCreditParams(2018, core.RegionBC)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = GetGSTCreditParams(2108, core.RegionCA)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+}
+
+func TestGetCWBParams(t *testing.T) {
+
+	params, err := GetCWBParams(2023, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a single worker with the maximum basic amount
+	spouseA := &benefits.Earner{WorkingIncome: 15000, NetIncome: 15000}
+	if actual, _ := params.Formula.Apply(spouseA, nil); actual != 1518 {
+		t.Errorf("unexpected benefit\nwant: %.2f\n got: %.2f", 1518.0, actual)
+	}
+}
+
+func TestGetCWBParams_Errors(t *testing.T) {
+
+	_, err := GetCWBParams(2019, core.RegionBC)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = GetCWBParams(2018, core.RegionCA)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Fatalf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+}
+
+func TestIncomeRecipeWorkingCA(t *testing.T) {
+
+	finances := finance.NewIndividualFinances()
+	for _, src := range core.FinancialSources() {
+		if src.IsIncomeSource() {
+			finances.AddAmount(src, 1000)
+		}
+	}
+
+	calculator, err := income.NewCalculator(incomeRecipeWorkingCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(finances)
+
+	if actual := calculator.TotalIncome(); actual != 1000 {
+		t.Errorf("expected working income to only include earned income\nwant: %.2f\n got: %.2f", 1000.0, actual)
+	}
+}
+
 func TestPanicIfError(t *testing.T) {
 
 	defer func() {
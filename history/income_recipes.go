@@ -6,9 +6,56 @@ import (
 )
 
 var (
+	incomeRecipeNetCA2025 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetCA2024 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetCA2023 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
 	incomeRecipeNetCA2022 = &income.Recipe{
 		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
 			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetCA2021 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetCA2020 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
 			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
 		},
 	}
@@ -31,6 +78,72 @@ var (
 		},
 	}
 
+	incomeRecipeAFNICA2025 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeAFNICA2024 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeAFNICA2023 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeAFNICA2022 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeAFNICA2021 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeAFNICA2020 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
 	incomeRecipeAFNICA2019 = &income.Recipe{
 		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
 			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
@@ -52,4 +165,188 @@ var (
 			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
 		},
 	}
+
+	incomeRecipeNetQC2025 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1420},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2024 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1380},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2023 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1320},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2022 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1245},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2021 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1200},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2020 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1190},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2019 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1175},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeNetQC2018 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.CappedReductionAdjuster{Rate: 0.06, Cap: 1150},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.16),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2025 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2024 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2023 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2022 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2021 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2020 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 5000, PartialExemption: 10000, PartialRate: 0.5},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2019 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 3500},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.15),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+
+	incomeRecipeGISCA2018 = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcEarned:                 income.ExemptionAdjuster{Exemption: 3500},
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.5),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(1.38),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(1.16),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
+	// incomeRecipeWorkingCA computes the working income as the total income,
+	// which only includes employment and self-employment income
+	incomeRecipeWorkingCA = &income.Recipe{
+		IncomeAdjusters: map[core.FinancialSource]income.Adjuster{
+			core.IncSrcInterest:               income.WeightedAdjuster(0.0),
+			core.IncSrcCapitalGainCA:          income.WeightedAdjuster(0.0),
+			core.IncSrcEligibleDividendsCA:    income.WeightedAdjuster(0.0),
+			core.IncSrcNonEligibleDividendsCA: income.WeightedAdjuster(0.0),
+			core.IncSrcForeignDividends:       income.WeightedAdjuster(0.0),
+			core.IncSrcRRSP:                   income.WeightedAdjuster(0.0),
+			core.IncSrcUCCB:                   income.WeightedAdjuster(0.0),
+			core.IncSrcRDSP:                   income.WeightedAdjuster(0.0),
+			core.IncSrcTFSA:                   income.WeightedAdjuster(0.0),
+			core.IncSrcOAS:                    income.WeightedAdjuster(0.0),
+		},
+	}
 )
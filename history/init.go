@@ -1,83 +1,47 @@
 package history
 
-import "github.com/pkg/errors"
-
-func init() {
-
-	err := validateAllTaxParams()
-	panicIfError(errors.Wrap(err, "invalid tax params"))
-
-	err = validateAllRRSPParams()
-	panicIfError(errors.Wrap(err, "invalid RRSP params"))
-
-	err = validateAllCBParams()
-	panicIfError(errors.Wrap(err, "invalid child benefit params"))
-
+import (
+	"reflect"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// builtinParams are the built-in params of all kinds, where each of them maps
+// regions to the params of each year
+var builtinParams = []interface{}{
+	taxParamsAll,
+	rrspParamsAll,
+	cbParamsAll,
+	payrollParamsAll,
+	oasParamsAll,
+	gisParamsAll,
+	gstParamsAll,
+	cwbParamsAll,
 }
 
-func validateAllTaxParams() error {
-
-	for jursdiction, paramsAllYears := range taxParamsAll {
-		for year, params := range paramsAllYears {
-
-			if params.Formula == nil {
-				return errors.Wrapf(errNilFormula, "%s[%d]", jursdiction, year)
-			}
-
-			err := params.Formula.Validate()
-			if err != nil {
-				return errors.Wrapf(err, "%s[%d]", jursdiction, year)
-			}
-
-			if params.ContraFormula == nil {
-				return errors.Wrapf(errNilContraFormula, "%s[%d]", jursdiction, year)
-			}
-
-			err = params.ContraFormula.Validate()
-			if err != nil {
-				return errors.Wrapf(err, "%s[%d]", jursdiction, year)
-			}
-
-		}
-	}
-
-	return nil
-}
-
-func validateAllRRSPParams() error {
-
-	for jursdiction, paramsAllYears := range rrspParamsAll {
-		for year, params := range paramsAllYears {
-
-			if params.Formula == nil {
-				return errors.Wrapf(errNilFormula, "%s[%d]", jursdiction, year)
-			}
-
-			err := params.Formula.Validate()
-			if err != nil {
-				return errors.Wrapf(errNilFormula, "%s[%d]", jursdiction, year)
-			}
-
-		}
+func init() {
+	for _, all := range builtinParams {
+		panicIfError(registerAll(defaultRegistry, all))
 	}
-
-	return nil
 }
 
-func validateAllCBParams() error {
+// registerAll registers the given params in the given registry, where the
+// params map regions to the params of each year, e.g. taxParamsAll
+func registerAll(registry *Registry, all interface{}) error {
 
-	for jursdiction, paramsAllYears := range cbParamsAll {
-		for year, params := range paramsAllYears {
+	regions := reflect.ValueOf(all).MapRange()
+	for regions.Next() {
 
-			if params.Formula == nil {
-				return errors.Wrapf(errNilFormula, "%s[%d]", jursdiction, year)
-			}
+		region := regions.Key().Interface().(core.Region)
+		years := regions.Value().MapRange()
+		for years.Next() {
 
-			err := params.Formula.Validate()
+			params := years.Value().Interface().(Params)
+			err := registry.Register(uint(years.Key().Uint()), region, params)
 			if err != nil {
-				return errors.Wrapf(errNilFormula, "%s[%d]", jursdiction, year)
+				return errors.Wrapf(err, "invalid %s params", params.Kind())
 			}
-
 		}
 	}
 
@@ -0,0 +1,174 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// RulesFormat is the encoding of a rule file
+type RulesFormat int
+
+const (
+	// unknown/uninitialized
+	_ RulesFormat = iota
+	// FormatJSON indicates JSON-encoded rule files
+	FormatJSON
+	// FormatYAML indicates YAML-encoded rule files
+	FormatYAML
+)
+
+// LoadRulesFile loads the rule file at the given path into the default
+// registry. See Registry.LoadRulesFile for details
+func LoadRulesFile(path string) error {
+	return defaultRegistry.LoadRulesFile(path)
+}
+
+// LoadRules loads the given rule file into the default registry. See
+// Registry.LoadRules for details
+func LoadRules(r io.Reader, format RulesFormat) error {
+	return defaultRegistry.LoadRules(r, format)
+}
+
+// LoadRulesFile loads the rule file at the given path, where the format of the
+// file is determined by its extension (.json, .yaml, or .yml)
+func (r *Registry) LoadRulesFile(path string) error {
+
+	var format RulesFormat
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		format = FormatJSON
+	case ".yaml", ".yml":
+		format = FormatYAML
+	default:
+		return errors.Wrapf(ErrUnknownFormat, "file extension of %q", path)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "error reading rule file")
+	}
+
+	return r.LoadRules(bytes.NewReader(data), format)
+}
+
+// LoadRules decodes the tax, child benefit, and RRSP params declared in the
+// given rule file, validates them, and registers them in this registry.
+// Params loaded for a region and year that already have params replace them.
+// If any of the declared params is invalid, nothing is registered
+func (r *Registry) LoadRules(reader io.Reader, format RulesFormat) error {
+
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Wrap(err, "error reading rules")
+	}
+
+	var file ruleFile
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	case FormatYAML:
+		err = yaml.UnmarshalStrict(data, &file)
+	default:
+		return ErrUnknownFormat
+	}
+	if err != nil {
+		return errors.Wrap(err, "error decoding rules")
+	}
+
+	if file.Version != RulesVersion {
+		return errors.Wrapf(ErrUnsupportedVersion, "version %d", file.Version)
+	}
+
+	staged, err := file.registry()
+	if err != nil {
+		return err
+	}
+
+	r.merge(staged)
+	return nil
+}
+
+// registry returns a new registry holding the params declared in this file
+func (f ruleFile) registry() (*Registry, error) {
+
+	staged := NewRegistry()
+
+	for i, rule := range f.Tax {
+
+		err := validateRuleKey(rule.Region, rule.Year, staged.has(KindTax, rule.Year, rule.Region))
+		if err != nil {
+			return nil, errors.Wrapf(err, "tax rule at index %d", i)
+		}
+
+		params, err := rule.toParams()
+		if err == nil {
+			err = staged.Register(rule.Year, rule.Region, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s[%d]: invalid tax rule", rule.Region, rule.Year)
+		}
+	}
+
+	for i, rule := range f.ChildBenefits {
+
+		err := validateRuleKey(rule.Region, rule.Year, staged.has(KindChildBenefit, rule.Year, rule.Region))
+		if err != nil {
+			return nil, errors.Wrapf(err, "child benefit rule at index %d", i)
+		}
+
+		params, err := rule.toParams()
+		if err == nil {
+			err = staged.Register(rule.Year, rule.Region, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s[%d]: invalid child benefit rule", rule.Region, rule.Year)
+		}
+	}
+
+	for i, rule := range f.RRSP {
+
+		err := validateRuleKey(rule.Region, rule.Year, staged.has(KindRRSP, rule.Year, rule.Region))
+		if err != nil {
+			return nil, errors.Wrapf(err, "RRSP rule at index %d", i)
+		}
+
+		params, err := rule.toParams()
+		if err == nil {
+			err = staged.Register(rule.Year, rule.Region, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s[%d]: invalid RRSP rule", rule.Region, rule.Year)
+		}
+	}
+
+	return staged, nil
+}
+
+// validateRuleKey ensures that a rule has a region and a year that were not
+// declared by a previous rule of the same type in the same file
+func validateRuleKey(region core.Region, year uint, declared bool) error {
+
+	if region == "" {
+		return errors.Wrap(ErrInvalidRule, "missing region")
+	}
+
+	if year == 0 {
+		return errors.Wrap(ErrInvalidRule, "missing year")
+	}
+
+	if declared {
+		return errors.Wrapf(ErrInvalidRule, "%s[%d] is declared more than once", region, year)
+	}
+
+	return nil
+}
@@ -0,0 +1,703 @@
+package history
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/pkg/errors"
+)
+
+const testRegion = core.Region("UnitTest")
+
+const testRulesYAML = `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    brackets:
+      - {rate: 0.10, lower: 0, upper: 50000}
+      - {rate: 0.20, lower: 50000}
+    creditors:
+      - kind: const
+        amount: 1000
+        credit_source: personal-amount
+        credit_type: not-carry-forward
+      - kind: canadian-spouse
+        base_amount: 10000
+        weight: 0.10
+        credit_source: canadian-spouse-credit
+        credit_type: not-carry-forward
+      - kind: weighted
+        weight: 0.10
+        credit_source: tuition-amount
+        credit_type: can-carry-forward
+        financial_source: tuition
+        description: tuition
+    income_recipe:
+      income:
+        capital-gain-ca: {kind: weighted, weight: 0.5}
+        earned: {kind: capped-reduction, rate: 0.06, cap: 1000}
+child_benefits:
+  - region: UnitTest
+    year: 2099
+    kind: ccb
+    beneficiaries:
+      - {min_age_months: 0, max_age_months: 71, min_per_month: 0, max_per_month: 500}
+    reducers:
+      - [{rate: 0.07, lower: 30000, upper: 60000}, {rate: 0.03, lower: 60000}]
+    income_recipe:
+      income:
+        tfsa: {kind: weighted, weight: 0}
+    small_payment_threshold: 240
+    disability_amount: 1200
+    disability_reducers:
+      - [{rate: 0.03, lower: 60000}]
+rrsp:
+  - region: UnitTest
+    year: 2099
+    rate: 0.18
+    cap: 26230
+    income_sources: [earned]
+    withdrawal_source: rrsp-withdrawal
+    contribution_source: rrsp-contribution
+`
+
+func unregisterTestRegion() {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+	for _, regionParams := range defaultRegistry.params {
+		delete(regionParams, testRegion)
+	}
+}
+
+func TestLoadRules_DefaultRegistry(t *testing.T) {
+
+	defer unregisterTestRegion()
+
+	err := LoadRules(strings.NewReader(testRulesYAML), FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetTaxParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetChildBenefitParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetRRSPParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRegistry_LoadRules_YAML(t *testing.T) {
+
+	registry := NewRegistry()
+	err := registry.LoadRules(strings.NewReader(testRulesYAML), FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taxParams, err := registry.TaxParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualTax := taxParams.Formula.Apply(60000)
+	if math.Abs(actualTax-7000) > 1e-9 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", 7000.0, actualTax)
+	}
+	if taxParams.Formula.Year() != 2099 || taxParams.Formula.Region() != testRegion {
+		t.Errorf("expected formula year and region to match the rule")
+	}
+	if err = taxParams.ContraFormula.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	cbParams, err := registry.ChildBenefitParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	child := &human.Person{AgeMonths: 0}
+	actualBenefits := cbParams.Formula.Apply(40000, child)
+	if math.Abs(actualBenefits-5300) > 1e-9 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", 5300.0, actualBenefits)
+	}
+
+	child.DisabilityEligible = true
+	actualBenefits = cbParams.Formula.Apply(70000, child)
+	expectedBenefits := 6000 - 0.07*30000 - 0.03*10000 + 1200 - 0.03*10000
+	if math.Abs(actualBenefits-expectedBenefits) > 1e-9 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", expectedBenefits, actualBenefits)
+	}
+
+	lumpSumFormula, ok := cbParams.Formula.(benefits.LumpSumFormula)
+	if !ok || lumpSumFormula.LumpSumThreshold() != 240 {
+		t.Errorf("expected the small-payment threshold of the rule to be loaded")
+	}
+
+	rrspParams, err := registry.RRSPParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	builtin, err := GetRRSPParams(2018, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(rrspParams, builtin)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestRegistry_LoadRules_JSON(t *testing.T) {
+
+	rules := `{
+		"version": 1,
+		"rrsp": [{
+			"region": "UnitTest",
+			"year": 2099,
+			"rate": 0.18,
+			"cap": 26230,
+			"income_sources": ["earned"],
+			"withdrawal_source": "rrsp-withdrawal",
+			"contribution_source": "rrsp-contribution"
+		}]
+	}`
+
+	registry := NewRegistry()
+	err := registry.LoadRules(strings.NewReader(rules), FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := registry.RRSPParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := GetRRSPParams(2018, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(actual, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestRegistry_LoadRules_ReplacesExisting(t *testing.T) {
+
+	registry := NewRegistry()
+
+	rules := `
+version: 1
+rrsp:
+  - {region: UnitTest, year: 2099, rate: 0.18, cap: %d, income_sources: [earned]}
+`
+	for _, cap := range []int{1000, 2000} {
+		err := registry.LoadRules(strings.NewReader(fmt.Sprintf(rules, cap)), FormatYAML)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	params, err := registry.RRSPParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := params.Formula.ContributionEarned(math.MaxFloat64)
+	if actual != 2000 {
+		t.Errorf("expected the last loaded params to be used\nwant: %d\n got: %.0f", 2000, actual)
+	}
+}
+
+func TestRegistry_LoadRules_AdjustmentsAndFamilyBenefit(t *testing.T) {
+
+	// the rules declare the params of built-in formulas to compare with
+	rules := `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    creditors:
+      - kind: living-alone
+        base_amount: 2128
+        reduction_threshold: 42090
+        reduction_rate: 0.1875
+        weight: 0.14
+        credit_source: living-alone-amount
+        credit_type: not-carry-forward
+        description: credits for a person living alone
+    adjusters:
+      - kind: surtax
+        brackets: [{rate: 0.20, lower: 5554}, {rate: 0.36, lower: 7108}]
+        description: Ontario surtax
+      - kind: source-credit
+        weight: 0.138
+        financial_source: eligible-dividends-ca
+        description: Ontario dividend tax credit for eligible dividends
+      - kind: source-credit
+        weight: 0.03434245
+        financial_source: non-eligible-dividends-ca
+        description: Ontario dividend tax credit for non-eligible dividends
+      - kind: low-income-reduction
+        base_amount: 286
+        dependent_amount: 529
+        max_dependent_age_months: 227
+        description: Ontario tax reduction
+      - kind: income-premium
+        brackets:
+          - {rate: 0.06, lower: 20000, upper: 25000}
+          - {rate: 0.06, lower: 36000, upper: 38500}
+          - {rate: 0.25, lower: 48000, upper: 48600}
+          - {rate: 0.25, lower: 72000, upper: 72600}
+          - {rate: 0.25, lower: 200000, upper: 200600}
+        description: Ontario health premium
+      - kind: recovery-tax
+        rate: 0.15
+        threshold: 93454
+        financial_source: oas
+        description: OAS recovery tax
+    abatements:
+      - {target_region: Canada, rate: 0.165, description: refundable Quebec abatement}
+child_benefits:
+  - region: UnitTest
+    year: 2099
+    kind: bcfb
+    family_benefit:
+      max_amounts: [2188, 1375, 1125]
+      min_amounts: [775, 750, 725]
+      max_child_age_months: 215
+      reduction_rate: 0.04
+      lower_threshold: 37336
+      upper_threshold: 119475
+      single_parent_supplement: 500
+`
+
+	registry := NewRegistry()
+	err := registry.LoadRules(strings.NewReader(rules), FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taxParams, err := registry.TaxParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creditors := taxParams.ContraFormula.(*tax.CanadianContraFormula).OrderedCreditors
+	diff := deep.Equal(creditors[0], taxContraFormulaQC2025.OrderedCreditors[1])
+	if diff != nil {
+		t.Error("unexpected living-alone creditor\n" + strings.Join(diff, "\n"))
+	}
+
+	adjustmentFormula, ok := taxParams.AdjustmentFormula.(*tax.CanadianAdjustmentFormula)
+	if !ok {
+		t.Fatalf("unexpected type\nwant: %T\n got: %T", adjustmentFormula, taxParams.AdjustmentFormula)
+	}
+	if adjustmentFormula.Year() != 2099 || adjustmentFormula.Region() != testRegion {
+		t.Errorf("expected adjustment formula year and region to match the rule")
+	}
+
+	expectedAdjusters := append(
+		taxAdjFormulaON2024.Clone().(*tax.CanadianAdjustmentFormula).OrderedAdjusters,
+		oasFormulaCanada2025.RecoveryAdjuster(),
+	)
+	diff = deep.Equal(adjustmentFormula.OrderedAdjusters, expectedAdjusters)
+	if diff != nil {
+		t.Error("unexpected adjusters\n" + strings.Join(diff, "\n"))
+	}
+
+	diff = deep.Equal(taxParams.Abatements, []tax.Abatement{abatementFederalQC})
+	if diff != nil {
+		t.Error("unexpected abatements\n" + strings.Join(diff, "\n"))
+	}
+
+	cbParams, err := registry.ChildBenefitParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff = deep.Equal(cbParams.Formula, cbFormulaBC2024)
+	if diff != nil {
+		t.Error("unexpected family benefit formula\n" + strings.Join(diff, "\n"))
+	}
+}
+
+// testRulesBC2020JSON declares the built-in BC child benefit params of 2020,
+// when the BC Family Benefit replaced the BCECTB in October
+const testRulesBC2020JSON = `{
+  "version": 1,
+  "child_benefits": [
+    {
+      "region": "UnitTest",
+      "year": 2099,
+      "kind": "transition",
+      "before": {
+        "kind": "bcectb",
+        "beneficiaries": [{"min_age_months": 0, "max_age_months": 71, "min_per_month": 0, "max_per_month": 55}],
+        "reducers": [[{"rate": 0.0132, "lower": 100000}]]
+      },
+      "after": {
+        "kind": "bcfb",
+        "family_benefit": {
+          "max_amounts": [1600, 1000, 800],
+          "min_amounts": [700, 680, 660],
+          "max_child_age_months": 215,
+          "reduction_rate": 0.04,
+          "lower_threshold": 25000,
+          "upper_threshold": 80000
+        }
+      },
+      "transition_month": 3,
+      "income_recipe": {
+        "income": {
+          "capital-gain-ca": {"kind": "weighted", "weight": 0.5},
+          "eligible-dividends-ca": {"kind": "weighted", "weight": 1.38},
+          "non-eligible-dividends-ca": {"kind": "weighted", "weight": 1.15},
+          "tfsa": {"kind": "weighted", "weight": 0},
+          "uccb": {"kind": "weighted", "weight": 0},
+          "rdsp": {"kind": "weighted", "weight": 0}
+        }
+      }
+    }
+  ]
+}`
+
+func TestRegistry_LoadRules_Transition(t *testing.T) {
+
+	registry := NewRegistry()
+	err := registry.LoadRules(strings.NewReader(testRulesBC2020JSON), FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := registry.ChildBenefitParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := DefaultRegistry().ChildBenefitParams(2020, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := deep.Equal(actual, expected)
+	if diff != nil {
+		t.Error("unexpected BC child benefit params\n" + strings.Join(diff, "\n"))
+	}
+}
+
+func TestRegistry_LoadRules_Errors(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		rules  string
+		format RulesFormat
+		err    error
+	}{
+		//
+		{
+			name:   "unknown-format",
+			rules:  "version: 1",
+			format: RulesFormat(0),
+			err:    ErrUnknownFormat,
+		},
+		//
+		{
+			name:   "unsupported-version",
+			rules:  "version: 2",
+			format: FormatYAML,
+			err:    ErrUnsupportedVersion,
+		},
+		//
+		{
+			name:   "unknown-field",
+			rules:  `{"version": 1, "unknown": true}`,
+			format: FormatJSON,
+			err:    nil, // decoding errors are not sentinel errors
+		},
+		//
+		{
+			name: "missing-region",
+			rules: `
+version: 1
+rrsp: [{year: 2099, income_sources: [earned]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "duplicate-declaration",
+			rules: `
+version: 1
+rrsp:
+  - {region: UnitTest, year: 2099}
+  - {region: UnitTest, year: 2099}`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-financial-source",
+			rules: `
+version: 1
+rrsp: [{region: UnitTest, year: 2099, income_sources: [lottery]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-creditor-kind",
+			rules: `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    creditors: [{kind: magic, credit_type: cashable}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-credit-type",
+			rules: `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    creditors: [{kind: const, credit_type: magic}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-adjuster-kind",
+			rules: `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    income_recipe: {income: {earned: {kind: magic}}}`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "duplicate-bracket-rate",
+			rules: `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    brackets: [{rate: 0.1, upper: 10}, {rate: 0.1, lower: 10}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "invalid-bracket",
+			rules: `
+version: 1
+tax:
+  - region: UnitTest
+    year: 2099
+    brackets: [{rate: 0.1, lower: 10, upper: 0}]`,
+			format: FormatYAML,
+			err:    core.ErrBoundsReversed,
+		},
+		//
+		{
+			name: "unknown-child-benefit-kind",
+			rules: `
+version: 1
+child_benefits: [{region: UnitTest, year: 2099, kind: magic}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "invalid-disability-reducer",
+			rules: `
+version: 1
+child_benefits: [{region: UnitTest, year: 2099, kind: ccb, reducers: [[]], disability_reducers: [[{rate: 0.03, lower: 2, upper: 1}]]}]`,
+			format: FormatYAML,
+			err:    core.ErrBoundsReversed,
+		},
+		//
+		{
+			name: "bcectb-multiple-reducers",
+			rules: `
+version: 1
+child_benefits: [{region: UnitTest, year: 2099, kind: bcectb, reducers: [[], []]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-tax-adjuster-kind",
+			rules: `
+version: 1
+tax: [{region: UnitTest, year: 2099, adjusters: [{kind: magic}]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "unknown-tax-adjuster-source",
+			rules: `
+version: 1
+tax: [{region: UnitTest, year: 2099, adjusters: [{kind: recovery-tax, financial_source: lottery}]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "duplicate-surtax-rate",
+			rules: `
+version: 1
+tax: [{region: UnitTest, year: 2099, adjusters: [{kind: surtax, brackets: [{rate: 0.2}, {rate: 0.2}]}]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "bcfb-missing-family-benefit",
+			rules: `
+version: 1
+child_benefits: [{region: UnitTest, year: 2099, kind: bcfb}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "transition-missing-after",
+			rules: `
+version: 1
+child_benefits: [{region: UnitTest, year: 2099, kind: transition, transition_month: 3, before: {kind: bcfb}}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "transition-month-out-of-range",
+			rules: `
+version: 1
+child_benefits:
+  - region: UnitTest
+    year: 2099
+    kind: transition
+    transition_month: 12
+    before: {kind: bcfb, family_benefit: {}}
+    after: {kind: bcfb, family_benefit: {}}`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "transition-invalid-formula",
+			rules: `
+version: 1
+child_benefits:
+  - region: UnitTest
+    year: 2099
+    kind: transition
+    transition_month: 3
+    before: {kind: magic}
+    after: {kind: bcfb, family_benefit: {}}`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+		//
+		{
+			name: "invalid-rule-after-valid-rule",
+			rules: `
+version: 1
+rrsp: [{region: UnitTest, year: 2099, rate: 0.18, cap: 100}]
+tax: [{region: UnitTest, year: 2099, creditors: [{kind: magic}]}]`,
+			format: FormatYAML,
+			err:    ErrInvalidRule,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			registry := NewRegistry()
+			err := registry.LoadRules(strings.NewReader(c.rules), c.format)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if c.err != nil && errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+
+			_, err = registry.RRSPParams(2099, testRegion)
+			if errors.Cause(err) != ErrRegionNotExist {
+				t.Errorf("expected nothing to be registered, got error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadRulesFile(t *testing.T) {
+
+	defer unregisterTestRegion()
+
+	dir, err := ioutil.TempDir("", "quantax")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rules.yml")
+	err = ioutil.WriteFile(path, []byte(testRulesYAML), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = LoadRulesFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetTaxParams(2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadRulesFile_Errors(t *testing.T) {
+
+	err := LoadRulesFile("rules.txt")
+	if errors.Cause(err) != ErrUnknownFormat {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnknownFormat, err)
+	}
+
+	err = LoadRulesFile(filepath.Join("does", "not", "exist.json"))
+	if err == nil {
+		t.Error("expected an error when the file does not exist")
+	}
+}
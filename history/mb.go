@@ -0,0 +1,251 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsMB = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaMB2025,
+		ContraFormula: taxContraFormulaMB2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaMB2024,
+		ContraFormula: taxContraFormulaMB2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaMB2023,
+		ContraFormula: taxContraFormulaMB2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaMB2022,
+		ContraFormula: taxContraFormulaMB2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaMB2021,
+		ContraFormula: taxContraFormulaMB2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaMB2020,
+		ContraFormula: taxContraFormulaMB2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaMB2019,
+		ContraFormula: taxContraFormulaMB2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaMB2018,
+		ContraFormula: taxContraFormulaMB2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaMB2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 47000},
+		0.1275: core.Bracket{47000, 100000},
+		0.1740: core.Bracket{100000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaMB2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 15780, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2024 */
+
+var taxFormulaMB2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 47000},
+		0.1275: core.Bracket{47000, 100000},
+		0.1740: core.Bracket{100000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaMB2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 15780, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2023 */
+
+var taxFormulaMB2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 36842},
+		0.1275: core.Bracket{36842, 79625},
+		0.1740: core.Bracket{79625, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaMB2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 10855, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2022 */
+
+var taxFormulaMB2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 34431},
+		0.1275: core.Bracket{34431, 74416},
+		0.1740: core.Bracket{74416, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaMB2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 10145, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2021 */
+
+var taxFormulaMB2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 33723},
+		0.1275: core.Bracket{33723, 72885},
+		0.1740: core.Bracket{72885, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaMB2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 9936, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2020 */
+
+var taxFormulaMB2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 33389},
+		0.1275: core.Bracket{33389, 72164},
+		0.1740: core.Bracket{72164, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaMB2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 9838, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2019 */
+
+var taxFormulaMB2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 32670},
+		0.1275: core.Bracket{32670, 70610},
+		0.1740: core.Bracket{70610, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaMB2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 9626, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionMB,
+}
+
+/* 2018 */
+
+var taxFormulaMB2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1080: core.Bracket{0, 31843},
+		0.1275: core.Bracket{31843, 68821},
+		0.1740: core.Bracket{68821, math.Inf(1)},
+	},
+	TaxRegion: core.RegionMB,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaMB2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1080 * 9382, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9134, Weight: 0.1080, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1080, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0800, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.007835, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionMB,
+}
@@ -0,0 +1,272 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsNB = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaNB2025,
+		ContraFormula: taxContraFormulaNB2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaNB2024,
+		ContraFormula: taxContraFormulaNB2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaNB2023,
+		ContraFormula: taxContraFormulaNB2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaNB2022,
+		ContraFormula: taxContraFormulaNB2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaNB2021,
+		ContraFormula: taxContraFormulaNB2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaNB2020,
+		ContraFormula: taxContraFormulaNB2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaNB2019,
+		ContraFormula: taxContraFormulaNB2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaNB2018,
+		ContraFormula: taxContraFormulaNB2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaNB2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0940: core.Bracket{0, 51306},
+		0.1400: core.Bracket{51306, 102614},
+		0.1600: core.Bracket{102614, 190060},
+		0.1950: core.Bracket{190060, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaNB2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0940 * 13396, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11375, Weight: 0.0940, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2024 */
+
+var taxFormulaNB2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0940: core.Bracket{0, 49958},
+		0.1400: core.Bracket{49958, 99916},
+		0.1600: core.Bracket{99916, 185064},
+		0.1950: core.Bracket{185064, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaNB2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0940 * 13044, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11076, Weight: 0.0940, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2023 */
+
+var taxFormulaNB2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0940: core.Bracket{0, 47715},
+		0.1400: core.Bracket{47715, 95431},
+		0.1600: core.Bracket{95431, 176756},
+		0.1950: core.Bracket{176756, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaNB2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0940 * 12458, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10579, Weight: 0.0940, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0940, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2022 */
+
+var taxFormulaNB2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0968: core.Bracket{0, 44887},
+		0.1482: core.Bracket{44887, 89775},
+		0.1652: core.Bracket{89775, 145955},
+		0.1784: core.Bracket{145955, 166280},
+		0.2030: core.Bracket{166280, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaNB2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0968 * 11720, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9952, Weight: 0.0968, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2021 */
+
+var taxFormulaNB2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0968: core.Bracket{0, 43835},
+		0.1482: core.Bracket{43835, 87671},
+		0.1652: core.Bracket{87671, 142534},
+		0.1784: core.Bracket{142534, 162383},
+		0.2030: core.Bracket{162383, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaNB2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0968 * 10564, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8970, Weight: 0.0968, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2020 */
+
+var taxFormulaNB2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0968: core.Bracket{0, 43401},
+		0.1482: core.Bracket{43401, 86803},
+		0.1652: core.Bracket{86803, 141122},
+		0.1784: core.Bracket{141122, 160776},
+		0.2030: core.Bracket{160776, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaNB2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0968 * 10459, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8881, Weight: 0.0968, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2019 */
+
+var taxFormulaNB2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0968: core.Bracket{0, 42592},
+		0.1482: core.Bracket{42592, 85184},
+		0.1652: core.Bracket{85184, 138491},
+		0.1784: core.Bracket{138491, 157778},
+		0.2030: core.Bracket{157778, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaNB2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0968 * 10264, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8715, Weight: 0.0968, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0275, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionNB,
+}
+
+/* 2018 */
+
+var taxFormulaNB2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0968: core.Bracket{0, 41675},
+		0.1482: core.Bracket{41675, 83351},
+		0.1652: core.Bracket{83351, 135510},
+		0.1784: core.Bracket{135510, 154382},
+		0.2030: core.Bracket{154382, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNB,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaNB2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0968 * 10043, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8528, Weight: 0.0968, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0968, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1400, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0200, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionNB,
+}
@@ -0,0 +1,287 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsNL = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaNL2025,
+		ContraFormula: taxContraFormulaNL2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaNL2024,
+		ContraFormula: taxContraFormulaNL2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaNL2023,
+		ContraFormula: taxContraFormulaNL2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaNL2022,
+		ContraFormula: taxContraFormulaNL2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaNL2021,
+		ContraFormula: taxContraFormulaNL2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaNL2020,
+		ContraFormula: taxContraFormulaNL2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaNL2019,
+		ContraFormula: taxContraFormulaNL2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaNL2018,
+		ContraFormula: taxContraFormulaNL2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaNL2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 44192},
+		0.1450: core.Bracket{44192, 88382},
+		0.1580: core.Bracket{88382, 157792},
+		0.1780: core.Bracket{157792, 220910},
+		0.1980: core.Bracket{220910, 282214},
+		0.2080: core.Bracket{282214, 564429},
+		0.2130: core.Bracket{564429, 1128858},
+		0.2180: core.Bracket{1128858, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaNL2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 11067, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9043, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0320, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2024 */
+
+var taxFormulaNL2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 43198},
+		0.1450: core.Bracket{43198, 86395},
+		0.1580: core.Bracket{86395, 154244},
+		0.1780: core.Bracket{154244, 215943},
+		0.1980: core.Bracket{215943, 275870},
+		0.2080: core.Bracket{275870, 551739},
+		0.2130: core.Bracket{551739, 1103478},
+		0.2180: core.Bracket{1103478, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaNL2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 10818, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8839, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0320, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2023 */
+
+var taxFormulaNL2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 41457},
+		0.1450: core.Bracket{41457, 82913},
+		0.1580: core.Bracket{82913, 148027},
+		0.1780: core.Bracket{148027, 207239},
+		0.1980: core.Bracket{207239, 264750},
+		0.2080: core.Bracket{264750, 529500},
+		0.2130: core.Bracket{529500, 1059000},
+		0.2180: core.Bracket{1059000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaNL2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 10382, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8483, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0320, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2022 */
+
+var taxFormulaNL2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 39147},
+		0.1450: core.Bracket{39147, 78294},
+		0.1580: core.Bracket{78294, 139780},
+		0.1780: core.Bracket{139780, 195693},
+		0.1980: core.Bracket{195693, 250000},
+		0.2080: core.Bracket{250000, 500000},
+		0.2130: core.Bracket{500000, 1000000},
+		0.2180: core.Bracket{1000000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaNL2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 9803, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8010, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0320, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2021 */
+
+var taxFormulaNL2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 38081},
+		0.1450: core.Bracket{38081, 76161},
+		0.1580: core.Bracket{76161, 135973},
+		0.1730: core.Bracket{135973, 190363},
+		0.1830: core.Bracket{190363, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaNL2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 9536, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 7792, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0350, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2020 */
+
+var taxFormulaNL2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 37929},
+		0.1450: core.Bracket{37929, 75858},
+		0.1580: core.Bracket{75858, 135432},
+		0.1730: core.Bracket{135432, 189604},
+		0.1830: core.Bracket{189604, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaNL2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 9498, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 7761, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0350, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2019 */
+
+var taxFormulaNL2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 37591},
+		0.1450: core.Bracket{37591, 75181},
+		0.1580: core.Bracket{75181, 134224},
+		0.1730: core.Bracket{134224, 187913},
+		0.1830: core.Bracket{187913, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaNL2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 9498, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 7761, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0350, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionNL,
+}
+
+/* 2018 */
+
+var taxFormulaNL2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0870: core.Bracket{0, 36926},
+		0.1450: core.Bracket{36926, 73852},
+		0.1580: core.Bracket{73852, 131850},
+		0.1730: core.Bracket{131850, 184590},
+		0.1830: core.Bracket{184590, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNL,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaNL2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0870 * 9414, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 7692, Weight: 0.0870, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0870, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0540, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0350, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionNL,
+}
@@ -0,0 +1,267 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsNS = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaNS2025,
+		ContraFormula: taxContraFormulaNS2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaNS2024,
+		ContraFormula: taxContraFormulaNS2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaNS2023,
+		ContraFormula: taxContraFormulaNS2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaNS2022,
+		ContraFormula: taxContraFormulaNS2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaNS2021,
+		ContraFormula: taxContraFormulaNS2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaNS2020,
+		ContraFormula: taxContraFormulaNS2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaNS2019,
+		ContraFormula: taxContraFormulaNS2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaNS2018,
+		ContraFormula: taxContraFormulaNS2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaNS2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 30995},
+		0.1495: core.Bracket{30995, 61991},
+		0.1667: core.Bracket{61991, 97417},
+		0.1750: core.Bracket{97417, 157124},
+		0.2100: core.Bracket{157124, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaNS2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 11744, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11744, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2024 */
+
+var taxFormulaNS2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 30507},
+		0.1495: core.Bracket{30507, 61015},
+		0.1667: core.Bracket{61015, 95883},
+		0.1750: core.Bracket{95883, 154650},
+		0.2100: core.Bracket{154650, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaNS2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8744, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8744, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2023 */
+
+var taxFormulaNS2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaNS2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2022 */
+
+var taxFormulaNS2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaNS2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2021 */
+
+var taxFormulaNS2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaNS2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2020 */
+
+var taxFormulaNS2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaNS2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2019 */
+
+var taxFormulaNS2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaNS2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0299, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionNS,
+}
+
+/* 2018 */
+
+var taxFormulaNS2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0879: core.Bracket{0, 29590},
+		0.1495: core.Bracket{29590, 59180},
+		0.1667: core.Bracket{59180, 93000},
+		0.1750: core.Bracket{93000, 150000},
+		0.2100: core.Bracket{150000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNS,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaNS2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0879 * 8481, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8481, Weight: 0.0879, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0879, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0885, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0350, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionNS,
+}
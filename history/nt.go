@@ -0,0 +1,267 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsNT = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaNT2025,
+		ContraFormula: taxContraFormulaNT2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaNT2024,
+		ContraFormula: taxContraFormulaNT2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaNT2023,
+		ContraFormula: taxContraFormulaNT2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaNT2022,
+		ContraFormula: taxContraFormulaNT2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaNT2021,
+		ContraFormula: taxContraFormulaNT2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaNT2020,
+		ContraFormula: taxContraFormulaNT2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaNT2019,
+		ContraFormula: taxContraFormulaNT2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaNT2018,
+		ContraFormula: taxContraFormulaNT2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaNT2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 51964},
+		0.0860: core.Bracket{51964, 103930},
+		0.1220: core.Bracket{103930, 168967},
+		0.1405: core.Bracket{168967, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaNT2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 18198, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 18198, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2024 */
+
+var taxFormulaNT2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 50597},
+		0.0860: core.Bracket{50597, 101198},
+		0.1220: core.Bracket{101198, 164525},
+		0.1405: core.Bracket{164525, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaNT2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 17842, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 17842, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2023 */
+
+var taxFormulaNT2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 48326},
+		0.0860: core.Bracket{48326, 96655},
+		0.1220: core.Bracket{96655, 157139},
+		0.1405: core.Bracket{157139, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaNT2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 17373, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 17373, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2022 */
+
+var taxFormulaNT2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 45462},
+		0.0860: core.Bracket{45462, 90927},
+		0.1220: core.Bracket{90927, 147826},
+		0.1405: core.Bracket{147826, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaNT2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 16593, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16593, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2021 */
+
+var taxFormulaNT2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 44396},
+		0.0860: core.Bracket{44396, 88796},
+		0.1220: core.Bracket{88796, 144362},
+		0.1405: core.Bracket{144362, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaNT2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 15609, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15609, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2020 */
+
+var taxFormulaNT2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 43957},
+		0.0860: core.Bracket{43957, 87916},
+		0.1220: core.Bracket{87916, 142932},
+		0.1405: core.Bracket{142932, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaNT2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 15243, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15243, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2019 */
+
+var taxFormulaNT2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 43137},
+		0.0860: core.Bracket{43137, 86277},
+		0.1220: core.Bracket{86277, 140267},
+		0.1405: core.Bracket{140267, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaNT2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 15093, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15093, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionNT,
+}
+
+/* 2018 */
+
+var taxFormulaNT2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0590: core.Bracket{0, 42209},
+		0.0860: core.Bracket{42209, 84420},
+		0.1220: core.Bracket{84420, 137248},
+		0.1405: core.Bracket{137248, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNT,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaNT2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0590 * 14811, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 14811, Weight: 0.0590, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0590, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1150, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0600, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionNT,
+}
@@ -0,0 +1,267 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsNU = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaNU2025,
+		ContraFormula: taxContraFormulaNU2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaNU2024,
+		ContraFormula: taxContraFormulaNU2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaNU2023,
+		ContraFormula: taxContraFormulaNU2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaNU2022,
+		ContraFormula: taxContraFormulaNU2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaNU2021,
+		ContraFormula: taxContraFormulaNU2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaNU2020,
+		ContraFormula: taxContraFormulaNU2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaNU2019,
+		ContraFormula: taxContraFormulaNU2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaNU2018,
+		ContraFormula: taxContraFormulaNU2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaNU2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 54707},
+		0.0700: core.Bracket{54707, 109413},
+		0.0900: core.Bracket{109413, 177881},
+		0.1150: core.Bracket{177881, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaNU2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 19274, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19274, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2024 */
+
+var taxFormulaNU2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 53268},
+		0.0700: core.Bracket{53268, 106537},
+		0.0900: core.Bracket{106537, 173205},
+		0.1150: core.Bracket{173205, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaNU2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 18767, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 18767, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2023 */
+
+var taxFormulaNU2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 50877},
+		0.0700: core.Bracket{50877, 101754},
+		0.0900: core.Bracket{101754, 165429},
+		0.1150: core.Bracket{165429, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaNU2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 17925, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 17925, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2022 */
+
+var taxFormulaNU2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 47862},
+		0.0700: core.Bracket{47862, 95724},
+		0.0900: core.Bracket{95724, 155625},
+		0.1150: core.Bracket{155625, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaNU2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 16862, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16862, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2021 */
+
+var taxFormulaNU2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 46740},
+		0.0700: core.Bracket{46740, 93480},
+		0.0900: core.Bracket{93480, 151978},
+		0.1150: core.Bracket{151978, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaNU2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 16467, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16467, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2020 */
+
+var taxFormulaNU2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 46277},
+		0.0700: core.Bracket{46277, 92555},
+		0.0900: core.Bracket{92555, 150473},
+		0.1150: core.Bracket{150473, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaNU2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 16304, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16304, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2019 */
+
+var taxFormulaNU2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 45414},
+		0.0700: core.Bracket{45414, 90829},
+		0.0900: core.Bracket{90829, 147667},
+		0.1150: core.Bracket{147667, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaNU2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 13618, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 13618, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionNU,
+}
+
+/* 2018 */
+
+var taxFormulaNU2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0400: core.Bracket{0, 44437},
+		0.0700: core.Bracket{44437, 88874},
+		0.0900: core.Bracket{88874, 144488},
+		0.1150: core.Bracket{144488, math.Inf(1)},
+	},
+	TaxRegion: core.RegionNU,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaNU2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0400 * 12781, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 12781, Weight: 0.0400, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0400, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.0551, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0261, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionNU,
+}
@@ -0,0 +1,521 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsON = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:           taxFormulaON2025,
+		ContraFormula:     taxContraFormulaON2025,
+		AdjustmentFormula: taxAdjFormulaON2025,
+		IncomeRecipe:      incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:           taxFormulaON2024,
+		ContraFormula:     taxContraFormulaON2024,
+		AdjustmentFormula: taxAdjFormulaON2024,
+		IncomeRecipe:      incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:           taxFormulaON2023,
+		ContraFormula:     taxContraFormulaON2023,
+		AdjustmentFormula: taxAdjFormulaON2023,
+		IncomeRecipe:      incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:           taxFormulaON2022,
+		ContraFormula:     taxContraFormulaON2022,
+		AdjustmentFormula: taxAdjFormulaON2022,
+		IncomeRecipe:      incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:           taxFormulaON2021,
+		ContraFormula:     taxContraFormulaON2021,
+		AdjustmentFormula: taxAdjFormulaON2021,
+		IncomeRecipe:      incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:           taxFormulaON2020,
+		ContraFormula:     taxContraFormulaON2020,
+		AdjustmentFormula: taxAdjFormulaON2020,
+		IncomeRecipe:      incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:           taxFormulaON2019,
+		ContraFormula:     taxContraFormulaON2019,
+		AdjustmentFormula: taxAdjFormulaON2019,
+		IncomeRecipe:      incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:           taxFormulaON2018,
+		ContraFormula:     taxContraFormulaON2018,
+		AdjustmentFormula: taxAdjFormulaON2018,
+		IncomeRecipe:      incomeRecipeNetCA2018,
+	},
+}
+
+// adjHealthPremiumON is the Ontario Health Premium, which is payable on taxable
+// income over $20,000 up to a maximum of $900. Its tiers remain unchanged since
+// it was introduced
+var adjHealthPremiumON = tax.IncomePremiumAdjuster{
+	Tiers: []tax.PremiumTier{
+		{Rate: 0.06, Bracket: core.Bracket{20000, 25000}},
+		{Rate: 0.06, Bracket: core.Bracket{36000, 38500}},
+		{Rate: 0.25, Bracket: core.Bracket{48000, 48600}},
+		{Rate: 0.25, Bracket: core.Bracket{72000, 72600}},
+		{Rate: 0.25, Bracket: core.Bracket{200000, 200600}},
+	},
+	Desc: "Ontario health premium",
+}
+
+/* 2025 */
+
+var taxFormulaON2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 52886},
+		0.0915: core.Bracket{52886, 105775},
+		0.1116: core.Bracket{105775, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaON2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 12747, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10823, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2025 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{5710, math.Inf(1)},
+				0.36: core.Bracket{7307, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            294,
+			DependentAmount:       544,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionON,
+}
+
+/* 2024 */
+
+var taxFormulaON2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 51446},
+		0.0915: core.Bracket{51446, 102894},
+		0.1116: core.Bracket{102894, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaON2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 12399, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10528, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2024 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{5554, math.Inf(1)},
+				0.36: core.Bracket{7108, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            286,
+			DependentAmount:       529,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionON,
+}
+
+/* 2023 */
+
+var taxFormulaON2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 49231},
+		0.0915: core.Bracket{49231, 98463},
+		0.1116: core.Bracket{98463, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaON2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 11865, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10075, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2023 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{5315, math.Inf(1)},
+				0.36: core.Bracket{6802, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            274,
+			DependentAmount:       506,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionON,
+}
+
+/* 2022 */
+
+var taxFormulaON2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 46226},
+		0.0915: core.Bracket{46226, 92454},
+		0.1116: core.Bracket{92454, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaON2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 11141, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9460, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2022 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{4991, math.Inf(1)},
+				0.36: core.Bracket{6387, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            257,
+			DependentAmount:       475,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionON,
+}
+
+/* 2021 */
+
+var taxFormulaON2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 45142},
+		0.0915: core.Bracket{45142, 90287},
+		0.1116: core.Bracket{90287, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaON2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 10880, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9248, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2021 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{4874, math.Inf(1)},
+				0.36: core.Bracket{6237, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            247,
+			DependentAmount:       455,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionON,
+}
+
+/* 2020 */
+
+var taxFormulaON2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 44740},
+		0.0915: core.Bracket{44740, 89482},
+		0.1116: core.Bracket{89482, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaON2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 10783, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9156, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2020 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{4830, math.Inf(1)},
+				0.36: core.Bracket{6182, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            244,
+			DependentAmount:       451,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionON,
+}
+
+/* 2019 */
+
+var taxFormulaON2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 43906},
+		0.0915: core.Bracket{43906, 87813},
+		0.1116: core.Bracket{87813, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaON2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 10582, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8985, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2019 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{4740, math.Inf(1)},
+				0.36: core.Bracket{6067, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.15 * 0.029863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            239,
+			DependentAmount:       442,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionON,
+}
+
+/* 2018 */
+
+var taxFormulaON2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0505: core.Bracket{0, 42960},
+		0.0915: core.Bracket{42960, 85923},
+		0.1116: core.Bracket{85923, 150000},
+		0.1216: core.Bracket{150000, 220000},
+		0.1316: core.Bracket{220000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionON,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaON2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0505 * 10354, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8792, Weight: 0.0505, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0505, CreditDescriptor: crDescEIPremiums},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionON,
+}
+
+var taxAdjFormulaON2018 = &tax.CanadianAdjustmentFormula{
+	OrderedAdjusters: []tax.TaxAdjuster{
+		tax.SurtaxAdjuster{
+			Rates: core.WeightedBrackets{
+				0.20: core.Bracket{4638, math.Inf(1)},
+				0.36: core.Bracket{5936, math.Inf(1)},
+			},
+			Desc: "Ontario surtax",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcEligibleDividendsCA,
+			Weight: 1.38 * 0.10,
+			Desc:   "Ontario dividend tax credit for eligible dividends",
+		},
+		tax.SourceCreditAdjuster{
+			Source: core.IncSrcNonEligibleDividendsCA,
+			Weight: 1.16 * 0.032863,
+			Desc:   "Ontario dividend tax credit for non-eligible dividends",
+		},
+		tax.LowIncomeReductionAdjuster{
+			BaseAmount:            235,
+			DependentAmount:       434,
+			MaxDependentAgeMonths: (monthsInYear * 19) - 1,
+			Desc:                  "Ontario tax reduction",
+		},
+		adjHealthPremiumON,
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionON,
+}
@@ -3,6 +3,7 @@ package history
 import (
 	"github.com/malkhamis/quantax/core/benefits"
 	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/payroll"
 	"github.com/malkhamis/quantax/core/rrsp"
 	"github.com/malkhamis/quantax/core/tax"
 )
@@ -13,15 +14,79 @@ type TaxParams struct {
 	Formula       tax.Formula
 	ContraFormula tax.ContraFormula
 	IncomeRecipe  *income.Recipe
+	// AdjustmentFormula is nil for jurisdictions with no tax adjustments
+	AdjustmentFormula tax.AdjustmentFormula
+	// Abatements are the reductions the jurisdiction grants on the basic tax
+	// of other jurisdictions
+	Abatements []tax.Abatement
 }
 
 // Clone returns a copy of these parameters
 func (p TaxParams) Clone() TaxParams {
-	return TaxParams{
+
+	clone := TaxParams{
 		Formula:       p.Formula.Clone(),
 		ContraFormula: p.ContraFormula.Clone(),
 		IncomeRecipe:  p.IncomeRecipe.Clone(),
 	}
+
+	if p.AdjustmentFormula != nil {
+		clone.AdjustmentFormula = p.AdjustmentFormula.Clone()
+	}
+
+	if p.Abatements != nil {
+		clone.Abatements = make([]tax.Abatement, len(p.Abatements))
+		copy(clone.Abatements, p.Abatements)
+	}
+
+	return clone
+}
+
+// Kind returns KindTax
+func (p TaxParams) Kind() ParamsKind {
+	return KindTax
+}
+
+func (p TaxParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p TaxParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	err := p.Formula.Validate()
+	if err != nil {
+		return err
+	}
+
+	if p.ContraFormula == nil {
+		return errNilContraFormula
+	}
+
+	err = p.ContraFormula.Validate()
+	if err != nil {
+		return err
+	}
+
+	if p.IncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	for _, abatement := range p.Abatements {
+		err = abatement.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.AdjustmentFormula == nil {
+		return nil
+	}
+
+	return p.AdjustmentFormula.Validate()
 }
 
 // RRSPParams represents the RRSP parameters associated with a jurisdiction
@@ -37,6 +102,24 @@ func (p RRSPParams) Clone() RRSPParams {
 	}
 }
 
+// Kind returns KindRRSP
+func (p RRSPParams) Kind() ParamsKind {
+	return KindRRSP
+}
+
+func (p RRSPParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p RRSPParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	return p.Formula.Validate()
+}
+
 // CBParams represents the child benefit parameters associated with a
 // jurisdiction for a specific tax year
 type CBParams struct {
@@ -51,10 +134,241 @@ func (p CBParams) Clone() CBParams {
 	}
 }
 
+// Kind returns KindChildBenefit
+func (p CBParams) Kind() ParamsKind {
+	return KindChildBenefit
+}
+
+func (p CBParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p CBParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	if p.IncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	return p.Formula.Validate()
+}
+
+// PayrollParams represents the payroll contribution parameters associated with
+// a jurisdiction for a specific tax year
+type PayrollParams struct {
+	Formulas []payroll.Formula
+}
+
+// Clone returns a copy of these parameters
+func (p PayrollParams) Clone() PayrollParams {
+
+	var clone PayrollParams
+
+	if p.Formulas != nil {
+		clone.Formulas = make([]payroll.Formula, len(p.Formulas))
+		for i, formula := range p.Formulas {
+			clone.Formulas[i] = formula.Clone()
+		}
+	}
+
+	return clone
+}
+
+// Kind returns KindPayroll
+func (p PayrollParams) Kind() ParamsKind {
+	return KindPayroll
+}
+
+func (p PayrollParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p PayrollParams) validate() error {
+
+	if len(p.Formulas) == 0 {
+		return errNilFormula
+	}
+
+	for _, formula := range p.Formulas {
+
+		if formula == nil {
+			return errNilFormula
+		}
+
+		err := formula.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OASParams represents the Old Age Security parameters associated with a
+// jurisdiction for a specific tax year
+type OASParams struct {
+	Formula      benefits.PensionFormula
+	IncomeRecipe *income.Recipe
+}
+
+// Clone returns a copy of these parameters
+func (p OASParams) Clone() OASParams {
+	return OASParams{
+		Formula:      p.Formula.Clone(),
+		IncomeRecipe: p.IncomeRecipe.Clone(),
+	}
+}
+
+// Kind returns KindOAS
+func (p OASParams) Kind() ParamsKind {
+	return KindOAS
+}
+
+func (p OASParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p OASParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	if p.IncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	return p.Formula.Validate()
+}
+
+// GISParams represents the Guaranteed Income Supplement and Allowance
+// parameters associated with a jurisdiction for a specific tax year
+type GISParams struct {
+	Formula      benefits.SupplementFormula
+	IncomeRecipe *income.Recipe
+}
+
+// Clone returns a copy of these parameters
+func (p GISParams) Clone() GISParams {
+	return GISParams{
+		Formula:      p.Formula.Clone(),
+		IncomeRecipe: p.IncomeRecipe.Clone(),
+	}
+}
+
+// Kind returns KindGIS
+func (p GISParams) Kind() ParamsKind {
+	return KindGIS
+}
+
+func (p GISParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p GISParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	if p.IncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	return p.Formula.Validate()
+}
+
+// GSTCreditParams represents the GST/HST credit parameters associated with a
+// jurisdiction for a specific year
+type GSTCreditParams struct {
+	Formula      benefits.HouseholdBenefitFormula
+	IncomeRecipe *income.Recipe
+}
+
+// Clone returns a copy of these parameters
+func (p GSTCreditParams) Clone() GSTCreditParams {
+	return GSTCreditParams{
+		Formula:      p.Formula.Clone(),
+		IncomeRecipe: p.IncomeRecipe.Clone(),
+	}
+}
+
+// Kind returns KindGSTCredit
+func (p GSTCreditParams) Kind() ParamsKind {
+	return KindGSTCredit
+}
+
+func (p GSTCreditParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p GSTCreditParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	if p.IncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	return p.Formula.Validate()
+}
+
+// CWBParams represents the Canada Workers Benefit parameters associated with a
+// jurisdiction for a specific tax year
+type CWBParams struct {
+	Formula benefits.WorkersBenefitFormula
+	// IncomeRecipe is the recipe of the adjusted net income
+	IncomeRecipe *income.Recipe
+	// WorkingIncomeRecipe is the recipe of the working income, which is the
+	// total income it computes
+	WorkingIncomeRecipe *income.Recipe
+}
+
+// Clone returns a copy of these parameters
+func (p CWBParams) Clone() CWBParams {
+	return CWBParams{
+		Formula:             p.Formula.Clone(),
+		IncomeRecipe:        p.IncomeRecipe.Clone(),
+		WorkingIncomeRecipe: p.WorkingIncomeRecipe.Clone(),
+	}
+}
+
+// Kind returns KindCWB
+func (p CWBParams) Kind() ParamsKind {
+	return KindCWB
+}
+
+func (p CWBParams) cloneParams() Params {
+	return p.Clone()
+}
+
+func (p CWBParams) validate() error {
+
+	if p.Formula == nil {
+		return errNilFormula
+	}
+
+	if p.IncomeRecipe == nil || p.WorkingIncomeRecipe == nil {
+		return errNilIncomeRecipe
+	}
+
+	return p.Formula.Validate()
+}
+
 type (
-	yearlyTaxParams  = map[uint]TaxParams
-	yearlyCBParams   = map[uint]CBParams
-	yearlyRRSPParams = map[uint]RRSPParams
+	yearlyTaxParams     = map[uint]TaxParams
+	yearlyCBParams      = map[uint]CBParams
+	yearlyRRSPParams    = map[uint]RRSPParams
+	yearlyPayrollParams = map[uint]PayrollParams
+	yearlyOASParams     = map[uint]OASParams
+	yearlyGISParams     = map[uint]GISParams
+	yearlyGSTParams     = map[uint]GSTCreditParams
+	yearlyCWBParams     = map[uint]CWBParams
 )
 
 const monthsInYear = 12
@@ -0,0 +1,265 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsPE = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaPE2025,
+		ContraFormula: taxContraFormulaPE2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaPE2024,
+		ContraFormula: taxContraFormulaPE2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaPE2023,
+		ContraFormula: taxContraFormulaPE2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaPE2022,
+		ContraFormula: taxContraFormulaPE2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaPE2021,
+		ContraFormula: taxContraFormulaPE2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaPE2020,
+		ContraFormula: taxContraFormulaPE2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaPE2019,
+		ContraFormula: taxContraFormulaPE2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaPE2018,
+		ContraFormula: taxContraFormulaPE2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaPE2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0950: core.Bracket{0, 33328},
+		0.1347: core.Bracket{33328, 64656},
+		0.1660: core.Bracket{64656, 105000},
+		0.1762: core.Bracket{105000, 140000},
+		0.1900: core.Bracket{140000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaPE2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0950 * 14250, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 12103, Weight: 0.0950, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0950, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0950, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0950, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0130, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2024 */
+
+var taxFormulaPE2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0965: core.Bracket{0, 32656},
+		0.1363: core.Bracket{32656, 64313},
+		0.1665: core.Bracket{64313, 105000},
+		0.1800: core.Bracket{105000, 140000},
+		0.1875: core.Bracket{140000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaPE2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0965 * 13500, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11466, Weight: 0.0965, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0130, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2023 */
+
+var taxFormulaPE2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0965: core.Bracket{0, 32656},
+		0.1363: core.Bracket{32656, 64313},
+		0.1665: core.Bracket{64313, 105000},
+		0.1800: core.Bracket{105000, 140000},
+		0.1875: core.Bracket{140000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaPE2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0965 * 12750, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 10830, Weight: 0.0965, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0965, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0130, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2022 */
+
+var taxFormulaPE2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0980: core.Bracket{0, 31984},
+		0.1380: core.Bracket{31984, 63969},
+		0.1670: core.Bracket{63969, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaPE2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0980 * 11250, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9555, Weight: 0.0980, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0280, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2021 */
+
+var taxFormulaPE2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0980: core.Bracket{0, 31984},
+		0.1380: core.Bracket{31984, 63969},
+		0.1670: core.Bracket{63969, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaPE2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0980 * 11250, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 9555, Weight: 0.0980, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0280, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2020 */
+
+var taxFormulaPE2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0980: core.Bracket{0, 31984},
+		0.1380: core.Bracket{31984, 63969},
+		0.1670: core.Bracket{63969, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaPE2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0980 * 10500, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8918, Weight: 0.0980, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0280, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2019 */
+
+var taxFormulaPE2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0980: core.Bracket{0, 31984},
+		0.1380: core.Bracket{31984, 63969},
+		0.1670: core.Bracket{63969, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaPE2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0980 * 10000, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 8493, Weight: 0.0980, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0280, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionPE,
+}
+
+/* 2018 */
+
+var taxFormulaPE2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0980: core.Bracket{0, 31984},
+		0.1380: core.Bracket{31984, 63969},
+		0.1670: core.Bracket{63969, math.Inf(1)},
+	},
+	TaxRegion: core.RegionPE,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaPE2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0980 * 9160, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 7780, Weight: 0.0980, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0980, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1050, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0310, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionPE,
+}
@@ -0,0 +1,535 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/payroll"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var (
+	crDescLivingAloneQC = tax.CreditDescriptor{
+		CreditDescription:     "credits for a person living alone",
+		TargetFinancialSource: core.SrcNone,
+		CreditRule: core.CreditRule{
+			CrSource: "living-alone-amount",
+			Type:     core.CrRuleTypeNotCarryForward,
+		},
+	}
+
+	// abatementFederalQC is the refundable Quebec abatement, which reduces the
+	// basic federal tax of Quebec residents
+	abatementFederalQC = tax.Abatement{
+		TargetRegion: core.RegionCA,
+		Rate:         0.165,
+		Desc:         "refundable Quebec abatement",
+	}
+)
+
+var taxParamsQC = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaQC2025,
+		ContraFormula: taxContraFormulaQC2025,
+		IncomeRecipe:  incomeRecipeNetQC2025,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaQC2024,
+		ContraFormula: taxContraFormulaQC2024,
+		IncomeRecipe:  incomeRecipeNetQC2024,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaQC2023,
+		ContraFormula: taxContraFormulaQC2023,
+		IncomeRecipe:  incomeRecipeNetQC2023,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaQC2022,
+		ContraFormula: taxContraFormulaQC2022,
+		IncomeRecipe:  incomeRecipeNetQC2022,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaQC2021,
+		ContraFormula: taxContraFormulaQC2021,
+		IncomeRecipe:  incomeRecipeNetQC2021,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaQC2020,
+		ContraFormula: taxContraFormulaQC2020,
+		IncomeRecipe:  incomeRecipeNetQC2020,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaQC2019,
+		ContraFormula: taxContraFormulaQC2019,
+		IncomeRecipe:  incomeRecipeNetQC2019,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaQC2018,
+		ContraFormula: taxContraFormulaQC2018,
+		IncomeRecipe:  incomeRecipeNetQC2018,
+		Abatements:    []tax.Abatement{abatementFederalQC},
+	},
+}
+
+var payrollParamsQC = yearlyPayrollParams{
+	2025: PayrollParams{[]payroll.Formula{qppFormula2025, eiFormulaQC2025, qpipFormula2025}},
+	2024: PayrollParams{[]payroll.Formula{qppFormula2024, eiFormulaQC2024, qpipFormula2024}},
+	2023: PayrollParams{[]payroll.Formula{qppFormula2023, eiFormulaQC2023, qpipFormula2023}},
+	2022: PayrollParams{[]payroll.Formula{qppFormula2022, eiFormulaQC2022, qpipFormula2022}},
+	2021: PayrollParams{[]payroll.Formula{qppFormula2021, eiFormulaQC2021, qpipFormula2021}},
+	2020: PayrollParams{[]payroll.Formula{qppFormula2020, eiFormulaQC2020, qpipFormula2020}},
+	2019: PayrollParams{[]payroll.Formula{qppFormula2019, eiFormulaQC2019, qpipFormula2019}},
+	2018: PayrollParams{[]payroll.Formula{qppFormula2018, eiFormulaQC2018, qpipFormula2018}},
+}
+
+/* 2025 */
+
+var taxFormulaQC2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1400: core.Bracket{0, 53255},
+		0.1900: core.Bracket{53255, 106495},
+		0.2400: core.Bracket{106495, 129590},
+		0.2575: core.Bracket{129590, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaQC2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.14 * 18571, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         2128,
+			ReductionThreshold: 42090,
+			ReductionRate:      0.1875,
+			Weight:             0.14,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0342, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2024 */
+
+var taxFormulaQC2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1400: core.Bracket{0, 51780},
+		0.1900: core.Bracket{51780, 103545},
+		0.2400: core.Bracket{103545, 126000},
+		0.2575: core.Bracket{126000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaQC2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.14 * 18056, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         2069,
+			ReductionThreshold: 40925,
+			ReductionRate:      0.1875,
+			Weight:             0.14,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0342, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2023 */
+
+var taxFormulaQC2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1400: core.Bracket{0, 49275},
+		0.1900: core.Bracket{49275, 98540},
+		0.2400: core.Bracket{98540, 119910},
+		0.2575: core.Bracket{119910, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaQC2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.14 * 17183, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1970,
+			ReductionThreshold: 38385,
+			ReductionRate:      0.1875,
+			Weight:             0.14,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0342, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2022 */
+
+var taxFormulaQC2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1500: core.Bracket{0, 46295},
+		0.2000: core.Bracket{46295, 92580},
+		0.2400: core.Bracket{92580, 112655},
+		0.2575: core.Bracket{112655, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaQC2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.15 * 16143, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1851,
+			ReductionThreshold: 36075,
+			ReductionRate:      0.1875,
+			Weight:             0.15,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0342, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2021 */
+
+var taxFormulaQC2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1500: core.Bracket{0, 45105},
+		0.2000: core.Bracket{45105, 90200},
+		0.2400: core.Bracket{90200, 109755},
+		0.2575: core.Bracket{109755, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaQC2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.15 * 15728, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1793,
+			ReductionThreshold: 35085,
+			ReductionRate:      0.1875,
+			Weight:             0.15,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0477, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2020 */
+
+var taxFormulaQC2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1500: core.Bracket{0, 44545},
+		0.2000: core.Bracket{44545, 89080},
+		0.2400: core.Bracket{89080, 108390},
+		0.2575: core.Bracket{108390, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaQC2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.15 * 15532, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1780,
+			ReductionThreshold: 34640,
+			ReductionRate:      0.1875,
+			Weight:             0.15,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1170, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0555, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2019 */
+
+var taxFormulaQC2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1500: core.Bracket{0, 43790},
+		0.2000: core.Bracket{43790, 87575},
+		0.2400: core.Bracket{87575, 106555},
+		0.2575: core.Bracket{106555, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaQC2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.15 * 15269, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1750,
+			ReductionThreshold: 34030,
+			ReductionRate:      0.1875,
+			Weight:             0.15,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1178, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0628, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionQC,
+}
+
+/* 2018 */
+
+var taxFormulaQC2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1500: core.Bracket{0, 43055},
+		0.2000: core.Bracket{43055, 86105},
+		0.2400: core.Bracket{86105, 104765},
+		0.2575: core.Bracket{104765, math.Inf(1)},
+	},
+	TaxRegion: core.RegionQC,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaQC2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.15 * 15012, CreditDescriptor: crDescPersonalAmount},
+		tax.LivingAloneCreditor{
+			BaseAmount:         1721,
+			ReductionThreshold: 33505,
+			ReductionRate:      0.1875,
+			Weight:             0.15,
+			CreditDescriptor:   crDescLivingAloneQC,
+		},
+		tax.WeightedCreditor{Weight: 0.08, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1186, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0705, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionQC,
+}
+
+/* payroll */
+
+var qppFormula2025 = &payroll.PensionPlanFormula{
+	BasicExemption:                   3500,
+	MaxPensionableEarnings:           71300,
+	AdditionalMaxPensionableEarnings: 81200,
+	BaseRate:                         0.0540,
+	FirstAdditionalRate:              0.0100,
+	SecondAdditionalRate:             0.0400,
+	IncomeSources:                    []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:           core.MiscSrcCPPBase,
+	AdditionalContributionSource:     core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2025 = &payroll.InsuranceFormula{
+	Rate:                 0.0131,
+	MaxInsurableEarnings: 65700,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2025 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 98000,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2024 = &payroll.PensionPlanFormula{
+	BasicExemption:                   3500,
+	MaxPensionableEarnings:           68500,
+	AdditionalMaxPensionableEarnings: 73200,
+	BaseRate:                         0.0540,
+	FirstAdditionalRate:              0.0100,
+	SecondAdditionalRate:             0.0400,
+	IncomeSources:                    []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:           core.MiscSrcCPPBase,
+	AdditionalContributionSource:     core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2024 = &payroll.InsuranceFormula{
+	Rate:                 0.0132,
+	MaxInsurableEarnings: 63200,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2024 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 94000,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2023 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       66600,
+	BaseRate:                     0.0540,
+	FirstAdditionalRate:          0.0100,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2023 = &payroll.InsuranceFormula{
+	Rate:                 0.0127,
+	MaxInsurableEarnings: 61500,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2023 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 91000,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2022 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       64900,
+	BaseRate:                     0.0540,
+	FirstAdditionalRate:          0.0075,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2022 = &payroll.InsuranceFormula{
+	Rate:                 0.0120,
+	MaxInsurableEarnings: 60300,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2022 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 88000,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2021 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       61600,
+	BaseRate:                     0.0540,
+	FirstAdditionalRate:          0.0050,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2021 = &payroll.InsuranceFormula{
+	Rate:                 0.0118,
+	MaxInsurableEarnings: 56300,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2021 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 83500,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2020 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       58700,
+	BaseRate:                     0.0540,
+	FirstAdditionalRate:          0.0030,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2020 = &payroll.InsuranceFormula{
+	Rate:                 0.0120,
+	MaxInsurableEarnings: 54200,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2020 = &payroll.InsuranceFormula{
+	Rate:                 0.00494,
+	MaxInsurableEarnings: 78500,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2019 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       57400,
+	BaseRate:                     0.0540,
+	FirstAdditionalRate:          0.0015,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2019 = &payroll.InsuranceFormula{
+	Rate:                 0.0125,
+	MaxInsurableEarnings: 53100,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2019 = &payroll.InsuranceFormula{
+	Rate:                 0.00526,
+	MaxInsurableEarnings: 76500,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
+
+var qppFormula2018 = &payroll.PensionPlanFormula{
+	BasicExemption:               3500,
+	MaxPensionableEarnings:       55900,
+	BaseRate:                     0.0540,
+	IncomeSources:                []core.FinancialSource{core.IncSrcEarned},
+	BaseContributionSource:       core.MiscSrcCPPBase,
+	AdditionalContributionSource: core.DeducSrcCPPEnhanced,
+}
+
+var eiFormulaQC2018 = &payroll.InsuranceFormula{
+	Rate:                 0.0130,
+	MaxInsurableEarnings: 51700,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcEIPremiums,
+}
+
+var qpipFormula2018 = &payroll.InsuranceFormula{
+	Rate:                 0.00548,
+	MaxInsurableEarnings: 74000,
+	IncomeSources:        []core.FinancialSource{core.IncSrcEarned},
+	PremiumSource:        core.MiscSrcQPIPPremiums,
+}
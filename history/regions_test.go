@@ -0,0 +1,123 @@
+package history
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/finance"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+// TestProvincialTaxParams_SampleCalculations checks the payable provincial tax
+// of a single tax payer with employment income only against the lines of each
+// region's 428 form for the year, i.e. the tax on taxable income from the rate
+// table less the basic personal amount at the lowest rate. The rates, bracket
+// thresholds and basic personal amounts are the ones printed on the forms
+func TestProvincialTaxParams_SampleCalculations(t *testing.T) {
+
+	cases := []struct {
+		region   core.Region
+		year     uint
+		earned   float64
+		expected float64
+	}{
+		// AB428 2019
+		{region: core.RegionAB, year: 2019, earned: 50000, expected: 0.1*50000 - 0.1*19369},
+		{region: core.RegionAB, year: 2019, earned: 150000, expected: 0.1*131220 + 0.12*(150000-131220) - 0.1*19369},
+		// AB428 2022
+		{region: core.RegionAB, year: 2022, earned: 50000, expected: 0.1*50000 - 0.1*19814},
+		{region: core.RegionAB, year: 2022, earned: 150000, expected: 0.1*134238 + 0.12*(150000-134238) - 0.1*19814},
+		// SK428 2019
+		{region: core.RegionSK, year: 2019, earned: 50000, expected: 0.105*45225 + 0.125*(50000-45225) - 0.105*16065},
+		{region: core.RegionSK, year: 2019, earned: 150000, expected: 0.105*45225 + 0.125*(129214-45225) + 0.145*(150000-129214) - 0.105*16065},
+		// SK428 2022
+		{region: core.RegionSK, year: 2022, earned: 50000, expected: 0.105*46773 + 0.125*(50000-46773) - 0.105*16615},
+		{region: core.RegionSK, year: 2022, earned: 150000, expected: 0.105*46773 + 0.125*(133638-46773) + 0.145*(150000-133638) - 0.105*16615},
+		// MB428 2019
+		{region: core.RegionMB, year: 2019, earned: 50000, expected: 0.108*32670 + 0.1275*(50000-32670) - 0.108*9626},
+		{region: core.RegionMB, year: 2019, earned: 150000, expected: 0.108*32670 + 0.1275*(70610-32670) + 0.174*(150000-70610) - 0.108*9626},
+		// MB428 2022
+		{region: core.RegionMB, year: 2022, earned: 50000, expected: 0.108*34431 + 0.1275*(50000-34431) - 0.108*10145},
+		{region: core.RegionMB, year: 2022, earned: 150000, expected: 0.108*34431 + 0.1275*(74416-34431) + 0.174*(150000-74416) - 0.108*10145},
+		// NB428 2019
+		{region: core.RegionNB, year: 2019, earned: 50000, expected: 0.0968*42592 + 0.1482*(50000-42592) - 0.0968*10264},
+		{region: core.RegionNB, year: 2019, earned: 150000, expected: 0.0968*42592 + 0.1482*(85184-42592) + 0.1652*(138491-85184) + 0.1784*(150000-138491) - 0.0968*10264},
+		// NB428 2022
+		{region: core.RegionNB, year: 2022, earned: 50000, expected: 0.0968*44887 + 0.1482*(50000-44887) - 0.0968*11720},
+		{region: core.RegionNB, year: 2022, earned: 150000, expected: 0.0968*44887 + 0.1482*(89775-44887) + 0.1652*(145955-89775) + 0.1784*(150000-145955) - 0.0968*11720},
+		// NS428 2019
+		{region: core.RegionNS, year: 2019, earned: 50000, expected: 0.0879*29590 + 0.1495*(50000-29590) - 0.0879*8481},
+		{region: core.RegionNS, year: 2019, earned: 150000, expected: 0.0879*29590 + 0.1495*(59180-29590) + 0.1667*(93000-59180) + 0.175*(150000-93000) - 0.0879*8481},
+		// NS428 2022
+		{region: core.RegionNS, year: 2022, earned: 50000, expected: 0.0879*29590 + 0.1495*(50000-29590) - 0.0879*8481},
+		{region: core.RegionNS, year: 2022, earned: 150000, expected: 0.0879*29590 + 0.1495*(59180-29590) + 0.1667*(93000-59180) + 0.175*(150000-93000) - 0.0879*8481},
+		// PE428 2019
+		{region: core.RegionPE, year: 2019, earned: 50000, expected: 0.098*31984 + 0.138*(50000-31984) - 0.098*10000},
+		{region: core.RegionPE, year: 2019, earned: 150000, expected: 0.098*31984 + 0.138*(63969-31984) + 0.167*(150000-63969) - 0.098*10000},
+		// PE428 2022
+		{region: core.RegionPE, year: 2022, earned: 50000, expected: 0.098*31984 + 0.138*(50000-31984) - 0.098*11250},
+		{region: core.RegionPE, year: 2022, earned: 150000, expected: 0.098*31984 + 0.138*(63969-31984) + 0.167*(150000-63969) - 0.098*11250},
+		// NL428 2019
+		{region: core.RegionNL, year: 2019, earned: 50000, expected: 0.087*37591 + 0.145*(50000-37591) - 0.087*9498},
+		{region: core.RegionNL, year: 2019, earned: 150000, expected: 0.087*37591 + 0.145*(75181-37591) + 0.158*(134224-75181) + 0.173*(150000-134224) - 0.087*9498},
+		// NL428 2022
+		{region: core.RegionNL, year: 2022, earned: 50000, expected: 0.087*39147 + 0.145*(50000-39147) - 0.087*9803},
+		{region: core.RegionNL, year: 2022, earned: 150000, expected: 0.087*39147 + 0.145*(78294-39147) + 0.158*(139780-78294) + 0.178*(150000-139780) - 0.087*9803},
+		// YT428 2019
+		{region: core.RegionYT, year: 2019, earned: 50000, expected: 0.064*47630 + 0.09*(50000-47630) - 0.064*12069},
+		{region: core.RegionYT, year: 2019, earned: 150000, expected: 0.064*47630 + 0.09*(95259-47630) + 0.109*(147667-95259) + 0.128*(150000-147667) - 0.064*12069},
+		// YT428 2022
+		{region: core.RegionYT, year: 2022, earned: 50000, expected: 0.064*50000 - 0.064*14398},
+		{region: core.RegionYT, year: 2022, earned: 150000, expected: 0.064*50197 + 0.09*(100392-50197) + 0.109*(150000-100392) - 0.064*14398},
+		// NT428 2019
+		{region: core.RegionNT, year: 2019, earned: 50000, expected: 0.059*43137 + 0.086*(50000-43137) - 0.059*15093},
+		{region: core.RegionNT, year: 2019, earned: 150000, expected: 0.059*43137 + 0.086*(86277-43137) + 0.122*(140267-86277) + 0.1405*(150000-140267) - 0.059*15093},
+		// NT428 2022
+		{region: core.RegionNT, year: 2022, earned: 50000, expected: 0.059*45462 + 0.086*(50000-45462) - 0.059*16593},
+		{region: core.RegionNT, year: 2022, earned: 150000, expected: 0.059*45462 + 0.086*(90927-45462) + 0.122*(147826-90927) + 0.1405*(150000-147826) - 0.059*16593},
+		// NU428 2019
+		{region: core.RegionNU, year: 2019, earned: 50000, expected: 0.04*45414 + 0.07*(50000-45414) - 0.04*13618},
+		{region: core.RegionNU, year: 2019, earned: 150000, expected: 0.04*45414 + 0.07*(90829-45414) + 0.09*(147667-90829) + 0.115*(150000-147667) - 0.04*13618},
+		// NU428 2022
+		{region: core.RegionNU, year: 2022, earned: 50000, expected: 0.04*47862 + 0.07*(50000-47862) - 0.04*16862},
+		{region: core.RegionNU, year: 2022, earned: 150000, expected: 0.04*47862 + 0.07*(95724-47862) + 0.09*(150000-95724) - 0.04*16862},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%s-%d-%.0f", c.region, c.year, c.earned), func(t *testing.T) {
+
+			params, err := GetTaxParams(c.year, c.region)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			incomeCalc, err := income.NewCalculator(params.IncomeRecipe)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			calc, err := tax.NewCalculator(tax.CalcConfig{
+				IncomeCalc:        incomeCalc,
+				TaxFormula:        params.Formula,
+				ContraTaxFormula:  params.ContraFormula,
+				AdjustmentFormula: params.AdjustmentFormula,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			finances := finance.NewIndividualFinances()
+			finances.SetAmount(core.IncSrcEarned, c.earned)
+			calc.SetFinances(finance.NewHouseholdFinances(finances, nil), nil)
+
+			actual, _, _ := calc.TaxPayable()
+			if math.Abs(actual-c.expected) > 1e-6 {
+				t.Errorf("unexpected payable tax\nwant: %.2f\n got: %.2f", c.expected, actual)
+			}
+		})
+	}
+}
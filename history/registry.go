@@ -0,0 +1,339 @@
+package history
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// ParamsKind identifies the kind of params held in a registry
+type ParamsKind int
+
+const (
+	// unknown/uninitialized
+	_ ParamsKind = iota
+	// KindTax identifies TaxParams
+	KindTax
+	// KindChildBenefit identifies CBParams
+	KindChildBenefit
+	// KindRRSP identifies RRSPParams
+	KindRRSP
+	// KindPayroll identifies PayrollParams
+	KindPayroll
+	// KindOAS identifies OASParams
+	KindOAS
+	// KindGIS identifies GISParams
+	KindGIS
+	// KindGSTCredit identifies GSTCreditParams
+	KindGSTCredit
+	// KindCWB identifies CWBParams
+	KindCWB
+	// the end of the kinds, which must remain last
+	paramsKindsEnd
+)
+
+// paramsKindNames holds the stable names of the kinds of params, which are
+// used when kinds are encoded as text. Names must never change once added
+var paramsKindNames = map[ParamsKind]string{
+	KindTax:          "tax",
+	KindChildBenefit: "child_benefits",
+	KindRRSP:         "rrsp",
+	KindPayroll:      "payroll",
+	KindOAS:          "oas",
+	KindGIS:          "gis",
+	KindGSTCredit:    "gst_credit",
+	KindCWB:          "cwb",
+}
+
+// paramsKindsByName is the reverse of paramsKindNames
+var paramsKindsByName = make(map[string]ParamsKind)
+
+func init() {
+	for kind, name := range paramsKindNames {
+		paramsKindsByName[name] = kind
+	}
+}
+
+// ParamsKinds returns all kinds of params in the order they are declared
+func ParamsKinds() []ParamsKind {
+
+	var kinds []ParamsKind
+	for kind := KindTax; kind < paramsKindsEnd; kind++ {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+// String returns the name of this kind. Kinds without a name are formatted as
+// ParamsKind(n)
+func (k ParamsKind) String() string {
+
+	name, ok := paramsKindNames[k]
+	if !ok {
+		return fmt.Sprintf("ParamsKind(%d)", int(k))
+	}
+	return name
+}
+
+// MarshalText returns the name of this kind. If this kind has no name, it
+// returns ErrInvalidParams
+func (k ParamsKind) MarshalText() ([]byte, error) {
+
+	name, ok := paramsKindNames[k]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidParams, "ParamsKind(%d)", int(k))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText sets this kind to the kind with the given name. If no kind has
+// the given name, it returns ErrInvalidParams
+func (k *ParamsKind) UnmarshalText(text []byte) error {
+
+	kind, ok := paramsKindsByName[string(text)]
+	if !ok {
+		return errors.Wrapf(ErrInvalidParams, "unknown params kind %q", text)
+	}
+	*k = kind
+	return nil
+}
+
+// Params is the set of parameters associated with a jurisdiction for a
+// specific year. It is implemented by TaxParams, CBParams, RRSPParams,
+// PayrollParams, OASParams, GISParams, GSTCreditParams, and CWBParams
+type Params interface {
+	// Kind returns the kind of these params
+	Kind() ParamsKind
+	// validate ensures that these params are valid for use
+	validate() error
+	// cloneParams returns a copy of these params
+	cloneParams() Params
+}
+
+// Registry holds params by kind, region, and year. Params are copied when
+// registered and when looked up, so changes to them do not affect the params
+// held in the registry. It is safe for concurrent use
+type Registry struct {
+	mu     sync.RWMutex
+	params map[ParamsKind]map[core.Region]map[uint]Params
+}
+
+// NewRegistry returns a new empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		params: make(map[ParamsKind]map[core.Region]map[uint]Params),
+	}
+}
+
+// defaultRegistry holds the built-in params and is populated at init
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the registry holding the built-in params, which is
+// used by the package-level functions of this package. Params registered in
+// it are visible to all of its users. To run custom scenarios side by side,
+// register them in a clone of the default registry instead
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register validates the given params and registers them for the given year
+// and region, replacing any params of the same kind that were registered for
+// them. Tax params must have formulas for the given year and region
+func (r *Registry) Register(year uint, region core.Region, params Params) error {
+
+	if params == nil {
+		return errors.Wrap(ErrInvalidParams, "nil params")
+	}
+
+	err := params.validate()
+	if err != nil {
+		return errors.Wrapf(err, "%s[%d]", region, year)
+	}
+
+	if taxParams, ok := params.(TaxParams); ok {
+		formula := taxParams.Formula
+		if formula.Year() != year || formula.Region() != region {
+			return errors.Wrapf(
+				ErrInvalidParams, "%s[%d]: tax formula is for %s[%d]",
+				region, year, formula.Region(), formula.Year(),
+			)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.set(year, region, params.cloneParams())
+	return nil
+}
+
+// Lookup returns a copy of the params of the given kind for the given year
+// and region
+func (r *Registry) Lookup(kind ParamsKind, year uint, region core.Region) (Params, error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	regionParams, ok := r.params[kind][region]
+	if !ok {
+		return nil, ErrRegionNotExist
+	}
+
+	params, ok := regionParams[year]
+	if !ok {
+		return nil, ErrParamsNotExist
+	}
+
+	return params.cloneParams(), nil
+}
+
+// Years returns the years, in ascending order, for which params of the given
+// kind are registered for the given region
+func (r *Registry) Years(kind ParamsKind, region core.Region) []uint {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var years []uint
+	for year := range r.params[kind][region] {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	return years
+}
+
+// Regions returns the regions, sorted by name, for which params of the given
+// kind are registered
+func (r *Registry) Regions(kind ParamsKind) []core.Region {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var regions []core.Region
+	for region := range r.params[kind] {
+		regions = append(regions, region)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i] < regions[j] })
+
+	return regions
+}
+
+// Clone returns a new registry that holds the same params as this registry
+func (r *Registry) Clone() *Registry {
+
+	clone := NewRegistry()
+	clone.merge(r)
+	return clone
+}
+
+// TaxParams returns a copy of the tax params for the given year and region
+func (r *Registry) TaxParams(year uint, region core.Region) (TaxParams, error) {
+	params, err := r.lookupOrZero(TaxParams{}, year, region)
+	return params.(TaxParams), err
+}
+
+// ChildBenefitParams returns a copy of the child benefit params for the given
+// year and region
+func (r *Registry) ChildBenefitParams(year uint, region core.Region) (CBParams, error) {
+	params, err := r.lookupOrZero(CBParams{}, year, region)
+	return params.(CBParams), err
+}
+
+// RRSPParams returns a copy of the RRSP params for the given year and region
+func (r *Registry) RRSPParams(year uint, region core.Region) (RRSPParams, error) {
+	params, err := r.lookupOrZero(RRSPParams{}, year, region)
+	return params.(RRSPParams), err
+}
+
+// PayrollParams returns a copy of the payroll params for the given year and
+// region
+func (r *Registry) PayrollParams(year uint, region core.Region) (PayrollParams, error) {
+	params, err := r.lookupOrZero(PayrollParams{}, year, region)
+	return params.(PayrollParams), err
+}
+
+// OASParams returns a copy of the Old Age Security params for the given year
+// and region
+func (r *Registry) OASParams(year uint, region core.Region) (OASParams, error) {
+	params, err := r.lookupOrZero(OASParams{}, year, region)
+	return params.(OASParams), err
+}
+
+// GISParams returns a copy of the Guaranteed Income Supplement params for the
+// given year and region
+func (r *Registry) GISParams(year uint, region core.Region) (GISParams, error) {
+	params, err := r.lookupOrZero(GISParams{}, year, region)
+	return params.(GISParams), err
+}
+
+// GSTCreditParams returns a copy of the GST/HST credit params for the given
+// year and region
+func (r *Registry) GSTCreditParams(year uint, region core.Region) (GSTCreditParams, error) {
+	params, err := r.lookupOrZero(GSTCreditParams{}, year, region)
+	return params.(GSTCreditParams), err
+}
+
+// CWBParams returns a copy of the Canada Workers Benefit params for the given
+// year and region
+func (r *Registry) CWBParams(year uint, region core.Region) (CWBParams, error) {
+	params, err := r.lookupOrZero(CWBParams{}, year, region)
+	return params.(CWBParams), err
+}
+
+// lookupOrZero is like Lookup for the kind of the given zero params, except
+// that the zero params are returned with the error if no params are found
+func (r *Registry) lookupOrZero(zero Params, year uint, region core.Region) (Params, error) {
+
+	params, err := r.Lookup(zero.Kind(), year, region)
+	if err != nil {
+		return zero, err
+	}
+	return params, nil
+}
+
+// merge copies all params in other into this registry, replacing the params
+// of the same kind, region, and year
+func (r *Registry) merge(other *Registry) {
+
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, regionParams := range other.params {
+		for region, yearlyParams := range regionParams {
+			for year, params := range yearlyParams {
+				r.set(year, region, params)
+			}
+		}
+	}
+}
+
+// has returns true if params of the given kind are registered for the given
+// year and region
+func (r *Registry) has(kind ParamsKind, year uint, region core.Region) bool {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.params[kind][region][year]
+	return ok
+}
+
+// set stores the given params without copying them. Callers must hold the lock
+func (r *Registry) set(year uint, region core.Region, params Params) {
+
+	kind := params.Kind()
+	if r.params[kind] == nil {
+		r.params[kind] = make(map[core.Region]map[uint]Params)
+	}
+	if r.params[kind][region] == nil {
+		r.params[kind][region] = make(map[uint]Params)
+	}
+	r.params[kind][region][year] = params
+}
@@ -0,0 +1,295 @@
+package history
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/pkg/errors"
+)
+
+func TestRegistry_Register_Lookup(t *testing.T) {
+
+	registry := NewRegistry()
+
+	formula := &rrsp.MaxCapper{Rate: 0.18, Cap: 1000}
+	err := registry.Register(2099, testRegion, RRSPParams{formula})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formula.Cap = 2000 // should not affect the registered params
+
+	params, err := registry.Lookup(KindRRSP, 2099, testRegion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rrspParams, ok := params.(RRSPParams)
+	if !ok {
+		t.Fatalf("unexpected type\nwant: %T\n got: %T", RRSPParams{}, params)
+	}
+
+	actual := rrspParams.Formula.ContributionEarned(math.MaxFloat64)
+	if actual != 1000 {
+		t.Errorf("unexpected contribution\nwant: %d\n got: %.0f", 1000, actual)
+	}
+
+	_, err = registry.Lookup(KindTax, 2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.Lookup(KindRRSP, 2098, testRegion)
+	if errors.Cause(err) != ErrParamsNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrParamsNotExist, err)
+	}
+}
+
+func TestRegistry_Register_Errors(t *testing.T) {
+
+	registry := NewRegistry()
+
+	err := registry.Register(2099, testRegion, nil)
+	if errors.Cause(err) != ErrInvalidParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidParams, err)
+	}
+
+	err = registry.Register(2099, testRegion, RRSPParams{})
+	if errors.Cause(err) != errNilFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilFormula, err)
+	}
+
+	err = registry.Register(2099, testRegion, TaxParams{
+		Formula:       &tax.CanadianFormula{TaxYear: 2099, TaxRegion: testRegion},
+		ContraFormula: &tax.CanadianContraFormula{},
+	})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, CBParams{Formula: &benefits.CCBMaxReducer{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, OASParams{Formula: &benefits.OASFormula{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, GISParams{Formula: &benefits.GISFormula{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, GSTCreditParams{Formula: &benefits.GSTCreditFormula{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, CWBParams{Formula: &benefits.CWBFormula{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, CWBParams{Formula: &benefits.CWBFormula{}, IncomeRecipe: &income.Recipe{}})
+	if errors.Cause(err) != errNilIncomeRecipe {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilIncomeRecipe, err)
+	}
+
+	err = registry.Register(2099, testRegion, TaxParams{
+		Formula:       &tax.CanadianFormula{TaxYear: 2098, TaxRegion: testRegion},
+		ContraFormula: &tax.CanadianContraFormula{},
+		IncomeRecipe:  &income.Recipe{},
+	})
+	if errors.Cause(err) != ErrInvalidParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidParams, err)
+	}
+
+	if len(registry.Regions(KindTax)) != 0 || len(registry.Regions(KindChildBenefit)) != 0 || len(registry.Regions(KindRRSP)) != 0 {
+		t.Error("expected invalid params not to be registered")
+	}
+}
+
+func TestRegistry_Years_Regions(t *testing.T) {
+
+	registry := NewRegistry()
+
+	for _, year := range []uint{2020, 2018, 2019} {
+		err := registry.Register(year, testRegion, RRSPParams{&rrsp.MaxCapper{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := registry.Register(2018, core.RegionBC, RRSPParams{&rrsp.MaxCapper{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	years := registry.Years(KindRRSP, testRegion)
+	if !reflect.DeepEqual(years, []uint{2018, 2019, 2020}) {
+		t.Errorf("unexpected years\nwant: %v\n got: %v", []uint{2018, 2019, 2020}, years)
+	}
+
+	regions := registry.Regions(KindRRSP)
+	expected := []core.Region{core.RegionBC, testRegion}
+	if !reflect.DeepEqual(regions, expected) {
+		t.Errorf("unexpected regions\nwant: %v\n got: %v", expected, regions)
+	}
+
+	if registry.Years(KindTax, testRegion) != nil {
+		t.Error("expected no years for unregistered kind")
+	}
+}
+
+func TestRegistry_Clone(t *testing.T) {
+
+	clone := DefaultRegistry().Clone()
+
+	err := clone.Register(2099, testRegion, RRSPParams{&rrsp.MaxCapper{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clone.RRSPParams(2018, core.RegionCA)
+	if err != nil {
+		t.Errorf("expected clone to hold the params of the original: %v", err)
+	}
+
+	_, err = GetRRSPParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("expected changes to clone not to affect the original: %v", err)
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+
+	registry := DefaultRegistry()
+
+	regions := registry.Regions(KindTax)
+	if len(regions) != len(taxParamsAll) {
+		t.Errorf("expected %d tax regions, got %d", len(taxParamsAll), len(regions))
+	}
+
+	years := registry.Years(KindTax, core.RegionCA)
+	if len(years) != len(taxParamsCanada) {
+		t.Errorf("expected %d tax years, got %d", len(taxParamsCanada), len(years))
+	}
+
+	payrollRegions := registry.Regions(KindPayroll)
+	expected := []core.Region{core.RegionCA, core.RegionQC}
+	if !reflect.DeepEqual(payrollRegions, expected) {
+		t.Errorf("unexpected regions\nwant: %v\n got: %v", expected, payrollRegions)
+	}
+}
+
+func TestRegistry_TypedLookups_Errors(t *testing.T) {
+
+	registry := NewRegistry()
+
+	_, err := registry.TaxParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.ChildBenefitParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.RRSPParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.PayrollParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.OASParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.GISParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.GSTCreditParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+
+	_, err = registry.CWBParams(2099, testRegion)
+	if errors.Cause(err) != ErrRegionNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrRegionNotExist, err)
+	}
+}
+
+func TestParamsKind_Text(t *testing.T) {
+
+	for _, kind := range ParamsKinds() {
+
+		text, err := kind.MarshalText()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", kind, err)
+		}
+
+		var parsed ParamsKind
+		err = parsed.UnmarshalText(text)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", kind, err)
+		}
+		if parsed != kind || kind.String() != string(text) {
+			t.Errorf("unexpected kind\nwant: %s\n got: %s", kind, parsed)
+		}
+	}
+
+	if len(ParamsKinds()) != len(paramsKindNames) {
+		t.Errorf("expected %d kinds, got: %d", len(paramsKindNames), len(ParamsKinds()))
+	}
+
+	_, err := ParamsKind(0).MarshalText()
+	if errors.Cause(err) != ErrInvalidParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidParams, err)
+	}
+
+	var kind ParamsKind
+	err = kind.UnmarshalText([]byte("magic"))
+	if errors.Cause(err) != ErrInvalidParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidParams, err)
+	}
+
+	if ParamsKind(0).String() != "ParamsKind(0)" {
+		t.Errorf("unexpected name of an unknown kind: %s", ParamsKind(0))
+	}
+}
+
+func TestRegisterAll(t *testing.T) {
+
+	registry := NewRegistry()
+	err := registerAll(registry, map[core.Region]yearlyRRSPParams{
+		testRegion: {2099: RRSPParams{&rrsp.MaxCapper{Rate: 0.18, Cap: 1000}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = registry.RRSPParams(2099, testRegion)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = registerAll(registry, map[core.Region]yearlyRRSPParams{testRegion: {2098: RRSPParams{}}})
+	if errors.Cause(err) != errNilFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errNilFormula, err)
+	}
+}
@@ -0,0 +1,580 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/pkg/errors"
+)
+
+// RulesVersion is the version of the rule file format this package can load
+const RulesVersion = 1
+
+// the kinds of creditors, adjusters, and child benefit formulas that can be
+// declared in rule files
+const (
+	kindConstCreditor          = "const"
+	kindWeightedCreditor       = "weighted"
+	kindCanadianSpouseCreditor = "canadian-spouse"
+	kindLivingAloneCreditor    = "living-alone"
+
+	kindWeightedAdjuster        = "weighted"
+	kindCappedReductionAdjuster = "capped-reduction"
+
+	kindSurtaxAdjuster             = "surtax"
+	kindSourceCreditAdjuster       = "source-credit"
+	kindLowIncomeReductionAdjuster = "low-income-reduction"
+	kindRecoveryTaxAdjuster        = "recovery-tax"
+	kindIncomePremiumAdjuster      = "income-premium"
+
+	kindChildBenefitFormulaCCB        = "ccb"
+	kindChildBenefitFormulaBCECTB     = "bcectb"
+	kindChildBenefitFormulaBCFB       = "bcfb"
+	kindChildBenefitFormulaTransition = "transition"
+)
+
+// ruleFile is the declarative representation of the params in a rule file
+type ruleFile struct {
+	Version       int                `json:"version" yaml:"version"`
+	Tax           []taxRule          `json:"tax" yaml:"tax"`
+	ChildBenefits []childBenefitRule `json:"child_benefits" yaml:"child_benefits"`
+	RRSP          []rrspRule         `json:"rrsp" yaml:"rrsp"`
+}
+
+// taxRule declares the tax params of a region for a single tax year
+type taxRule struct {
+	Region       core.Region    `json:"region" yaml:"region"`
+	Year         uint           `json:"year" yaml:"year"`
+	Brackets     []bracketRule  `json:"brackets" yaml:"brackets"`
+	Creditors    []creditorRule `json:"creditors" yaml:"creditors"`
+	IncomeRecipe recipeRule     `json:"income_recipe" yaml:"income_recipe"`
+	// Adjusters are the ordered adjusters of the adjustment formula, which
+	// is not set if there are no adjusters
+	Adjusters  []taxAdjusterRule `json:"adjusters,omitempty" yaml:"adjusters,omitempty"`
+	Abatements []abatementRule   `json:"abatements,omitempty" yaml:"abatements,omitempty"`
+}
+
+// bracketRule declares a rated bracket. A missing upper bound means that the
+// bracket is unbounded
+type bracketRule struct {
+	Rate  float64  `json:"rate" yaml:"rate"`
+	Lower float64  `json:"lower" yaml:"lower"`
+	Upper *float64 `json:"upper,omitempty" yaml:"upper,omitempty"`
+}
+
+// creditorRule declares a tax creditor of the given kind, where only the
+// amounts relevant to the kind are used
+type creditorRule struct {
+	Kind            string  `json:"kind" yaml:"kind"`
+	Amount          float64 `json:"amount,omitempty" yaml:"amount,omitempty"`
+	BaseAmount      float64 `json:"base_amount,omitempty" yaml:"base_amount,omitempty"`
+	Weight          float64 `json:"weight,omitempty" yaml:"weight,omitempty"`
+	CreditSource    string  `json:"credit_source" yaml:"credit_source"`
+	CreditType      string  `json:"credit_type" yaml:"credit_type"`
+	FinancialSource string  `json:"financial_source,omitempty" yaml:"financial_source,omitempty"`
+	Description     string  `json:"description,omitempty" yaml:"description,omitempty"`
+	// ReductionThreshold and ReductionRate are only used by living-alone
+	// creditors
+	ReductionThreshold float64 `json:"reduction_threshold,omitempty" yaml:"reduction_threshold,omitempty"`
+	ReductionRate      float64 `json:"reduction_rate,omitempty" yaml:"reduction_rate,omitempty"`
+}
+
+// taxAdjusterRule declares a tax adjuster of the given kind, where only the
+// fields relevant to the kind are used. The brackets are the surtax rates of
+// surtax adjusters and the tiers of income premium adjusters
+type taxAdjusterRule struct {
+	Kind                  string        `json:"kind" yaml:"kind"`
+	Brackets              []bracketRule `json:"brackets,omitempty" yaml:"brackets,omitempty"`
+	Rate                  float64       `json:"rate,omitempty" yaml:"rate,omitempty"`
+	Threshold             float64       `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+	Weight                float64       `json:"weight,omitempty" yaml:"weight,omitempty"`
+	BaseAmount            float64       `json:"base_amount,omitempty" yaml:"base_amount,omitempty"`
+	DependentAmount       float64       `json:"dependent_amount,omitempty" yaml:"dependent_amount,omitempty"`
+	MaxDependentAgeMonths uint          `json:"max_dependent_age_months,omitempty" yaml:"max_dependent_age_months,omitempty"`
+	FinancialSource       string        `json:"financial_source,omitempty" yaml:"financial_source,omitempty"`
+	Description           string        `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// abatementRule declares the reduction of the basic tax of a target region
+type abatementRule struct {
+	TargetRegion core.Region `json:"target_region" yaml:"target_region"`
+	Rate         float64     `json:"rate" yaml:"rate"`
+	Description  string      `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// recipeRule declares the adjusters of an income recipe keyed by the names of
+// the adjusted financial sources
+type recipeRule struct {
+	Income     map[string]adjusterRule `json:"income,omitempty" yaml:"income,omitempty"`
+	Deductions map[string]adjusterRule `json:"deductions,omitempty" yaml:"deductions,omitempty"`
+}
+
+// adjusterRule declares an income adjuster of the given kind
+type adjusterRule struct {
+	Kind   string  `json:"kind" yaml:"kind"`
+	Weight float64 `json:"weight,omitempty" yaml:"weight,omitempty"`
+	Rate   float64 `json:"rate,omitempty" yaml:"rate,omitempty"`
+	Cap    float64 `json:"cap,omitempty" yaml:"cap,omitempty"`
+}
+
+// childBenefitRule declares the child benefit params of a region for a single
+// year
+type childBenefitRule struct {
+	Region                  core.Region `json:"region" yaml:"region"`
+	Year                    uint        `json:"year" yaml:"year"`
+	childBenefitFormulaRule `yaml:",inline"`
+	IncomeRecipe            recipeRule `json:"income_recipe" yaml:"income_recipe"`
+}
+
+// childBenefitFormulaRule declares a child benefit formula of the given kind.
+// CCB formulas use one reducer per child count while BCECTB formulas use the
+// first reducer only. BC Family Benefit formulas use neither the beneficiaries
+// nor the reducers. Transition formulas only use the formulas before and after
+// the transition and the transition month
+type childBenefitFormulaRule struct {
+	Kind          string          `json:"kind" yaml:"kind"`
+	Beneficiaries []ageGroupRule  `json:"beneficiaries" yaml:"beneficiaries"`
+	Reducers      [][]bracketRule `json:"reducers" yaml:"reducers"`
+	// SmallPaymentThreshold is the annual benefits below which the benefits
+	// are paid in a single payment. It is only supported by the CCB formula
+	SmallPaymentThreshold float64 `json:"small_payment_threshold" yaml:"small_payment_threshold"`
+	// DisabilityAmount is the maximum annual Child Disability Benefit for each
+	// eligible child, which is reduced by one disability reducer per child
+	// count. They are only supported by the CCB formula
+	DisabilityAmount   float64         `json:"disability_amount,omitempty" yaml:"disability_amount,omitempty"`
+	DisabilityReducers [][]bracketRule `json:"disability_reducers,omitempty" yaml:"disability_reducers,omitempty"`
+	// FamilyBenefit declares the BC Family Benefit formula
+	FamilyBenefit *familyBenefitRule `json:"family_benefit,omitempty" yaml:"family_benefit,omitempty"`
+	// Before and After declare the formulas that apply before and from the
+	// transition month of the benefit year, starting at zero, in which one
+	// child benefit replaces another
+	Before          *childBenefitFormulaRule `json:"before,omitempty" yaml:"before,omitempty"`
+	After           *childBenefitFormulaRule `json:"after,omitempty" yaml:"after,omitempty"`
+	TransitionMonth uint                     `json:"transition_month,omitempty" yaml:"transition_month,omitempty"`
+}
+
+// familyBenefitRule declares the amounts of the BC Family Benefit, where the
+// amounts are annual and are ordered by the birth order of the children
+type familyBenefitRule struct {
+	MaxAmounts             []float64 `json:"max_amounts" yaml:"max_amounts"`
+	MinAmounts             []float64 `json:"min_amounts" yaml:"min_amounts"`
+	MaxChildAgeMonths      uint      `json:"max_child_age_months" yaml:"max_child_age_months"`
+	ReductionRate          float64   `json:"reduction_rate" yaml:"reduction_rate"`
+	LowerThreshold         float64   `json:"lower_threshold" yaml:"lower_threshold"`
+	UpperThreshold         float64   `json:"upper_threshold" yaml:"upper_threshold"`
+	SingleParentSupplement float64   `json:"single_parent_supplement,omitempty" yaml:"single_parent_supplement,omitempty"`
+}
+
+// ageGroupRule declares the monthly benefits for an age group, where the ages
+// are in months and are bound-inclusive
+type ageGroupRule struct {
+	MinAgeMonths uint    `json:"min_age_months" yaml:"min_age_months"`
+	MaxAgeMonths uint    `json:"max_age_months" yaml:"max_age_months"`
+	MinPerMonth  float64 `json:"min_per_month" yaml:"min_per_month"`
+	MaxPerMonth  float64 `json:"max_per_month" yaml:"max_per_month"`
+}
+
+// rrspRule declares the RRSP params of a region for a single year
+type rrspRule struct {
+	Region             core.Region `json:"region" yaml:"region"`
+	Year               uint        `json:"year" yaml:"year"`
+	Rate               float64     `json:"rate" yaml:"rate"`
+	Cap                float64     `json:"cap" yaml:"cap"`
+	IncomeSources      []string    `json:"income_sources" yaml:"income_sources"`
+	WithdrawalSource   string      `json:"withdrawal_source" yaml:"withdrawal_source"`
+	ContributionSource string      `json:"contribution_source" yaml:"contribution_source"`
+}
+
+// toWeightedBrackets converts the given bracket rules to weighted brackets
+func toWeightedBrackets(rules []bracketRule) (core.WeightedBrackets, error) {
+
+	brackets := make(core.WeightedBrackets, len(rules))
+	for _, rule := range rules {
+
+		if _, exists := brackets[rule.Rate]; exists {
+			return nil, errors.Wrapf(ErrInvalidRule, "duplicate bracket rate %v", rule.Rate)
+		}
+
+		upper := math.Inf(1)
+		if rule.Upper != nil {
+			upper = *rule.Upper
+		}
+		brackets[rule.Rate] = core.Bracket{rule.Lower, upper}
+	}
+
+	return brackets, nil
+}
+
+// toCreditor converts the given creditor rule to a tax creditor
+func (r creditorRule) toCreditor() (tax.Creditor, error) {
+
+	crType, err := core.ParseCreditRuleType(r.CreditType)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidRule, "unknown credit type %q", r.CreditType)
+	}
+
+	src, err := parseRuleSource(r.FinancialSource)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor := tax.CreditDescriptor{
+		CreditRule:            core.CreditRule{CrSource: r.CreditSource, Type: crType},
+		TargetFinancialSource: src,
+		CreditDescription:     r.Description,
+	}
+
+	switch r.Kind {
+	case kindConstCreditor:
+		return tax.ConstCreditor{Amount: r.Amount, CreditDescriptor: descriptor}, nil
+	case kindWeightedCreditor:
+		return tax.WeightedCreditor{Weight: r.Weight, CreditDescriptor: descriptor}, nil
+	case kindCanadianSpouseCreditor:
+		return tax.CanadianSpouseCreditor{
+			BaseAmount:       r.BaseAmount,
+			Weight:           r.Weight,
+			CreditDescriptor: descriptor,
+		}, nil
+	case kindLivingAloneCreditor:
+		return tax.LivingAloneCreditor{
+			BaseAmount:         r.BaseAmount,
+			ReductionThreshold: r.ReductionThreshold,
+			ReductionRate:      r.ReductionRate,
+			Weight:             r.Weight,
+			CreditDescriptor:   descriptor,
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidRule, "unknown creditor kind %q", r.Kind)
+	}
+}
+
+// toTaxAdjuster converts the given tax adjuster rule to a tax adjuster
+func (r taxAdjusterRule) toTaxAdjuster() (tax.TaxAdjuster, error) {
+
+	switch r.Kind {
+	case kindSurtaxAdjuster:
+		rates, err := toWeightedBrackets(r.Brackets)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid surtax brackets")
+		}
+		return tax.SurtaxAdjuster{Rates: rates, Desc: r.Description}, nil
+
+	case kindSourceCreditAdjuster:
+		src, err := parseRuleSource(r.FinancialSource)
+		if err != nil {
+			return nil, err
+		}
+		return tax.SourceCreditAdjuster{Source: src, Weight: r.Weight, Desc: r.Description}, nil
+
+	case kindLowIncomeReductionAdjuster:
+		return tax.LowIncomeReductionAdjuster{
+			BaseAmount:            r.BaseAmount,
+			DependentAmount:       r.DependentAmount,
+			MaxDependentAgeMonths: r.MaxDependentAgeMonths,
+			Desc:                  r.Description,
+		}, nil
+
+	case kindRecoveryTaxAdjuster:
+		src, err := parseRuleSource(r.FinancialSource)
+		if err != nil {
+			return nil, err
+		}
+		return tax.RecoveryTaxAdjuster{
+			Rate:          r.Rate,
+			Threshold:     r.Threshold,
+			BenefitSource: src,
+			Desc:          r.Description,
+		}, nil
+
+	case kindIncomePremiumAdjuster:
+		tiers := make([]tax.PremiumTier, len(r.Brackets))
+		for i, rule := range r.Brackets {
+			upper := math.Inf(1)
+			if rule.Upper != nil {
+				upper = *rule.Upper
+			}
+			tiers[i] = tax.PremiumTier{Rate: rule.Rate, Bracket: core.Bracket{rule.Lower, upper}}
+		}
+		return tax.IncomePremiumAdjuster{Tiers: tiers, Desc: r.Description}, nil
+
+	default:
+		return nil, errors.Wrapf(ErrInvalidRule, "unknown tax adjuster kind %q", r.Kind)
+	}
+}
+
+// toAdjuster converts the given adjuster rule to an income adjuster
+func (r adjusterRule) toAdjuster() (income.Adjuster, error) {
+
+	switch r.Kind {
+	case kindWeightedAdjuster:
+		return income.WeightedAdjuster(r.Weight), nil
+	case kindCappedReductionAdjuster:
+		return income.CappedReductionAdjuster{Rate: r.Rate, Cap: r.Cap}, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidRule, "unknown adjuster kind %q", r.Kind)
+	}
+}
+
+// toRecipe converts the given recipe rule to an income recipe
+func (r recipeRule) toRecipe() (*income.Recipe, error) {
+
+	incomeAdjusters, err := toAdjusters(r.Income)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid income adjusters")
+	}
+
+	deductionAdjusters, err := toAdjusters(r.Deductions)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid deduction adjusters")
+	}
+
+	recipe := &income.Recipe{
+		IncomeAdjusters:    incomeAdjusters,
+		DeductionAdjusters: deductionAdjusters,
+	}
+	return recipe, nil
+}
+
+// toAdjusters converts the given adjuster rules to income adjusters keyed by
+// their financial sources
+func toAdjusters(rules map[string]adjusterRule) (map[core.FinancialSource]income.Adjuster, error) {
+
+	if rules == nil {
+		return nil, nil
+	}
+
+	adjusters := make(map[core.FinancialSource]income.Adjuster, len(rules))
+	for name, rule := range rules {
+
+		src, err := parseRuleSource(name)
+		if err != nil {
+			return nil, err
+		}
+
+		adjusters[src], err = rule.toAdjuster()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return adjusters, nil
+}
+
+// toParams converts the given tax rule to tax params
+func (r taxRule) toParams() (TaxParams, error) {
+
+	brackets, err := toWeightedBrackets(r.Brackets)
+	if err != nil {
+		return TaxParams{}, errors.Wrap(err, "invalid brackets")
+	}
+
+	creditors := make([]tax.Creditor, len(r.Creditors))
+	for i, creditorRule := range r.Creditors {
+		creditors[i], err = creditorRule.toCreditor()
+		if err != nil {
+			return TaxParams{}, errors.Wrapf(err, "invalid creditor at index %d", i)
+		}
+	}
+
+	recipe, err := r.IncomeRecipe.toRecipe()
+	if err != nil {
+		return TaxParams{}, errors.Wrap(err, "invalid income recipe")
+	}
+
+	var adjustmentFormula tax.AdjustmentFormula
+	if len(r.Adjusters) > 0 {
+		adjusters := make([]tax.TaxAdjuster, len(r.Adjusters))
+		for i, adjusterRule := range r.Adjusters {
+			adjusters[i], err = adjusterRule.toTaxAdjuster()
+			if err != nil {
+				return TaxParams{}, errors.Wrapf(err, "invalid tax adjuster at index %d", i)
+			}
+		}
+		adjustmentFormula = &tax.CanadianAdjustmentFormula{
+			OrderedAdjusters: adjusters,
+			TaxYear:          r.Year,
+			TaxRegion:        r.Region,
+		}
+	}
+
+	var abatements []tax.Abatement
+	for _, abatementRule := range r.Abatements {
+		abatements = append(abatements, tax.Abatement{
+			TargetRegion: abatementRule.TargetRegion,
+			Rate:         abatementRule.Rate,
+			Desc:         abatementRule.Description,
+		})
+	}
+
+	params := TaxParams{
+		Formula: &tax.CanadianFormula{
+			WeightedBrackets: brackets,
+			TaxYear:          r.Year,
+			TaxRegion:        r.Region,
+		},
+		ContraFormula: &tax.CanadianContraFormula{
+			OrderedCreditors: creditors,
+			TaxYear:          r.Year,
+			TaxRegion:        r.Region,
+		},
+		IncomeRecipe:      recipe,
+		AdjustmentFormula: adjustmentFormula,
+		Abatements:        abatements,
+	}
+	return params, nil
+}
+
+// toParams converts the given child benefit rule to child benefit params
+func (r childBenefitRule) toParams() (CBParams, error) {
+
+	recipe, err := r.IncomeRecipe.toRecipe()
+	if err != nil {
+		return CBParams{}, errors.Wrap(err, "invalid income recipe")
+	}
+
+	formula, err := r.toFormula()
+	if err != nil {
+		return CBParams{}, err
+	}
+
+	params := CBParams{Formula: formula, IncomeRecipe: recipe}
+	return params, nil
+}
+
+// toFormula converts the given formula rule to a child benefit formula
+func (r childBenefitFormulaRule) toFormula() (benefits.ChildBenefitFormula, error) {
+
+	beneficiaries := make([]benefits.AgeGroupBenefits, len(r.Beneficiaries))
+	for i, group := range r.Beneficiaries {
+		beneficiaries[i] = benefits.AgeGroupBenefits{
+			AgesMonths:      human.AgeRange{group.MinAgeMonths, group.MaxAgeMonths},
+			AmountsPerMonth: core.Bracket{group.MinPerMonth, group.MaxPerMonth},
+		}
+	}
+
+	reducers := make([]core.WeightedBrackets, len(r.Reducers))
+	for i, reducerRule := range r.Reducers {
+		var err error
+		reducers[i], err = toWeightedBrackets(reducerRule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid reducer at index %d", i)
+		}
+	}
+
+	var disabilityReducers []core.WeightedBrackets
+	for i, reducerRule := range r.DisabilityReducers {
+		reducer, err := toWeightedBrackets(reducerRule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid disability reducer at index %d", i)
+		}
+		disabilityReducers = append(disabilityReducers, reducer)
+	}
+
+	switch r.Kind {
+	case kindChildBenefitFormulaCCB:
+		formula := &benefits.CCBMaxReducer{
+			BeneficiaryClasses:    beneficiaries,
+			Reducers:              reducers,
+			SmallPaymentThreshold: r.SmallPaymentThreshold,
+			DisabilityAmount:      r.DisabilityAmount,
+			DisabilityReducers:    disabilityReducers,
+		}
+		return formula, nil
+
+	case kindChildBenefitFormulaBCECTB:
+		if len(reducers) != 1 {
+			return nil, errors.Wrap(ErrInvalidRule, "expected exactly one reducer")
+		}
+		formula := &benefits.BCECTBMaxReducer{
+			BeneficiaryClasses: beneficiaries,
+			ReducerFormula:     reducers[0],
+		}
+		return formula, nil
+
+	case kindChildBenefitFormulaBCFB:
+		if r.FamilyBenefit == nil {
+			return nil, errors.Wrap(ErrInvalidRule, "missing family benefit")
+		}
+		formula := &benefits.BCFamilyBenefitFormula{
+			MaxAmounts:             r.FamilyBenefit.MaxAmounts,
+			MinAmounts:             r.FamilyBenefit.MinAmounts,
+			MaxChildAgeMonths:      r.FamilyBenefit.MaxChildAgeMonths,
+			ReductionRate:          r.FamilyBenefit.ReductionRate,
+			LowerThreshold:         r.FamilyBenefit.LowerThreshold,
+			UpperThreshold:         r.FamilyBenefit.UpperThreshold,
+			SingleParentSupplement: r.FamilyBenefit.SingleParentSupplement,
+		}
+		return formula, nil
+
+	case kindChildBenefitFormulaTransition:
+		return r.toTransition()
+
+	default:
+		return nil, errors.Wrapf(ErrInvalidRule, "unknown formula kind %q", r.Kind)
+	}
+}
+
+// toTransition converts the given formula rule to a child benefit transition,
+// where both formulas must apply in at least one month of the benefit year
+func (r childBenefitFormulaRule) toTransition() (*benefits.ChildBenefitTransition, error) {
+
+	if r.Before == nil || r.After == nil {
+		return nil, errors.Wrap(ErrInvalidRule, "missing formula before or after transition")
+	}
+
+	if r.TransitionMonth < 1 || r.TransitionMonth >= monthsInYear {
+		return nil, errors.Wrapf(ErrInvalidRule, "transition month %d is not within [1, 11]", r.TransitionMonth)
+	}
+
+	before, err := r.Before.toFormula()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid formula before transition")
+	}
+
+	after, err := r.After.toFormula()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid formula after transition")
+	}
+
+	transition := &benefits.ChildBenefitTransition{
+		Before:          before,
+		After:           after,
+		TransitionMonth: r.TransitionMonth,
+	}
+	return transition, nil
+}
+
+// toParams converts the given RRSP rule to RRSP params
+func (r rrspRule) toParams() (RRSPParams, error) {
+
+	incomeSources, err := parseRuleSources(r.IncomeSources)
+	if err != nil {
+		return RRSPParams{}, errors.Wrap(err, "invalid income sources")
+	}
+
+	withdrawalSource, err := parseRuleSource(r.WithdrawalSource)
+	if err != nil {
+		return RRSPParams{}, errors.Wrap(err, "invalid withdrawal source")
+	}
+
+	contributionSource, err := parseRuleSource(r.ContributionSource)
+	if err != nil {
+		return RRSPParams{}, errors.Wrap(err, "invalid contribution source")
+	}
+
+	params := RRSPParams{
+		Formula: &rrsp.MaxCapper{
+			Rate:                           r.Rate,
+			Cap:                            r.Cap,
+			IncomeSources:                  incomeSources,
+			IncomeSourceForWithdrawal:      withdrawalSource,
+			DeductionSourceForContribution: contributionSource,
+		},
+	}
+	return params, nil
+}
@@ -0,0 +1,37 @@
+package history
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/pkg/errors"
+)
+
+// parseRuleSource returns the financial source for the given name, which is
+// one of the names of core.FinancialSource. An empty name is parsed as
+// core.SrcNone
+func parseRuleSource(name string) (core.FinancialSource, error) {
+
+	if name == "" {
+		return core.SrcNone, nil
+	}
+
+	src, err := core.ParseFinancialSource(name)
+	if err != nil {
+		return core.SrcNone, errors.Wrapf(ErrInvalidRule, "unknown financial source %q", name)
+	}
+	return src, nil
+}
+
+// parseRuleSources returns the financial sources for the given names
+func parseRuleSources(names []string) ([]core.FinancialSource, error) {
+
+	var sources []core.FinancialSource
+	for _, name := range names {
+		src, err := parseRuleSource(name)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
@@ -0,0 +1,251 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsSK = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaSK2025,
+		ContraFormula: taxContraFormulaSK2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaSK2024,
+		ContraFormula: taxContraFormulaSK2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaSK2023,
+		ContraFormula: taxContraFormulaSK2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaSK2022,
+		ContraFormula: taxContraFormulaSK2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaSK2021,
+		ContraFormula: taxContraFormulaSK2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaSK2020,
+		ContraFormula: taxContraFormulaSK2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaSK2019,
+		ContraFormula: taxContraFormulaSK2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaSK2018,
+		ContraFormula: taxContraFormulaSK2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaSK2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 53463},
+		0.1250: core.Bracket{53463, 152750},
+		0.1450: core.Bracket{152750, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaSK2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 19491, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 19491, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0294, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2024 */
+
+var taxFormulaSK2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 52057},
+		0.1250: core.Bracket{52057, 148734},
+		0.1450: core.Bracket{148734, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaSK2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 18491, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 18491, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0294, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2023 */
+
+var taxFormulaSK2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 49720},
+		0.1250: core.Bracket{49720, 142058},
+		0.1450: core.Bracket{142058, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaSK2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 17661, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 17661, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.02519, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2022 */
+
+var taxFormulaSK2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 46773},
+		0.1250: core.Bracket{46773, 133638},
+		0.1450: core.Bracket{133638, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaSK2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 16615, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16615, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.02105, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2021 */
+
+var taxFormulaSK2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 46773},
+		0.1250: core.Bracket{46773, 133638},
+		0.1450: core.Bracket{133638, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaSK2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 16225, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16225, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.01695, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2020 */
+
+var taxFormulaSK2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 45677},
+		0.1250: core.Bracket{45677, 130506},
+		0.1450: core.Bracket{130506, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaSK2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 16065, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16065, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.03362, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2019 */
+
+var taxFormulaSK2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 45225},
+		0.1250: core.Bracket{45225, 129214},
+		0.1450: core.Bracket{129214, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaSK2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 16065, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16065, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.03362, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionSK,
+}
+
+/* 2018 */
+
+var taxFormulaSK2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.1050: core.Bracket{0, 45225},
+		0.1250: core.Bracket{45225, 129214},
+		0.1450: core.Bracket{129214, math.Inf(1)},
+	},
+	TaxRegion: core.RegionSK,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaSK2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.1050 * 16065, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16065, Weight: 0.1050, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.1050, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1100, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.03362, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionSK,
+}
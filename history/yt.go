@@ -0,0 +1,275 @@
+package history
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+)
+
+var taxParamsYT = yearlyTaxParams{
+	2025: TaxParams{
+		Formula:       taxFormulaYT2025,
+		ContraFormula: taxContraFormulaYT2025,
+		IncomeRecipe:  incomeRecipeNetCA2025,
+	},
+	2024: TaxParams{
+		Formula:       taxFormulaYT2024,
+		ContraFormula: taxContraFormulaYT2024,
+		IncomeRecipe:  incomeRecipeNetCA2024,
+	},
+	2023: TaxParams{
+		Formula:       taxFormulaYT2023,
+		ContraFormula: taxContraFormulaYT2023,
+		IncomeRecipe:  incomeRecipeNetCA2023,
+	},
+	2022: TaxParams{
+		Formula:       taxFormulaYT2022,
+		ContraFormula: taxContraFormulaYT2022,
+		IncomeRecipe:  incomeRecipeNetCA2022,
+	},
+	2021: TaxParams{
+		Formula:       taxFormulaYT2021,
+		ContraFormula: taxContraFormulaYT2021,
+		IncomeRecipe:  incomeRecipeNetCA2021,
+	},
+	2020: TaxParams{
+		Formula:       taxFormulaYT2020,
+		ContraFormula: taxContraFormulaYT2020,
+		IncomeRecipe:  incomeRecipeNetCA2020,
+	},
+	2019: TaxParams{
+		Formula:       taxFormulaYT2019,
+		ContraFormula: taxContraFormulaYT2019,
+		IncomeRecipe:  incomeRecipeNetCA2019,
+	},
+	2018: TaxParams{
+		Formula:       taxFormulaYT2018,
+		ContraFormula: taxContraFormulaYT2018,
+		IncomeRecipe:  incomeRecipeNetCA2018,
+	},
+}
+
+/* 2025 */
+
+var taxFormulaYT2025 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 57375},
+		0.0900: core.Bracket{57375, 114750},
+		0.1090: core.Bracket{114750, 177882},
+		0.1280: core.Bracket{177882, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2025,
+}
+
+var taxContraFormulaYT2025 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 16129, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 16129, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2025,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2024 */
+
+var taxFormulaYT2024 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 55867},
+		0.0900: core.Bracket{55867, 111733},
+		0.1090: core.Bracket{111733, 173205},
+		0.1280: core.Bracket{173205, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2024,
+}
+
+var taxContraFormulaYT2024 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 15705, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15705, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2024,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2023 */
+
+var taxFormulaYT2023 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 53359},
+		0.0900: core.Bracket{53359, 106717},
+		0.1090: core.Bracket{106717, 165430},
+		0.1280: core.Bracket{165430, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2023,
+}
+
+var taxContraFormulaYT2023 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 15000, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 15000, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2023,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2022 */
+
+var taxFormulaYT2022 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 50197},
+		0.0900: core.Bracket{50197, 100392},
+		0.1090: core.Bracket{100392, 155625},
+		0.1280: core.Bracket{155625, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2022,
+}
+
+var taxContraFormulaYT2022 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 14398, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 14398, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2022,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2021 */
+
+var taxFormulaYT2021 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 49020},
+		0.0900: core.Bracket{49020, 98040},
+		0.1090: core.Bracket{98040, 151978},
+		0.1280: core.Bracket{151978, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2021,
+}
+
+var taxContraFormulaYT2021 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 13808, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 13808, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2021,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2020 */
+
+var taxFormulaYT2020 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 48535},
+		0.0900: core.Bracket{48535, 97069},
+		0.1090: core.Bracket{97069, 150473},
+		0.1280: core.Bracket{150473, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2020,
+}
+
+var taxContraFormulaYT2020 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 13229, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 13229, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2020,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2019 */
+
+var taxFormulaYT2019 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 47630},
+		0.0900: core.Bracket{47630, 95259},
+		0.1090: core.Bracket{95259, 147667},
+		0.1280: core.Bracket{147667, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2019,
+}
+
+var taxContraFormulaYT2019 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 12069, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 12069, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.15 * 0.0067, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2019,
+	TaxRegion: core.RegionYT,
+}
+
+/* 2018 */
+
+var taxFormulaYT2018 = &tax.CanadianFormula{
+	WeightedBrackets: core.WeightedBrackets{
+		0.0640: core.Bracket{0, 46605},
+		0.0900: core.Bracket{46605, 93208},
+		0.1090: core.Bracket{93208, 144489},
+		0.1280: core.Bracket{144489, 500000},
+		0.1500: core.Bracket{500000, math.Inf(1)},
+	},
+	TaxRegion: core.RegionYT,
+	TaxYear:   2018,
+}
+
+var taxContraFormulaYT2018 = &tax.CanadianContraFormula{
+	OrderedCreditors: []tax.Creditor{
+		tax.ConstCreditor{Amount: 0.0640 * 11809, CreditDescriptor: crDescPersonalAmount},
+		tax.CanadianSpouseCreditor{BaseAmount: 11809, Weight: 0.0640, CreditDescriptor: crDescCanadianSpouse},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescCPPBaseContributions},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescEIPremiums},
+		tax.WeightedCreditor{Weight: 0.0640, CreditDescriptor: crDescTuitionAmount},
+		tax.WeightedCreditor{Weight: 1.38 * 0.1202, CreditDescriptor: crDescCanadianEligibleDividends},
+		tax.WeightedCreditor{Weight: 1.16 * 0.0230, CreditDescriptor: crDescCanadianNonEligibleDividends},
+	},
+	TaxYear:   2018,
+	TaxRegion: core.RegionYT,
+}
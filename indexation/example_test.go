@@ -0,0 +1,54 @@
+package indexation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/history"
+)
+
+func ExampleTaxParams() {
+
+	// check the registered brackets of 2025 against published index factors
+	registry := history.DefaultRegistry()
+	for _, factor := range []float64{1.027, 1.028} {
+
+		base, err := registry.TaxParams(2024, core.RegionBC)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		indexed, err := TaxParams(base, factor)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		registered, err := registry.TaxParams(2025, core.RegionBC)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		fmt.Printf("%.3f: %v\n", factor, upperBounds(indexed) == upperBounds(registered))
+	}
+
+	// Output:
+	// 1.027: false
+	// 1.028: true
+}
+
+// upperBounds returns the sorted upper bounds of the brackets of the given
+// params as a string
+func upperBounds(params history.TaxParams) string {
+
+	var bounds []float64
+	for _, bracket := range params.Formula.(*tax.CanadianFormula).WeightedBrackets {
+		bounds = append(bounds, bracket.Upper())
+	}
+	sort.Float64s(bounds)
+	return fmt.Sprint(bounds)
+}
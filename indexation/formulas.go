@@ -0,0 +1,241 @@
+package indexation
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/pkg/errors"
+)
+
+// CanadianFormula returns a copy of the given formula for the following tax
+// year, where the bounds of the tax brackets are indexed by the given factor
+// and rounded to the nearest dollar
+func CanadianFormula(f *tax.CanadianFormula, factor float64) (*tax.CanadianFormula, error) {
+
+	if f == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil tax formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tax.CanadianFormula{
+		WeightedBrackets: indexBrackets(f.WeightedBrackets, factor),
+		TaxYear:          f.TaxYear + 1,
+		TaxRegion:        f.TaxRegion,
+	}, nil
+}
+
+// CanadianContraFormula returns a copy of the given contra formula for the
+// following tax year, where the base amounts of constant and spouse creditors
+// are indexed by the given factor and rounded to the nearest dollar. Since the
+// amount of a constant creditor is the credit of a base amount, the base amount
+// is found using the given credit rate, which is usually the lowest tax rate of
+// the jurisdiction. The amounts of other creditors are not indexed
+func CanadianContraFormula(cf *tax.CanadianContraFormula, creditRate, factor float64) (*tax.CanadianContraFormula, error) {
+
+	if cf == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil contra tax formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	if creditRate <= 0 || math.IsInf(creditRate, 0) || math.IsNaN(creditRate) {
+		return nil, errors.Wrapf(ErrInvalidRate, "%f", creditRate)
+	}
+
+	clone := cf.Clone().(*tax.CanadianContraFormula)
+	clone.TaxYear++
+
+	for i, creditor := range clone.OrderedCreditors {
+		switch c := creditor.(type) {
+		case tax.ConstCreditor:
+			c.Amount = creditRate * RoundDollar(c.Amount/creditRate*factor)
+			clone.OrderedCreditors[i] = c
+		case tax.CanadianSpouseCreditor:
+			c.BaseAmount = RoundDollar(c.BaseAmount * factor)
+			clone.OrderedCreditors[i] = c
+		}
+	}
+
+	return clone, nil
+}
+
+// CanadianAdjustmentFormula returns a copy of the given adjustment formula for
+// the following tax year, where the thresholds of surtax adjusters, the amounts
+// of low-income reduction adjusters and the thresholds of recovery tax
+// adjusters are indexed by the given factor and rounded to the nearest dollar.
+// Other adjusters, e.g. Ontario's health premium, are not indexed
+func CanadianAdjustmentFormula(af *tax.CanadianAdjustmentFormula, factor float64) (*tax.CanadianAdjustmentFormula, error) {
+
+	if af == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil tax adjustment formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := af.Clone().(*tax.CanadianAdjustmentFormula)
+	clone.TaxYear++
+
+	for i, adjuster := range clone.OrderedAdjusters {
+		switch a := adjuster.(type) {
+		case tax.SurtaxAdjuster:
+			a.Rates = indexBrackets(a.Rates, factor)
+			clone.OrderedAdjusters[i] = a
+		case tax.LowIncomeReductionAdjuster:
+			a.BaseAmount = RoundDollar(a.BaseAmount * factor)
+			a.DependentAmount = RoundDollar(a.DependentAmount * factor)
+			clone.OrderedAdjusters[i] = a
+		case tax.RecoveryTaxAdjuster:
+			a.Threshold = RoundDollar(a.Threshold * factor)
+			clone.OrderedAdjusters[i] = a
+		}
+	}
+
+	return clone, nil
+}
+
+// CCBMaxReducer returns a copy of the given formula, where the income
+// thresholds of the reducers are indexed by the given factor and rounded to
+// the nearest dollar. The benefit amounts are indexed as annual amounts, which
+// are rounded to the nearest dollar before they are converted back to monthly
+// amounts
+func CCBMaxReducer(f *benefits.CCBMaxReducer, factor float64) (*benefits.CCBMaxReducer, error) {
+
+	if f == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil child benefit formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := f.Clone().(*benefits.CCBMaxReducer)
+
+	for i, reducer := range clone.Reducers {
+		clone.Reducers[i] = indexBrackets(reducer, factor)
+	}
+
+	for i, class := range clone.BeneficiaryClasses {
+		amounts := class.AmountsPerMonth
+		clone.BeneficiaryClasses[i].AmountsPerMonth = core.Bracket{
+			indexMonthly(amounts.Lower(), factor),
+			indexMonthly(amounts.Upper(), factor),
+		}
+	}
+
+	return clone, nil
+}
+
+// BCFamilyBenefitFormula returns a copy of the given formula, where the income
+// thresholds and the benefit amounts are indexed by the given factor and
+// rounded to the nearest dollar
+func BCFamilyBenefitFormula(f *benefits.BCFamilyBenefitFormula, factor float64) (*benefits.BCFamilyBenefitFormula, error) {
+
+	if f == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil child benefit formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := f.Clone().(*benefits.BCFamilyBenefitFormula)
+	clone.LowerThreshold = RoundDollar(f.LowerThreshold * factor)
+	clone.UpperThreshold = RoundDollar(f.UpperThreshold * factor)
+	clone.SingleParentSupplement = RoundDollar(f.SingleParentSupplement * factor)
+
+	for i := range clone.MaxAmounts {
+		clone.MaxAmounts[i] = RoundDollar(f.MaxAmounts[i] * factor)
+	}
+	for i := range clone.MinAmounts {
+		clone.MinAmounts[i] = RoundDollar(f.MinAmounts[i] * factor)
+	}
+
+	return clone, nil
+}
+
+// MaxCapper returns a copy of the given formula, where the maximum contribution
+// is indexed by the given factor and rounded to the nearest multiple of ten
+// dollars. The CRA indexes the maximum to the growth of the average wage
+// rather than the rate of inflation, so the factor might differ from the one
+// used for tax formulas
+func MaxCapper(f *rrsp.MaxCapper, factor float64) (*rrsp.MaxCapper, error) {
+
+	if f == nil {
+		return nil, errors.Wrap(ErrUnsupportedFormula, "nil RRSP formula")
+	}
+
+	err := validateFactor(factor)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := f.Clone().(*rrsp.MaxCapper)
+	clone.Cap = RoundTenDollars(clone.Cap * factor)
+	return clone, nil
+}
+
+// indexBrackets returns a copy of the given brackets, where the bounds are
+// indexed by the given factor and rounded to the nearest dollar. Zero and
+// infinite bounds are not changed
+func indexBrackets(wb core.WeightedBrackets, factor float64) core.WeightedBrackets {
+
+	if wb == nil {
+		return nil
+	}
+
+	indexed := make(core.WeightedBrackets, len(wb))
+	for rate, bracket := range wb {
+		indexed[rate] = core.Bracket{
+			RoundDollar(bracket.Lower() * factor),
+			RoundDollar(bracket.Upper() * factor),
+		}
+	}
+	return indexed
+}
+
+// indexMonthly indexes the annual amount of the given monthly amount by the
+// given factor and returns the monthly amount of the result
+func indexMonthly(monthly, factor float64) float64 {
+
+	annual := RoundDollar(monthly * 12)
+	return RoundDollar(annual*factor) / 12
+}
+
+// lowestRate returns the rate of the bracket with the lowest lower bound
+func lowestRate(wb core.WeightedBrackets) float64 {
+
+	var (
+		rate  float64
+		lower = math.Inf(1)
+	)
+	for r, bracket := range wb {
+		if bracket.Lower() < lower {
+			rate, lower = r, bracket.Lower()
+		}
+	}
+	return rate
+}
+
+// validateFactor checks if the given index factor is valid for use
+func validateFactor(factor float64) error {
+
+	if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+		return errors.Wrapf(ErrInvalidFactor, "%f", factor)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package indexation
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/pkg/errors"
+)
+
+func TestCanadianFormula(t *testing.T) {
+
+	original := &tax.CanadianFormula{
+		WeightedBrackets: core.WeightedBrackets{
+			0.10: core.Bracket{0, 10000},
+			0.20: core.Bracket{10000, math.Inf(1)},
+		},
+		TaxYear:   2020,
+		TaxRegion: core.RegionCA,
+	}
+
+	indexed, err := CanadianFormula(original, 1.025)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &tax.CanadianFormula{
+		WeightedBrackets: core.WeightedBrackets{
+			0.10: core.Bracket{0, 10250},
+			0.20: core.Bracket{10250, math.Inf(1)},
+		},
+		TaxYear:   2021,
+		TaxRegion: core.RegionCA,
+	}
+	diff := deep.Equal(indexed, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	if original.TaxYear != 2020 || original.WeightedBrackets[0.10].Upper() != 10000 {
+		t.Error("expected original formula to not be modified")
+	}
+}
+
+func TestCanadianContraFormula(t *testing.T) {
+
+	original := &tax.CanadianContraFormula{
+		OrderedCreditors: []tax.Creditor{
+			tax.ConstCreditor{Amount: 0.15 * 12069},
+			tax.CanadianSpouseCreditor{BaseAmount: 12069, Weight: 0.15},
+			tax.WeightedCreditor{Weight: 0.15},
+		},
+		TaxYear:   2019,
+		TaxRegion: core.RegionCA,
+	}
+
+	indexed, err := CanadianContraFormula(original, 0.15, 1.019)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &tax.CanadianContraFormula{
+		OrderedCreditors: []tax.Creditor{
+			tax.ConstCreditor{Amount: 0.15 * 12298},
+			tax.CanadianSpouseCreditor{BaseAmount: 12298, Weight: 0.15},
+			tax.WeightedCreditor{Weight: 0.15},
+		},
+		TaxYear:   2020,
+		TaxRegion: core.RegionCA,
+	}
+	diff := deep.Equal(indexed, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	if original.OrderedCreditors[1].(tax.CanadianSpouseCreditor).BaseAmount != 12069 {
+		t.Error("expected original contra formula to not be modified")
+	}
+}
+
+func TestCanadianAdjustmentFormula(t *testing.T) {
+
+	original := &tax.CanadianAdjustmentFormula{
+		OrderedAdjusters: []tax.TaxAdjuster{
+			tax.SurtaxAdjuster{Rates: core.WeightedBrackets{
+				0.20: core.Bracket{5315, math.Inf(1)},
+				0.36: core.Bracket{6802, math.Inf(1)},
+			}},
+			tax.SourceCreditAdjuster{Source: core.IncSrcEligibleDividendsCA, Weight: 0.138},
+			tax.LowIncomeReductionAdjuster{BaseAmount: 274, DependentAmount: 507},
+			tax.RecoveryTaxAdjuster{Rate: 0.15, Threshold: 86912, BenefitSource: core.IncSrcOAS},
+		},
+		TaxYear:   2023,
+		TaxRegion: core.RegionON,
+	}
+
+	indexed, err := CanadianAdjustmentFormula(original, 1.045)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &tax.CanadianAdjustmentFormula{
+		OrderedAdjusters: []tax.TaxAdjuster{
+			tax.SurtaxAdjuster{Rates: core.WeightedBrackets{
+				0.20: core.Bracket{5554, math.Inf(1)},
+				0.36: core.Bracket{7108, math.Inf(1)},
+			}},
+			tax.SourceCreditAdjuster{Source: core.IncSrcEligibleDividendsCA, Weight: 0.138},
+			tax.LowIncomeReductionAdjuster{BaseAmount: 286, DependentAmount: 530},
+			tax.RecoveryTaxAdjuster{Rate: 0.15, Threshold: 90823, BenefitSource: core.IncSrcOAS},
+		},
+		TaxYear:   2024,
+		TaxRegion: core.RegionON,
+	}
+	diff := deep.Equal(indexed, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	if original.OrderedAdjusters[2].(tax.LowIncomeReductionAdjuster).BaseAmount != 274 {
+		t.Error("expected original adjustment formula to not be modified")
+	}
+}
+
+func TestCCBMaxReducer(t *testing.T) {
+
+	original := &benefits.CCBMaxReducer{
+		BeneficiaryClasses: []benefits.AgeGroupBenefits{
+			{AgesMonths: human.AgeRange{0, 71}, AmountsPerMonth: core.Bracket{0, 648.91}},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.07: core.Bracket{36502, 79087}, 0.032: core.Bracket{79087, math.Inf(1)}},
+		},
+	}
+
+	indexed, err := CCBMaxReducer(original, 1.027)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &benefits.CCBMaxReducer{
+		BeneficiaryClasses: []benefits.AgeGroupBenefits{
+			{AgesMonths: human.AgeRange{0, 71}, AmountsPerMonth: core.Bracket{0, 7997.0 / 12}},
+		},
+		Reducers: []core.WeightedBrackets{
+			{0.07: core.Bracket{37488, 81222}, 0.032: core.Bracket{81222, math.Inf(1)}},
+		},
+	}
+	diff := deep.Equal(indexed, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	if original.Reducers[0][0.07].Lower() != 36502 {
+		t.Error("expected original formula to not be modified")
+	}
+}
+
+func TestBCFamilyBenefitFormula(t *testing.T) {
+
+	original := &benefits.BCFamilyBenefitFormula{
+		MaxAmounts:             []float64{2188, 1375},
+		MinAmounts:             []float64{775, 750},
+		MaxChildAgeMonths:      215,
+		ReductionRate:          0.04,
+		LowerThreshold:         35902,
+		UpperThreshold:         114887,
+		SingleParentSupplement: 500,
+	}
+
+	indexed, err := BCFamilyBenefitFormula(original, 1.027)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &benefits.BCFamilyBenefitFormula{
+		MaxAmounts:             []float64{2247, 1412},
+		MinAmounts:             []float64{796, 770},
+		MaxChildAgeMonths:      215,
+		ReductionRate:          0.04,
+		LowerThreshold:         36871,
+		UpperThreshold:         117989,
+		SingleParentSupplement: 514,
+	}
+	diff := deep.Equal(indexed, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	if original.MaxAmounts[0] != 2188 {
+		t.Error("expected original formula to not be modified")
+	}
+}
+
+func TestMaxCapper(t *testing.T) {
+
+	original := &rrsp.MaxCapper{Rate: 0.18, Cap: 31560}
+
+	indexed, err := MaxCapper(original, 1.0295)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if indexed.Cap != 32490 || indexed.Rate != 0.18 {
+		t.Errorf("unexpected formula: %+v", indexed)
+	}
+
+	if original.Cap != 31560 {
+		t.Error("expected original formula to not be modified")
+	}
+}
+
+func TestFormulas_Errors(t *testing.T) {
+
+	var err error
+
+	_, err = CanadianFormula(nil, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	_, err = CanadianFormula(&tax.CanadianFormula{}, 0)
+	if errors.Cause(err) != ErrInvalidFactor {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFactor, err)
+	}
+
+	_, err = CanadianContraFormula(&tax.CanadianContraFormula{}, 0, 1.02)
+	if errors.Cause(err) != ErrInvalidRate {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidRate, err)
+	}
+
+	_, err = CanadianAdjustmentFormula(nil, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	_, err = CanadianAdjustmentFormula(&tax.CanadianAdjustmentFormula{}, math.Inf(1))
+	if errors.Cause(err) != ErrInvalidFactor {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFactor, err)
+	}
+
+	_, err = CCBMaxReducer(&benefits.CCBMaxReducer{}, math.NaN())
+	if errors.Cause(err) != ErrInvalidFactor {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFactor, err)
+	}
+
+	_, err = BCFamilyBenefitFormula(nil, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	_, err = BCFamilyBenefitFormula(&benefits.BCFamilyBenefitFormula{}, -1)
+	if errors.Cause(err) != ErrInvalidFactor {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrInvalidFactor, err)
+	}
+
+	_, err = MaxCapper(nil, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+}
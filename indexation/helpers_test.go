@@ -0,0 +1,13 @@
+package indexation
+
+import (
+	"sort"
+
+	"github.com/malkhamis/quantax/core"
+)
+
+// sortBrackets sorts the given brackets by their lower bounds
+func sortBrackets(brackets []core.Bracket) []core.Bracket {
+	sort.Slice(brackets, func(i, j int) bool { return brackets[i].Lower() < brackets[j].Lower() })
+	return brackets
+}
@@ -0,0 +1,16 @@
+// Package indexation derives the params of a year from the params of the year
+// before it and an index factor, e.g. the published rate of inflation. It
+// follows the rounding conventions of the Canada Revenue Agency (CRA), where
+// indexed amounts are rounded to the nearest dollar and the RRSP dollar limit
+// is rounded to the nearest multiple of ten dollars
+package indexation
+
+import "errors"
+
+// Sentinel errors that can be wrapped and returned by this package
+var (
+	ErrInvalidFactor      = errors.New("invalid index factor")
+	ErrInvalidRate        = errors.New("invalid credit rate")
+	ErrUnsupportedParams  = errors.New("params of this kind cannot be indexed")
+	ErrUnsupportedFormula = errors.New("formula of this type cannot be indexed")
+)
@@ -0,0 +1,133 @@
+package indexation
+
+import (
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// TaxParams returns a copy of the given tax params for the following tax year,
+// where the formula, the contra formula and the adjustment formula are indexed
+// by the given factor. Personal amounts are credited at the lowest tax rate of
+// the formula
+func TaxParams(params history.TaxParams, factor float64) (history.TaxParams, error) {
+
+	formula, ok := params.Formula.(*tax.CanadianFormula)
+	if !ok {
+		return history.TaxParams{}, errors.Wrapf(ErrUnsupportedFormula, "tax formula of type %T", params.Formula)
+	}
+
+	contraFormula, ok := params.ContraFormula.(*tax.CanadianContraFormula)
+	if !ok {
+		return history.TaxParams{}, errors.Wrapf(ErrUnsupportedFormula, "contra tax formula of type %T", params.ContraFormula)
+	}
+
+	indexed := params.Clone()
+
+	var err error
+	indexed.Formula, err = CanadianFormula(formula, factor)
+	if err != nil {
+		return history.TaxParams{}, err
+	}
+
+	creditRate := lowestRate(formula.WeightedBrackets)
+	indexed.ContraFormula, err = CanadianContraFormula(contraFormula, creditRate, factor)
+	if err != nil {
+		return history.TaxParams{}, err
+	}
+
+	switch f := indexed.AdjustmentFormula.(type) {
+	case nil:
+	case *tax.CanadianAdjustmentFormula:
+		indexed.AdjustmentFormula, err = CanadianAdjustmentFormula(f, factor)
+		if err != nil {
+			return history.TaxParams{}, err
+		}
+	default:
+		return history.TaxParams{}, errors.Wrapf(ErrUnsupportedFormula, "tax adjustment formula of type %T", f)
+	}
+
+	return indexed, nil
+}
+
+// CBParams returns a copy of the given child benefit params, where the formula
+// is indexed by the given factor
+func CBParams(params history.CBParams, factor float64) (history.CBParams, error) {
+
+	var (
+		formula benefits.ChildBenefitFormula
+		err     error
+	)
+	switch f := params.Formula.(type) {
+	case *benefits.CCBMaxReducer:
+		formula, err = CCBMaxReducer(f, factor)
+	case *benefits.BCFamilyBenefitFormula:
+		formula, err = BCFamilyBenefitFormula(f, factor)
+	default:
+		return history.CBParams{}, errors.Wrapf(ErrUnsupportedFormula, "child benefit formula of type %T", params.Formula)
+	}
+	if err != nil {
+		return history.CBParams{}, err
+	}
+
+	indexed := params.Clone()
+	indexed.Formula = formula
+	return indexed, nil
+}
+
+// RRSPParams returns a copy of the given RRSP params, where the formula is
+// indexed by the given factor
+func RRSPParams(params history.RRSPParams, factor float64) (history.RRSPParams, error) {
+
+	formula, ok := params.Formula.(*rrsp.MaxCapper)
+	if !ok {
+		return history.RRSPParams{}, errors.Wrapf(ErrUnsupportedFormula, "RRSP formula of type %T", params.Formula)
+	}
+
+	indexed, err := MaxCapper(formula, factor)
+	if err != nil {
+		return history.RRSPParams{}, err
+	}
+
+	return history.RRSPParams{Formula: indexed}, nil
+}
+
+// Params returns a copy of the given params for the following year, where the
+// params are indexed by the given factor. Tax, child benefit, and RRSP params
+// can be indexed
+func Params(params history.Params, factor float64) (history.Params, error) {
+
+	switch p := params.(type) {
+	case history.TaxParams:
+		return TaxParams(p, factor)
+	case history.CBParams:
+		return CBParams(p, factor)
+	case history.RRSPParams:
+		return RRSPParams(p, factor)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedParams, "params of type %T", params)
+	}
+}
+
+// Annually returns a function that indexes the given params of a year by the
+// given factor once for each year until the given target year, which matches
+// how amounts are indexed and rounded from one year to the next. The returned
+// function can be used as the indexer of a projection
+func Annually(factor float64) func(params history.Params, fromYear, toYear uint) (history.Params, error) {
+
+	return func(params history.Params, fromYear, toYear uint) (history.Params, error) {
+
+		for year := fromYear; year < toYear; year++ {
+
+			var err error
+			params, err = Params(params, factor)
+			if err != nil {
+				return nil, errors.Wrapf(err, "indexing params of year %d", year)
+			}
+		}
+
+		return params, nil
+	}
+}
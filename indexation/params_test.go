@@ -0,0 +1,150 @@
+package indexation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+func TestTaxParams(t *testing.T) {
+
+	base, err := history.DefaultRegistry().TaxParams(2024, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexed, err := TaxParams(base, 1.027)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	published, err := history.DefaultRegistry().TaxParams(2025, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if indexed.Formula.Year() != 2025 || indexed.ContraFormula.Year() != 2025 {
+		t.Errorf("unexpected years: %d, %d", indexed.Formula.Year(), indexed.ContraFormula.Year())
+	}
+
+	// the lowest rate changed in 2025, but the brackets follow the indexation
+	var indexedBrackets, publishedBrackets []core.Bracket
+	for _, bracket := range indexed.Formula.(*tax.CanadianFormula).WeightedBrackets {
+		indexedBrackets = append(indexedBrackets, bracket)
+	}
+	for _, bracket := range published.Formula.(*tax.CanadianFormula).WeightedBrackets {
+		publishedBrackets = append(publishedBrackets, bracket)
+	}
+	diff := deep.Equal(sortBrackets(indexedBrackets), sortBrackets(publishedBrackets))
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	personalAmount := indexed.ContraFormula.(*tax.CanadianContraFormula).OrderedCreditors[0].(tax.ConstCreditor)
+	if personalAmount.Amount != 0.15*16129 {
+		t.Errorf("unexpected personal amount credit\nwant: %.2f\n got: %.2f", 0.15*16129, personalAmount.Amount)
+	}
+
+	err = history.NewRegistry().Register(2025, core.RegionCA, indexed)
+	if err != nil {
+		t.Errorf("expected indexed params to be valid, got: %v", err)
+	}
+}
+
+func TestTaxParams_Adjustments(t *testing.T) {
+
+	base, err := history.DefaultRegistry().TaxParams(2024, core.RegionON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexed, err := TaxParams(base, 1.02)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if indexed.AdjustmentFormula.Year() != 2025 || base.AdjustmentFormula.Year() != 2024 {
+		t.Errorf(
+			"unexpected adjustment formula years: %d, %d",
+			indexed.AdjustmentFormula.Year(), base.AdjustmentFormula.Year(),
+		)
+	}
+
+	adjusters := indexed.AdjustmentFormula.(*tax.CanadianAdjustmentFormula).OrderedAdjusters
+	surtax, ok := adjusters[0].(tax.SurtaxAdjuster)
+	if !ok {
+		t.Fatalf("expected the first adjuster to be the surtax, got: %T", adjusters[0])
+	}
+	if surtax.Rates[0.20].Lower() != RoundDollar(5554*1.02) {
+		t.Errorf("unexpected indexed surtax threshold\nwant: %.2f\n got: %.2f", RoundDollar(5554*1.02), surtax.Rates[0.20].Lower())
+	}
+}
+
+func TestParams_Errors(t *testing.T) {
+
+	_, err := Params(history.PayrollParams{}, 1.02)
+	if errors.Cause(err) != ErrUnsupportedParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedParams, err)
+	}
+
+	_, err = Params(history.TaxParams{ContraFormula: &tax.CanadianContraFormula{}}, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	_, err = Params(history.CBParams{}, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	_, err = Params(history.RRSPParams{}, 1.02)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+}
+
+func TestAnnually(t *testing.T) {
+
+	base, err := history.DefaultRegistry().RRSPParams(2025, core.RegionCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexer := Annually(1.03)
+
+	indexed, err := indexer(base, 2025, 2027)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each year is rounded before indexing the next one
+	expected := RoundTenDollars(RoundTenDollars(32490*1.03) * 1.03)
+	actual := indexed.(history.RRSPParams).Formula.(*rrsp.MaxCapper).Cap
+	if actual != expected {
+		t.Errorf("unexpected cap\nwant: %.2f\n got: %.2f", expected, actual)
+	}
+
+	taxBase, err := history.DefaultRegistry().TaxParams(2025, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexedTax, err := indexer(taxBase, 2025, 2028)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if year := indexedTax.(history.TaxParams).Formula.Year(); year != 2028 {
+		t.Errorf("unexpected year\nwant: %d\n got: %d", 2028, year)
+	}
+
+	_, err = indexer(history.PayrollParams{}, 2025, 2026)
+	if errors.Cause(err) != ErrUnsupportedParams {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedParams, err)
+	}
+}
@@ -0,0 +1,35 @@
+package indexation
+
+import "math"
+
+// RoundFactor rounds the given index factor to three decimal places, which is
+// how the CRA publishes the factors, e.g. 1.027 for an indexation of 2.7%
+func RoundFactor(factor float64) float64 {
+	return math.Round(factor*1000) / 1000
+}
+
+// RoundDollar rounds the given amount to the nearest dollar, where amounts
+// that are equidistant from two dollars are rounded up
+func RoundDollar(amount float64) float64 {
+	return roundUpHalf(amount, 1)
+}
+
+// RoundTenDollars rounds the given amount to the nearest multiple of ten
+// dollars, where amounts that are equidistant from two multiples are rounded
+// up
+func RoundTenDollars(amount float64) float64 {
+	return roundUpHalf(amount, 10)
+}
+
+// roundUpHalf rounds the given amount to the nearest multiple of unit, where
+// the amount is rounded to six decimal places first so that floating point
+// errors do not affect amounts that are equidistant from two multiples
+func roundUpHalf(amount, unit float64) float64 {
+
+	if math.IsInf(amount, 0) || math.IsNaN(amount) {
+		return amount
+	}
+
+	amount = math.Round(amount*1e6) / 1e6
+	return math.Floor(amount/unit+0.5) * unit
+}
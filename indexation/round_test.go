@@ -0,0 +1,37 @@
+package indexation
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRounding(t *testing.T) {
+
+	cases := []struct {
+		name     string
+		round    func(float64) float64
+		amount   float64
+		expected float64
+	}{
+		{name: "dollar-down", round: RoundDollar, amount: 57375.409, expected: 57375},
+		{name: "dollar-up", round: RoundDollar, amount: 114749.8, expected: 114750},
+		{name: "dollar-half", round: RoundDollar, amount: 12345.5, expected: 12346},
+		{name: "dollar-almost-half", round: RoundDollar, amount: 181232 * 1.028, expected: 186306},
+		{name: "dollar-inf", round: RoundDollar, amount: math.Inf(1), expected: math.Inf(1)},
+		{name: "ten-dollars-down", round: RoundTenDollars, amount: 32491.02, expected: 32490},
+		{name: "ten-dollars-half", round: RoundTenDollars, amount: 32495, expected: 32500},
+		{name: "factor", round: RoundFactor, amount: 1.02749, expected: 1.027},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			actual := c.round(c.amount)
+			if actual != c.expected {
+				t.Errorf("unexpected rounding\nwant: %.3f\n got: %.3f", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package projection
+
+import (
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// Indexer derives the params of a year beyond the registered years from the
+// params of the latest registered year, e.g. by indexing amounts to inflation.
+// The given params are a copy that the indexer may modify and return. The
+// returned params are associated with the target year by the projection. See
+// package indexation for indexers that follow the CRA rounding conventions
+type Indexer func(params history.Params, fromYear, toYear uint) (history.Params, error)
+
+// paramsKey identifies the params of a kind for a year and region
+type paramsKey struct {
+	kind   history.ParamsKind
+	year   uint
+	region core.Region
+}
+
+// resolver makes the params of the years of a projection available in a
+// working registry. Params of years beyond the registered years are derived
+// from the params of the latest registered year
+type resolver struct {
+	source   *history.Registry
+	registry *history.Registry
+	indexer  Indexer
+	horizons map[history.ParamsKind]uint
+	derived  map[paramsKey]bool
+}
+
+// newResolver returns a resolver whose working registry is a clone of source
+func newResolver(source *history.Registry, indexer Indexer) *resolver {
+	return &resolver{
+		source:   source,
+		registry: source.Clone(),
+		indexer:  indexer,
+		horizons: make(map[history.ParamsKind]uint),
+		derived:  make(map[paramsKey]bool),
+	}
+}
+
+// ensure makes sure that params of the given kind are in the working registry
+// for the given year and region. It returns true if the params are derived
+// from an earlier year. Params are only derived if the given year is beyond
+// the latest year registered for any region and the latest year registered
+// for the given region is that year, so params that were discontinued before
+// then are not revived
+func (r *resolver) ensure(kind history.ParamsKind, year uint, region core.Region) (bool, error) {
+
+	key := paramsKey{kind, year, region}
+	if r.derived[key] {
+		return true, nil
+	}
+
+	_, lookupErr := r.registry.Lookup(kind, year, region)
+	if lookupErr == nil {
+		return false, nil
+	}
+
+	years := r.source.Years(kind, region)
+	if len(years) == 0 {
+		return false, lookupErr
+	}
+
+	latest := years[len(years)-1]
+	if year <= latest || latest != r.horizon(kind) {
+		return false, lookupErr
+	}
+
+	params, err := r.source.Lookup(kind, latest, region)
+	if err != nil {
+		return false, err
+	}
+
+	if r.indexer != nil {
+		params, err = r.indexer(params, latest, year)
+		if err != nil {
+			return false, errors.Wrapf(err, "indexing params from year %d", latest)
+		}
+	}
+
+	params, err = withYear(params, year)
+	if err != nil {
+		return false, err
+	}
+
+	err = r.registry.Register(year, region, params)
+	if err != nil {
+		return false, err
+	}
+
+	r.derived[key] = true
+	return true, nil
+}
+
+// horizon returns the latest year for which params of the given kind are
+// registered for any region in the source registry
+func (r *resolver) horizon(kind history.ParamsKind) uint {
+
+	if latest, ok := r.horizons[kind]; ok {
+		return latest
+	}
+
+	var latest uint
+	for _, region := range r.source.Regions(kind) {
+		years := r.source.Years(kind, region)
+		if len(years) > 0 && years[len(years)-1] > latest {
+			latest = years[len(years)-1]
+		}
+	}
+
+	r.horizons[kind] = latest
+	return latest
+}
+
+// withYear associates the formulas of the given params with the given year.
+// Only tax params have formulas that are associated with a year
+func withYear(params history.Params, year uint) (history.Params, error) {
+
+	taxParams, ok := params.(history.TaxParams)
+	if !ok {
+		return params, nil
+	}
+
+	switch f := taxParams.Formula.(type) {
+	case *tax.CanadianFormula:
+		f.TaxYear = year
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedFormula, "tax formula of type %T", f)
+	}
+
+	switch f := taxParams.ContraFormula.(type) {
+	case *tax.CanadianContraFormula:
+		f.TaxYear = year
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedFormula, "contra tax formula of type %T", f)
+	}
+
+	switch f := taxParams.AdjustmentFormula.(type) {
+	case nil:
+	case *tax.CanadianAdjustmentFormula:
+		f.TaxYear = year
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedFormula, "tax adjustment formula of type %T", f)
+	}
+
+	return taxParams, nil
+}
+
+// isNotExist returns true if the given error indicates params that are not
+// registered
+func isNotExist(err error) bool {
+	cause := errors.Cause(err)
+	return cause == history.ErrParamsNotExist || cause == history.ErrRegionNotExist
+}
@@ -0,0 +1,103 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// testFormula is a tax formula of a type that cannot be associated with
+// another year
+type testFormula struct {
+	*tax.CanadianFormula
+}
+
+// discontinuedRegistry returns a copy of the default registry, except that the
+// child benefits of BC are discontinued after 2019
+func discontinuedRegistry(t *testing.T) *history.Registry {
+
+	source := history.DefaultRegistry()
+	registry := history.NewRegistry()
+
+	for kind := history.KindTax; kind <= history.KindCWB; kind++ {
+		for _, region := range source.Regions(kind) {
+			for _, year := range source.Years(kind, region) {
+
+				if kind == history.KindChildBenefit && region == core.RegionBC && year > 2019 {
+					continue
+				}
+
+				params, err := source.Lookup(kind, year, region)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = registry.Register(year, region, params)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	return registry
+}
+
+func TestResolver_Ensure(t *testing.T) {
+
+	r := newResolver(history.DefaultRegistry(), nil)
+
+	indexed, err := r.ensure(history.KindTax, 2030, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !indexed {
+		t.Error("expected params beyond the registered years to be derived")
+	}
+
+	params, err := r.registry.TaxParams(2030, core.RegionBC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Formula.Year() != 2030 || params.ContraFormula.Year() != 2030 {
+		t.Errorf("unexpected formula years: %d, %d", params.Formula.Year(), params.ContraFormula.Year())
+	}
+
+	indexed, err = r.ensure(history.KindTax, 2030, core.RegionBC)
+	if err != nil || !indexed {
+		t.Errorf("expected derived params to be reported as derived, got: %t, %v", indexed, err)
+	}
+
+	_, err = history.DefaultRegistry().TaxParams(2030, core.RegionBC)
+	if errors.Cause(err) != history.ErrParamsNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", history.ErrParamsNotExist, err)
+	}
+
+	// discontinued params are not revived
+	r = newResolver(discontinuedRegistry(t), nil)
+	_, err = r.ensure(history.KindChildBenefit, 2030, core.RegionBC)
+	if errors.Cause(err) != history.ErrParamsNotExist {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", history.ErrParamsNotExist, err)
+	}
+}
+
+func TestWithYear_UnsupportedFormula(t *testing.T) {
+
+	params := history.TaxParams{
+		Formula:       testFormula{&tax.CanadianFormula{}},
+		ContraFormula: &tax.CanadianContraFormula{},
+	}
+
+	_, err := withYear(params, 2030)
+	if errors.Cause(err) != ErrUnsupportedFormula {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", ErrUnsupportedFormula, err)
+	}
+
+	rrspParams := history.RRSPParams{}
+	actual, err := withYear(rrspParams, 2030)
+	if err != nil || actual != history.Params(rrspParams) {
+		t.Errorf("expected params without years to be returned as is, got: %v, %v", actual, err)
+	}
+}
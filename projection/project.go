@@ -0,0 +1,382 @@
+package projection
+
+import (
+	"math"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// Config is used to pass configurations for projecting a household over
+// multiple years
+type Config struct {
+	// Registry is where the params of each year are looked up. If nil, the
+	// default registry is used. It is never modified by the projection
+	Registry *history.Registry
+	// Indexer derives the params of years beyond the registered ones. If nil,
+	// the params of the latest registered year are used as is
+	Indexer Indexer
+	// StartYear is the first year of the projection
+	StartYear uint
+	// Years are the inputs of the household for each year of the projection,
+	// where the first one is for the start year
+	Years []YearInput
+	// TaxRegions are the tax regions of the household, e.g. Canada and a
+	// province
+	TaxRegions []core.Region
+	// BenefitRegions are the regions to calculate child benefits for. If not
+	// set, the tax regions that have child benefits in a year are used
+	BenefitRegions []core.Region
+	// RRSPRegion is the region of the RRSP rules, which defaults to Canada
+	RRSPRegion core.Region
+	// Dependents are the dependents of the household in the start year. They
+	// are never modified by the projection
+	Dependents []*human.Person
+	// Credits are the tax credits carried forward to the start year
+	Credits []tax.CreditRecord
+	// RRSPRoomA is the unused RRSP contribution room of spouse A at the
+	// beginning of the start year
+	RRSPRoomA float64
+	// RRSPRoomB is the unused RRSP contribution room of spouse B at the
+	// beginning of the start year
+	RRSPRoomB float64
+}
+
+// YearInput is the input of the household for a single year
+type YearInput struct {
+	// Finances are the finances of the household in the year, which are never
+	// modified by the projection. Contributions to and withdrawals from RRSP
+	// accounts are taken from the sources of the RRSP formula
+	Finances core.HouseholdFinances
+	// NewDependents are the dependents who join the household in the year,
+	// e.g. newborns. They are aged along with the other dependents in the
+	// following years
+	NewDependents []*human.Person
+}
+
+// validate checks if the configurations are valid for use
+func (cfg Config) validate() error {
+
+	if cfg.StartYear == 0 {
+		return ErrInvalidYear
+	}
+
+	if len(cfg.Years) == 0 {
+		return ErrNoYears
+	}
+
+	if len(cfg.TaxRegions) == 0 {
+		return ErrNoRegions
+	}
+
+	for i, input := range cfg.Years {
+		if input.Finances == nil {
+			return errors.Wrapf(ErrNoFinances, "year %d", cfg.StartYear+uint(i))
+		}
+	}
+
+	for _, room := range []float64{cfg.RRSPRoomA, cfg.RRSPRoomB} {
+		if room < 0 || math.IsInf(room, 0) || math.IsNaN(room) {
+			return ErrInvalidRoom
+		}
+	}
+
+	return nil
+}
+
+// Row is the projection of the household in a single year
+type Row struct {
+	// Year is the year of this row
+	Year uint
+	// Indexed is true if some of the params of the year are not registered
+	// and were derived from the params of an earlier year
+	Indexed bool
+	// Dependents are the dependents of the household in the year
+	Dependents []*human.Person
+	// TaxA is the payable tax of spouse A
+	TaxA float64
+	// TaxB is the payable tax of spouse B
+	TaxB float64
+	// Benefits is the recievable child benefits of the household
+	Benefits float64
+	// RRSPA is the RRSP activity of spouse A
+	RRSPA RRSPActivity
+	// RRSPB is the RRSP activity of spouse B
+	RRSPB RRSPActivity
+	// Credits are the unused tax credits carried forward to the next year
+	Credits []tax.CreditRecord
+}
+
+// RRSPActivity is the RRSP activity of a single spouse in a single year
+type RRSPActivity struct {
+	// RoomStart is the unused contribution room at the beginning of the year
+	RoomStart float64
+	// Contribution is the amount contributed in the year
+	Contribution float64
+	// Withdrawal is the amount withdrawn in the year
+	Withdrawal float64
+	// RoomEarned is the contribution room earned on the income of the year,
+	// which becomes available in the next year
+	RoomEarned float64
+	// RoomEnd is the unused contribution room carried forward to the next
+	// year. It is negative if more than the available room is contributed
+	RoomEnd float64
+}
+
+// Project projects the household over the configured years and returns one
+// row for each year
+func Project(cfg Config) ([]Row, error) {
+
+	err := cfg.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
+	if cfg.Registry == nil {
+		cfg.Registry = history.DefaultRegistry()
+	}
+	if cfg.RRSPRegion == "" {
+		cfg.RRSPRegion = core.RegionCA
+	}
+
+	p := &projector{
+		cfg:        cfg,
+		params:     newResolver(cfg.Registry, cfg.Indexer),
+		dependents: cloneDependents(cfg.Dependents),
+		records:    cfg.Credits,
+		roomA:      cfg.RRSPRoomA,
+		roomB:      cfg.RRSPRoomB,
+	}
+
+	rows := make([]Row, len(cfg.Years))
+	for i, input := range cfg.Years {
+
+		if i > 0 {
+			ageDependents(p.dependents, 12)
+		}
+		p.dependents = append(p.dependents, cloneDependents(input.NewDependents)...)
+
+		year := cfg.StartYear + uint(i)
+		rows[i], err = p.project(year, input.Finances)
+		if err != nil {
+			return nil, errors.Wrapf(err, "year %d", year)
+		}
+	}
+
+	return rows, nil
+}
+
+// cloneDependents returns copies of the given dependents, skipping nil ones
+func cloneDependents(dependents []*human.Person) []*human.Person {
+
+	clones := make([]*human.Person, 0, len(dependents))
+	for _, d := range dependents {
+		if d == nil {
+			continue
+		}
+		clone := *d
+		clones = append(clones, &clone)
+	}
+	return clones
+}
+
+// ageDependents adds the given number of months to the age of the given
+// dependents
+func ageDependents(dependents []*human.Person, months uint) {
+	for _, d := range dependents {
+		d.AgeMonths += months
+	}
+}
+
+// projector holds the state that is carried from one year of a projection to
+// the next
+type projector struct {
+	cfg        Config
+	params     *resolver
+	dependents []*human.Person
+	records    []tax.CreditRecord
+	roomA      float64
+	roomB      float64
+}
+
+// project returns the row of the given year for the given finances and
+// updates the state carried forward to the next year
+func (p *projector) project(year uint, finances core.HouseholdFinances) (Row, error) {
+
+	row := Row{Year: year, Dependents: cloneDependents(p.dependents)}
+
+	for _, region := range p.cfg.TaxRegions {
+		indexed, err := p.params.ensure(history.KindTax, year, region)
+		if err != nil {
+			return Row{}, errors.Wrapf(err, "tax params for region %q", region)
+		}
+		row.Indexed = row.Indexed || indexed
+	}
+
+	indexed, err := p.params.ensure(history.KindRRSP, year, p.cfg.RRSPRegion)
+	if err != nil {
+		return Row{}, errors.Wrapf(err, "RRSP params for region %q", p.cfg.RRSPRegion)
+	}
+	row.Indexed = row.Indexed || indexed
+
+	benefitRegions, indexed, err := p.benefitRegions(year)
+	if err != nil {
+		return Row{}, err
+	}
+	row.Indexed = row.Indexed || indexed
+
+	credits, err := bindCredits(p.records, finances)
+	if err != nil {
+		return Row{}, errors.Wrap(err, "carried tax credits")
+	}
+
+	taxCalc, err := factory.NewTaxFactoryWithRegistry(p.params.registry, year, p.cfg.TaxRegions...).NewCalculator()
+	if err != nil {
+		return Row{}, err
+	}
+	taxCalc.SetFinances(finances, credits)
+	taxCalc.SetDependents(p.dependents)
+
+	var usedCredits []core.TaxCredit
+	row.TaxA, row.TaxB, usedCredits = taxCalc.TaxPayable()
+
+	if len(benefitRegions) > 0 {
+		benefitCalc, err := factory.NewChildBenefitFactoryWithRegistry(p.params.registry, year, benefitRegions...).NewCalculator()
+		if err != nil {
+			return Row{}, err
+		}
+		benefitCalc.SetFinances(finances)
+		benefitCalc.SetBeneficiaries(p.dependents)
+		row.Benefits = benefitCalc.BenefitRecievable()
+	}
+
+	row.RRSPA, row.RRSPB, err = p.rrspActivity(year, finances, credits)
+	if err != nil {
+		return Row{}, err
+	}
+
+	row.Credits = tax.NewCreditRecords(carryForward(usedCredits), finances)
+
+	p.records = row.Credits
+	p.roomA, p.roomB = row.RRSPA.RoomEnd, row.RRSPB.RoomEnd
+	return row, nil
+}
+
+// benefitRegions returns the regions to calculate child benefits for in the
+// given year and whether the params of any of them are derived
+func (p *projector) benefitRegions(year uint) ([]core.Region, bool, error) {
+
+	var (
+		regions    []core.Region
+		anyIndexed bool
+		configured = len(p.cfg.BenefitRegions) > 0
+		candidates = p.cfg.BenefitRegions
+	)
+	if !configured {
+		candidates = p.cfg.TaxRegions
+	}
+
+	for _, region := range candidates {
+
+		indexed, err := p.params.ensure(history.KindChildBenefit, year, region)
+		if !configured && isNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "child benefit params for region %q", region)
+		}
+
+		regions = append(regions, region)
+		anyIndexed = anyIndexed || indexed
+	}
+
+	return regions, anyIndexed, nil
+}
+
+// rrspActivity returns the RRSP activity of both spouses in the given year
+func (p *projector) rrspActivity(year uint, finances core.HouseholdFinances, credits []core.TaxCredit) (spouseA, spouseB RRSPActivity, err error) {
+
+	params, err := p.params.registry.RRSPParams(year, p.cfg.RRSPRegion)
+	if err != nil {
+		return RRSPActivity{}, RRSPActivity{}, err
+	}
+
+	config := factory.RRSPFactoryConfig{
+		Year:       year,
+		RRSPRegion: p.cfg.RRSPRegion,
+		TaxRegions: p.cfg.TaxRegions,
+	}
+	calculator, err := factory.NewRRSPFactoryWithRegistry(p.params.registry, config).NewCalculator()
+	if err != nil {
+		return RRSPActivity{}, RRSPActivity{}, err
+	}
+	calculator.SetFinances(finances, credits)
+	calculator.SetDependents(p.dependents)
+
+	calculator.SetTargetSpouseA()
+	spouseA = newRRSPActivity(p.roomA, finances.SpouseA(), params.Formula, calculator.ContributionEarned())
+
+	calculator.SetTargetSpouseB()
+	spouseB = newRRSPActivity(p.roomB, finances.SpouseB(), params.Formula, calculator.ContributionEarned())
+
+	return spouseA, spouseB, nil
+}
+
+// newRRSPActivity returns the RRSP activity of the given spouse, where room is
+// the unused contribution room at the beginning of the year. If the spouse is
+// nil, the room is carried forward as is
+func newRRSPActivity(room float64, spouse core.Financer, formula rrsp.Formula, earned float64) RRSPActivity {
+
+	activity := RRSPActivity{RoomStart: room, RoomEnd: room}
+	if spouse == nil {
+		return activity
+	}
+
+	activity.Contribution = spouse.TotalAmount(formula.TargetSourceForContribution())
+	activity.Withdrawal = spouse.TotalAmount(formula.TargetSourceForWithdrawl())
+	activity.RoomEarned = earned
+	activity.RoomEnd = room - activity.Contribution + earned
+	return activity
+}
+
+// bindCredits returns the tax credits of the given records that are bound to
+// the given finances. Records owned by a spouse who is not in the finances,
+// e.g. after a separation, are dropped
+func bindCredits(records []tax.CreditRecord, finances core.HouseholdFinances) ([]core.TaxCredit, error) {
+
+	bindable := make([]tax.CreditRecord, 0, len(records))
+	for _, r := range records {
+		if r.Owner == tax.OwnerSpouseA && finances.SpouseA() == nil {
+			continue
+		}
+		if r.Owner == tax.OwnerSpouseB && finances.SpouseB() == nil {
+			continue
+		}
+		bindable = append(bindable, r)
+	}
+
+	return tax.BindCreditRecords(bindable, finances)
+}
+
+// carryForward returns the given credits that can be carried forward and have
+// a remaining balance
+func carryForward(credits []core.TaxCredit) []core.TaxCredit {
+
+	var carried []core.TaxCredit
+	for _, cr := range credits {
+
+		if cr == nil || cr.Rule().Type != core.CrRuleTypeCanCarryForward {
+			continue
+		}
+
+		if _, _, remaining := cr.Amounts(); remaining > 0 {
+			carried = append(carried, cr)
+		}
+	}
+	return carried
+}
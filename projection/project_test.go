@@ -0,0 +1,334 @@
+package projection
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/malkhamis/quantax/indexation"
+	"github.com/pkg/errors"
+)
+
+func TestProject(t *testing.T) {
+
+	ff := factory.NewFinanceFactory()
+	firstYear := ff.NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 80000, core.DeducSrcRRSP: 2000},
+		map[core.FinancialSource]float64{core.IncSrcEarned: 30000},
+	)
+	secondYear := ff.NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 85000, core.DeducSrcRRSP: 20000},
+		map[core.FinancialSource]float64{core.IncSrcEarned: 10000, core.IncSrcRRSP: 5000},
+	)
+
+	dependents := []*human.Person{{Name: "A", AgeMonths: 10}}
+	regions := []core.Region{core.RegionCA, core.RegionBC}
+
+	rows, err := Project(Config{
+		StartYear:  2019,
+		TaxRegions: regions,
+		Dependents: dependents,
+		RRSPRoomA:  5000,
+		Years: []YearInput{
+			{Finances: firstYear},
+			{Finances: secondYear, NewDependents: []*human.Person{{Name: "B"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 2 || rows[0].Year != 2019 || rows[1].Year != 2020 {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+
+	if dependents[0].AgeMonths != 10 {
+		t.Error("expected given dependents to not be modified")
+	}
+
+	diff := deep.Equal(rows[1].Dependents, []*human.Person{{Name: "A", AgeMonths: 22}, {Name: "B"}})
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	for i, finances := range []core.HouseholdFinances{firstYear, secondYear} {
+
+		row := rows[i]
+		if row.Indexed {
+			t.Errorf("year %d: expected registered params to be used", row.Year)
+		}
+
+		taxCalc, err := factory.NewTaxFactory(row.Year, regions...).NewCalculator()
+		if err != nil {
+			t.Fatal(err)
+		}
+		taxCalc.SetFinances(finances, nil)
+		taxCalc.SetDependents(row.Dependents)
+		taxA, taxB, _ := taxCalc.TaxPayable()
+
+		if row.TaxA != taxA || row.TaxB != taxB {
+			t.Errorf(
+				"year %d: unexpected tax\nwant: %.2f, %.2f\n got: %.2f, %.2f",
+				row.Year, taxA, taxB, row.TaxA, row.TaxB,
+			)
+		}
+
+		if row.Benefits <= 0 {
+			t.Errorf("year %d: expected the household to recieve child benefits", row.Year)
+		}
+	}
+
+	cbCalc, err := factory.NewChildBenefitFactory(2020, regions...).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cbCalc.SetFinances(secondYear)
+	cbCalc.SetBeneficiaries(rows[1].Dependents)
+	if benefits := cbCalc.BenefitRecievable(); rows[1].Benefits != benefits {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", benefits, rows[1].Benefits)
+	}
+
+	expectedRRSP := []RRSPActivity{
+		{RoomStart: 5000, Contribution: 2000, RoomEarned: 14400, RoomEnd: 17400},
+		{RoomStart: 17400, Contribution: 20000, RoomEarned: 15300, RoomEnd: 12700},
+	}
+	for i, row := range rows {
+		diff := deep.Equal(row.RRSPA, expectedRRSP[i])
+		if diff != nil {
+			t.Errorf("year %d: actual does not match expected\n%s", row.Year, strings.Join(diff, "\n"))
+		}
+	}
+
+	if rows[1].RRSPB.Withdrawal != 5000 || rows[1].RRSPB.RoomStart != 5400 {
+		t.Errorf("unexpected RRSP activity of spouse B: %+v", rows[1].RRSPB)
+	}
+}
+
+func TestProject_CarryForwardCredits(t *testing.T) {
+
+	ff := factory.NewFinanceFactory()
+	studentYear := ff.NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 20000, core.MiscSrcTuition: 30000},
+	)
+	workingYear := ff.NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 60000},
+	)
+
+	rows, err := Project(Config{
+		StartYear:  2019,
+		TaxRegions: []core.Region{core.RegionCA},
+		Years:      []YearInput{{Finances: studentYear}, {Finances: workingYear}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows[0].Credits) == 0 {
+		t.Fatal("expected unused tuition credits to be carried forward")
+	}
+
+	for _, record := range rows[0].Credits {
+		if record.RuleType != core.CrRuleTypeCanCarryForward || record.Owner != tax.OwnerSpouseA {
+			t.Errorf("unexpected carried credit: %+v", record)
+		}
+	}
+
+	taxCalc, err := factory.NewTaxFactory(2020, core.RegionCA).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxCalc.SetFinances(workingYear, nil)
+	taxWithoutCredits, _, _ := taxCalc.TaxPayable()
+
+	if math.Abs(rows[1].TaxA-4335.88) > 0.01 || rows[1].TaxA >= taxWithoutCredits {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", 4335.88, rows[1].TaxA)
+	}
+
+	if len(rows[1].Credits) != 0 {
+		t.Errorf("expected carried credits to be used up, got: %+v", rows[1].Credits)
+	}
+}
+
+func TestProject_BeyondRegisteredYears(t *testing.T) {
+
+	finances := factory.NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 70000},
+	)
+
+	cfg := Config{
+		StartYear:  2025,
+		TaxRegions: []core.Region{core.RegionCA, core.RegionBC},
+		Dependents: []*human.Person{{AgeMonths: 24}},
+		Years:      []YearInput{{Finances: finances}, {Finances: finances}, {Finances: finances}},
+	}
+
+	rows, err := Project(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rows[0].Indexed || !rows[1].Indexed || !rows[2].Indexed {
+		t.Errorf("unexpected indexation: %t, %t, %t", rows[0].Indexed, rows[1].Indexed, rows[2].Indexed)
+	}
+
+	if rows[1].TaxA != rows[0].TaxA || rows[2].TaxA != rows[0].TaxA {
+		t.Errorf("expected unindexed params to result in the same tax, got: %.2f, %.2f, %.2f",
+			rows[0].TaxA, rows[1].TaxA, rows[2].TaxA)
+	}
+
+	type call struct {
+		kind     history.ParamsKind
+		from, to uint
+	}
+	var calls []call
+	cfg.Indexer = func(params history.Params, from, to uint) (history.Params, error) {
+		calls = append(calls, call{params.Kind(), from, to})
+		return params, nil
+	}
+
+	_, err = Project(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []call{
+		{history.KindTax, 2025, 2026},
+		{history.KindTax, 2025, 2026},
+		{history.KindRRSP, 2025, 2026},
+		{history.KindChildBenefit, 2025, 2026},
+		{history.KindChildBenefit, 2025, 2026},
+		{history.KindTax, 2025, 2027},
+		{history.KindTax, 2025, 2027},
+		{history.KindRRSP, 2025, 2027},
+		{history.KindChildBenefit, 2025, 2027},
+		{history.KindChildBenefit, 2025, 2027},
+	}
+	diff := deep.Equal(calls, expected)
+	if diff != nil {
+		t.Error("actual does not match expected\n" + strings.Join(diff, "\n"))
+	}
+
+	errIndexer := errors.New("indexer error")
+	cfg.Indexer = func(history.Params, uint, uint) (history.Params, error) {
+		return nil, errIndexer
+	}
+
+	_, err = Project(cfg)
+	if errors.Cause(err) != errIndexer {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", errIndexer, err)
+	}
+
+	if cfg.Registry != nil || len(history.DefaultRegistry().Years(history.KindTax, core.RegionCA)) != 8 {
+		t.Error("expected the registry to not be modified")
+	}
+}
+
+func TestProject_Indexation(t *testing.T) {
+
+	finances := factory.NewFinanceFactory().NewHouseholdFinancesForSingle(
+		map[core.FinancialSource]float64{core.IncSrcEarned: 70000},
+	)
+
+	rows, err := Project(Config{
+		StartYear:  2025,
+		TaxRegions: []core.Region{core.RegionCA, core.RegionBC},
+		Years:      []YearInput{{Finances: finances}, {Finances: finances}},
+		Indexer:    indexation.Annually(1.02),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rows[1].TaxA >= rows[0].TaxA {
+		t.Errorf("expected indexed brackets to reduce the tax on the same income, got: %.2f, %.2f",
+			rows[0].TaxA, rows[1].TaxA)
+	}
+}
+
+func TestProject_Errors(t *testing.T) {
+
+	finances := factory.NewFinanceFactory().NewHouseholdFinancesForSingle(nil)
+	years := []YearInput{{Finances: finances}}
+	regions := []core.Region{core.RegionCA}
+
+	cases := []struct {
+		name string
+		cfg  Config
+		err  error
+	}{
+		{
+			name: "no-start-year",
+			cfg:  Config{TaxRegions: regions, Years: years},
+			err:  ErrInvalidYear,
+		},
+		{
+			name: "no-years",
+			cfg:  Config{StartYear: 2019, TaxRegions: regions},
+			err:  ErrNoYears,
+		},
+		{
+			name: "no-regions",
+			cfg:  Config{StartYear: 2019, Years: years},
+			err:  ErrNoRegions,
+		},
+		{
+			name: "nil-finances",
+			cfg:  Config{StartYear: 2019, TaxRegions: regions, Years: []YearInput{{}}},
+			err:  ErrNoFinances,
+		},
+		{
+			name: "negative-room",
+			cfg:  Config{StartYear: 2019, TaxRegions: regions, Years: years, RRSPRoomB: -1},
+			err:  ErrInvalidRoom,
+		},
+		{
+			name: "unknown-region",
+			cfg:  Config{StartYear: 2019, TaxRegions: []core.Region{"Atlantis"}, Years: years},
+			err:  history.ErrRegionNotExist,
+		},
+		{
+			name: "before-registered-years",
+			cfg:  Config{StartYear: 2010, TaxRegions: regions, Years: years},
+			err:  history.ErrParamsNotExist,
+		},
+		{
+			name: "discontinued-benefits",
+			cfg: Config{
+				Registry:       discontinuedRegistry(t),
+				StartYear:      2021,
+				TaxRegions:     regions,
+				BenefitRegions: []core.Region{core.RegionBC},
+				Years:          years,
+			},
+			err: history.ErrParamsNotExist,
+		},
+		{
+			name: "unknown-credit-owner",
+			cfg: Config{
+				StartYear:  2019,
+				TaxRegions: regions,
+				Years:      years,
+				Credits:    []tax.CreditRecord{{Owner: "spouse-c"}},
+			},
+			err: tax.ErrInvalidTaxArg,
+		},
+	}
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			_, err := Project(c.cfg)
+			if errors.Cause(err) != c.err {
+				t.Errorf("unexpected error\nwant: %v\n got: %v", c.err, err)
+			}
+		})
+	}
+}
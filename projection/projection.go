@@ -0,0 +1,17 @@
+// Package projection projects the payable tax, recievable benefits, and RRSP
+// activity of a household over multiple years. Children are aged from one
+// year to the next, and unused tax credits and RRSP contribution room are
+// carried forward
+package projection
+
+import "errors"
+
+// Sentinel errors that can be wrapped and returned by this package
+var (
+	ErrInvalidYear        = errors.New("invalid year")
+	ErrNoYears            = errors.New("no years given")
+	ErrNoRegions          = errors.New("no tax regions given")
+	ErrNoFinances         = errors.New("no finances given")
+	ErrInvalidRoom        = errors.New("invalid RRSP contribution room")
+	ErrUnsupportedFormula = errors.New("formula cannot be associated with another year")
+)
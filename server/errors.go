@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/malkhamis/quantax/core/benefits"
+	"github.com/malkhamis/quantax/core/income"
+	"github.com/malkhamis/quantax/core/rrsp"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors that can be wrapped and returned by this package
+var (
+	ErrInvalidRequest   = errors.New("invalid request")
+	ErrNotFound         = errors.New("no such endpoint")
+	ErrMethodNotAllowed = errors.New("method not allowed")
+)
+
+// errorResponse is the body of the responses of failed requests
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+// errorBody describes the error of a failed request
+type errorBody struct {
+	// Code is a stable identifier of the error that clients can check
+	Code string `json:"code"`
+	// Message is a human-readable description of the error
+	Message string `json:"message"`
+}
+
+// errorMapping is the status code and error code of a sentinel error
+type errorMapping struct {
+	status int
+	code   string
+}
+
+// errorMappings maps sentinel errors to status codes and error codes. Errors
+// that are not listed here are internal server errors
+var errorMappings = map[error]errorMapping{
+	ErrInvalidRequest:   {http.StatusBadRequest, "invalid_request"},
+	ErrNotFound:         {http.StatusNotFound, "not_found"},
+	ErrMethodNotAllowed: {http.StatusMethodNotAllowed, "method_not_allowed"},
+
+	factory.ErrInvalidHousehold: {http.StatusBadRequest, "invalid_request"},
+
+	history.ErrRegionNotExist: {http.StatusNotFound, "region_not_exist"},
+	history.ErrParamsNotExist: {http.StatusNotFound, "params_not_exist"},
+
+	tax.ErrTooManyYears:  {http.StatusUnprocessableEntity, "too_many_years"},
+	tax.ErrInvalidTaxArg: {http.StatusUnprocessableEntity, "invalid_tax_arg"},
+
+	factory.ErrFactoryNotInit: {http.StatusInternalServerError, "factory_not_init"},
+	factory.ErrNoRegistry:     {http.StatusInternalServerError, "no_registry"},
+	tax.ErrNoFormula:          {http.StatusInternalServerError, "invalid_params"},
+	tax.ErrNoContraFormula:    {http.StatusInternalServerError, "invalid_params"},
+	benefits.ErrNoFormula:     {http.StatusInternalServerError, "invalid_params"},
+	rrsp.ErrNoFormula:         {http.StatusInternalServerError, "invalid_params"},
+	income.ErrNoRecipe:        {http.StatusInternalServerError, "invalid_params"},
+}
+
+// mapError returns the status code and the response body of the given error
+func mapError(err error) (int, errorResponse) {
+
+	mapping, ok := errorMappings[errors.Cause(err)]
+	if !ok {
+		mapping = errorMapping{http.StatusInternalServerError, "internal"}
+	}
+
+	return mapping.status, errorResponse{
+		Error: errorBody{Code: mapping.code, Message: err.Error()},
+	}
+}
@@ -0,0 +1,263 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+)
+
+// TaxResponse is the body of the responses of tax requests
+type TaxResponse struct {
+	Year    uint          `json:"year"`
+	Regions []core.Region `json:"regions"`
+	SpouseA *SpouseTax    `json:"spouse_a"`
+	SpouseB *SpouseTax    `json:"spouse_b,omitempty"`
+	Total   float64       `json:"total"`
+}
+
+// SpouseTax is the payable tax of a single spouse
+type SpouseTax struct {
+	Breakdowns []TaxBreakdown `json:"breakdowns"`
+	Total      float64        `json:"total"`
+}
+
+// TaxBreakdown itemizes the payable tax of a single spouse in a single region
+type TaxBreakdown struct {
+	Region         core.Region     `json:"region"`
+	NetIncome      float64         `json:"net_income"`
+	GrossTax       float64         `json:"gross_tax"`
+	CreditsApplied float64         `json:"credits_applied"`
+	Adjustments    []TaxAdjustment `json:"adjustments,omitempty"`
+	NetPayable     float64         `json:"net_payable"`
+}
+
+// TaxAdjustment is a post-credit adjustment of the payable tax
+type TaxAdjustment struct {
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// BenefitsResponse is the body of the responses of child benefit requests
+type BenefitsResponse struct {
+	Year     uint            `json:"year"`
+	Benefits []RegionBenefit `json:"benefits"`
+	Total    float64         `json:"total"`
+}
+
+// RegionBenefit is the child benefits receivable in a single region
+type RegionBenefit struct {
+	Region core.Region `json:"region"`
+	Amount float64     `json:"amount"`
+}
+
+// RRSPResponse is the body of the responses of RRSP requests. Only the field
+// of the requested calculation is set
+type RRSPResponse struct {
+	Year               uint     `json:"year"`
+	Spouse             string   `json:"spouse"`
+	Amount             float64  `json:"amount"`
+	TaxRefund          *float64 `json:"tax_refund,omitempty"`
+	TaxPaid            *float64 `json:"tax_paid,omitempty"`
+	ContributionEarned *float64 `json:"contribution_earned,omitempty"`
+}
+
+// ParamsResponse is the body of the responses of params requests. It maps the
+// names of the kinds of params, e.g. "tax", to the regions for which params are
+// registered and the years they are registered for
+type ParamsResponse map[history.ParamsKind]map[core.Region][]uint
+
+func (s *Server) handleTax(r *http.Request) (interface{}, error) {
+
+	req := &HouseholdRequest{}
+	err := decodeRequest(r.Body, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := req.DependentPeople()
+	if err != nil {
+		return nil, err
+	}
+
+	finances := req.Finances()
+	calculator, err := factory.NewTaxFactoryWithRegistry(s.registry, req.Year, req.Regions...).NewCalculator()
+	if err != nil {
+		return nil, err
+	}
+	calculator.SetFinances(finances, nil)
+	calculator.SetDependents(dependents)
+
+	breakdownA, breakdownB, _ := calculator.TaxBreakdown()
+
+	resp := &TaxResponse{
+		Year:    req.Year,
+		Regions: req.Regions,
+		SpouseA: newSpouseTax(breakdownA),
+	}
+	resp.Total = resp.SpouseA.Total
+	if req.IsCouple() {
+		resp.SpouseB = newSpouseTax(breakdownB)
+		resp.Total += resp.SpouseB.Total
+	}
+
+	return resp, nil
+}
+
+func (s *Server) handleBenefits(r *http.Request) (interface{}, error) {
+
+	req := &HouseholdRequest{}
+	err := decodeRequest(r.Body, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := req.DependentPeople()
+	if err != nil {
+		return nil, err
+	}
+
+	finances := req.Finances()
+	resp := &BenefitsResponse{Year: req.Year}
+	for _, region := range req.Regions {
+
+		calculator, err := factory.NewChildBenefitFactoryWithRegistry(s.registry, req.Year, region).NewCalculator()
+		if err != nil {
+			return nil, err
+		}
+		calculator.SetFinances(finances)
+		calculator.SetBeneficiaries(dependents)
+
+		amount := calculator.BenefitRecievable()
+		resp.Benefits = append(resp.Benefits, RegionBenefit{Region: region, Amount: amount})
+		resp.Total += amount
+	}
+
+	return resp, nil
+}
+
+func (s *Server) handleRRSPRefund(r *http.Request) (interface{}, error) {
+	return s.handleRRSP(r, func(calculator core.RRSPCalculator, resp *RRSPResponse) {
+		refund, _ := calculator.TaxRefund(resp.Amount)
+		resp.TaxRefund = &refund
+	})
+}
+
+func (s *Server) handleRRSPWithdrawal(r *http.Request) (interface{}, error) {
+	return s.handleRRSP(r, func(calculator core.RRSPCalculator, resp *RRSPResponse) {
+		paid, _ := calculator.TaxPaid(resp.Amount)
+		resp.TaxPaid = &paid
+	})
+}
+
+func (s *Server) handleRRSPRoom(r *http.Request) (interface{}, error) {
+	return s.handleRRSP(r, func(calculator core.RRSPCalculator, resp *RRSPResponse) {
+		earned := calculator.ContributionEarned()
+		resp.ContributionEarned = &earned
+	})
+}
+
+// handleRRSP decodes an RRSP request, sets up an RRSP calculator for it, and
+// calls calculate to set the requested fields of the response
+func (s *Server) handleRRSP(r *http.Request, calculate func(core.RRSPCalculator, *RRSPResponse)) (interface{}, error) {
+
+	req := &RRSPRequest{}
+	err := decodeRequest(r.Body, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = req.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := req.DependentPeople()
+	if err != nil {
+		return nil, err
+	}
+
+	config := factory.RRSPFactoryConfig{
+		Year:       req.Year,
+		RRSPRegion: req.RRSPRegion,
+		TaxRegions: req.Regions,
+	}
+	calculator, err := factory.NewRRSPFactoryWithRegistry(s.registry, config).NewCalculator()
+	if err != nil {
+		return nil, err
+	}
+
+	finances := req.Finances()
+	calculator.SetFinances(finances, nil)
+	calculator.SetDependents(dependents)
+	if req.Spouse == "b" {
+		calculator.SetTargetSpouseB()
+	}
+
+	resp := &RRSPResponse{
+		Year:   req.Year,
+		Spouse: req.Spouse,
+		Amount: req.Amount,
+	}
+	calculate(calculator, resp)
+	return resp, nil
+}
+
+func (s *Server) handleParams(r *http.Request) (interface{}, error) {
+
+	resp := make(ParamsResponse)
+	for _, kind := range history.ParamsKinds() {
+		resp[kind] = s.paramsYears(kind)
+	}
+	return resp, nil
+}
+
+// paramsYears maps the regions for which params of the given kind are
+// registered to the years they are registered for
+func (s *Server) paramsYears(kind history.ParamsKind) map[core.Region][]uint {
+
+	years := make(map[core.Region][]uint)
+	for _, region := range s.registry.Regions(kind) {
+		years[region] = s.registry.Years(kind, region)
+	}
+	return years
+}
+
+// newSpouseTax returns the payable tax of a single spouse, where the total is
+// the sum of the net payable tax in the given breakdowns
+func newSpouseTax(breakdowns []core.TaxBreakdown) *SpouseTax {
+
+	spouse := &SpouseTax{}
+	for _, b := range breakdowns {
+
+		spouse.Total += b.NetPayable
+
+		breakdown := TaxBreakdown{
+			Region:         b.Region,
+			NetIncome:      b.NetIncome,
+			GrossTax:       b.GrossTax,
+			CreditsApplied: b.CreditsApplied,
+			NetPayable:     b.NetPayable,
+		}
+		for _, adj := range b.Adjustments {
+			breakdown.Adjustments = append(breakdown.Adjustments, TaxAdjustment{
+				Description: adj.Description,
+				Amount:      adj.Amount,
+			})
+		}
+
+		spouse.Breakdowns = append(spouse.Breakdowns, breakdown)
+	}
+	return spouse
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/pkg/errors"
+)
+
+// maxRequestBytes is the maximum size of request bodies
+const maxRequestBytes = 1 << 20
+
+// HouseholdRequest is the body of the tax and child benefit requests
+type HouseholdRequest struct {
+	factory.HouseholdDesc
+}
+
+// RRSPRequest is the body of the RRSP requests
+type RRSPRequest struct {
+	HouseholdRequest
+	// RRSPRegion is the region of the RRSP rules, which defaults to Canada
+	RRSPRegion core.Region `json:"rrsp_region,omitempty"`
+	// Spouse is the spouse ("a" or "b") who contributes or withdraws, which
+	// defaults to spouse A
+	Spouse string `json:"spouse,omitempty"`
+	// Amount is the contribution or withdrawal amount
+	Amount float64 `json:"amount"`
+}
+
+// decodeRequest decodes the JSON body in r into v, rejecting unknown fields
+func decodeRequest(r io.Reader, v interface{}) error {
+
+	decoder := json.NewDecoder(io.LimitReader(r, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidRequest, "error decoding body: %v", err)
+	}
+	return nil
+}
+
+// validate ensures that this request has all the required fields and sets
+// the defaults of the optional ones
+func (req *RRSPRequest) validate() error {
+
+	err := req.Validate()
+	if err != nil {
+		return err
+	}
+
+	if req.RRSPRegion == "" {
+		req.RRSPRegion = core.RegionCA
+	}
+
+	switch req.Spouse {
+	case "", "a":
+		req.Spouse = "a"
+	case "b":
+		if !req.IsCouple() {
+			return errors.Wrap(ErrInvalidRequest, "spouse b is given for a single-person household")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidRequest, "unknown spouse %q", req.Spouse)
+	}
+
+	if req.Amount < 0 {
+		return errors.Wrap(ErrInvalidRequest, "negative amount")
+	}
+
+	return nil
+}
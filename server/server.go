@@ -0,0 +1,107 @@
+// Package server exposes the calculators of package factory over HTTP/JSON.
+//
+// All calculation endpoints accept POST requests with a JSON body and respond
+// with a JSON body:
+//   POST /v1/tax              HouseholdRequest -> TaxResponse
+//   POST /v1/benefits         HouseholdRequest -> BenefitsResponse
+//   POST /v1/rrsp/refund      RRSPRequest      -> RRSPResponse
+//   POST /v1/rrsp/withdrawal  RRSPRequest      -> RRSPResponse
+//   POST /v1/rrsp/room        RRSPRequest      -> RRSPResponse
+//   GET  /v1/params           ParamsResponse
+//
+// Failed requests are responded to with a status code that reflects the cause
+// of the failure and a body like:
+//   {"error": {"code": "region_not_exist", "message": "..."}}
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+// Server is an http.Handler that serves calculations using the params of a
+// registry
+type Server struct {
+	registry *history.Registry
+	mux      *http.ServeMux
+}
+
+// NewServer returns a new server that calculates using the params registered
+// in the given registry
+func NewServer(registry *history.Registry) (*Server, error) {
+
+	if registry == nil {
+		return nil, factory.ErrNoRegistry
+	}
+
+	s := &Server{
+		registry: registry,
+		mux:      http.NewServeMux(),
+	}
+
+	s.handle("/v1/tax", http.MethodPost, s.handleTax)
+	s.handle("/v1/benefits", http.MethodPost, s.handleBenefits)
+	s.handle("/v1/rrsp/refund", http.MethodPost, s.handleRRSPRefund)
+	s.handle("/v1/rrsp/withdrawal", http.MethodPost, s.handleRRSPWithdrawal)
+	s.handle("/v1/rrsp/room", http.MethodPost, s.handleRRSPRoom)
+	s.handle("/v1/params", http.MethodGet, s.handleParams)
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, errors.Wrapf(ErrNotFound, "%s", r.URL.Path))
+	})
+
+	return s, nil
+}
+
+// ServeHTTP dispatches the request to the handler of its endpoint
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handlerFunc handles a request and returns the response body or an error
+type handlerFunc func(r *http.Request) (interface{}, error)
+
+// handle registers the given handler for the given path and method
+func (s *Server) handle(path, method string, handler handlerFunc) {
+
+	s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, errors.Wrapf(ErrMethodNotAllowed, "%s %s", r.Method, path))
+			return
+		}
+
+		body, err := handler(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// writeError writes the given error as a JSON error response
+func writeError(w http.ResponseWriter, err error) {
+	status, body := mapError(err)
+	writeJSON(w, status, body)
+}
+
+// writeJSON writes the given body as a JSON response with the given status
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		var errBody errorResponse
+		status, errBody = mapError(errors.Wrap(err, "error encoding response"))
+		data, _ = json.Marshal(errBody)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -0,0 +1,395 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/malkhamis/quantax/core"
+	"github.com/malkhamis/quantax/core/human"
+	"github.com/malkhamis/quantax/core/tax"
+	"github.com/malkhamis/quantax/factory"
+	"github.com/malkhamis/quantax/history"
+	"github.com/pkg/errors"
+)
+
+const testHousehold = `
+	"year": 2019,
+	"regions": ["Canada", "British Columbia"],
+	"spouse_a": {"earned": 85000, "capital-gain-ca": 2000},
+	"spouse_b": {"earned": 30000, "rrsp-contribution": 1000},
+	"dependents": [{"name": "A", "age_months": 30}]`
+
+func testFinances() core.HouseholdFinanceMutator {
+	return factory.NewFinanceFactory().NewHouseholdFinancesForCouple(
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned:        85000,
+			core.IncSrcCapitalGainCA: 2000,
+		},
+		map[core.FinancialSource]float64{
+			core.IncSrcEarned: 30000,
+			core.DeducSrcRRSP: 1000,
+		},
+	)
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	s, err := NewServer(history.DefaultRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(s)
+}
+
+func post(t *testing.T, ts *httptest.Server, path, body string, resp interface{}) int {
+	t.Helper()
+
+	res, err := http.Post(ts.URL+path, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode
+}
+
+func TestNewServer_NoRegistry(t *testing.T) {
+
+	_, err := NewServer(nil)
+	if errors.Cause(err) != factory.ErrNoRegistry {
+		t.Errorf("unexpected error\nwant: %v\n got: %v", factory.ErrNoRegistry, err)
+	}
+}
+
+func TestServer_Tax(t *testing.T) {
+
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	resp := &TaxResponse{}
+	status := post(t, ts, "/v1/tax", "{"+testHousehold+"}", resp)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status\nwant: %d\n got: %d", http.StatusOK, status)
+	}
+
+	calculator, err := factory.NewTaxFactory(2019, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances(), nil)
+	taxA, taxB, _ := calculator.TaxPayable()
+
+	if resp.SpouseA == nil || resp.SpouseB == nil {
+		t.Fatal("expected the tax of each spouse")
+	}
+	if math.Abs(resp.SpouseA.Total-taxA) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxA, resp.SpouseA.Total)
+	}
+	if math.Abs(resp.SpouseB.Total-taxB) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxB, resp.SpouseB.Total)
+	}
+	if math.Abs(resp.Total-(taxA+taxB)) > 1e-6 {
+		t.Errorf("unexpected tax\nwant: %.2f\n got: %.2f", taxA+taxB, resp.Total)
+	}
+	if len(resp.SpouseA.Breakdowns) != 2 {
+		t.Errorf("expected a breakdown for each region, got %d", len(resp.SpouseA.Breakdowns))
+	}
+}
+
+func TestServer_Benefits(t *testing.T) {
+
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	resp := &BenefitsResponse{}
+	status := post(t, ts, "/v1/benefits", "{"+testHousehold+"}", resp)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status\nwant: %d\n got: %d", http.StatusOK, status)
+	}
+
+	calculator, err := factory.NewChildBenefitFactory(2019, core.RegionCA, core.RegionBC).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances())
+	calculator.SetBeneficiaries([]*human.Person{{Name: "A", AgeMonths: 30}})
+	expected := calculator.BenefitRecievable()
+
+	if len(resp.Benefits) != 2 {
+		t.Errorf("expected the benefits of each region, got %d", len(resp.Benefits))
+	}
+	if math.Abs(resp.Total-expected) > 1e-6 {
+		t.Errorf("unexpected benefits\nwant: %.2f\n got: %.2f", expected, resp.Total)
+	}
+}
+
+func TestServer_RRSP(t *testing.T) {
+
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	config := factory.RRSPFactoryConfig{
+		Year:       2019,
+		RRSPRegion: core.RegionCA,
+		TaxRegions: []core.Region{core.RegionCA, core.RegionBC},
+	}
+	calculator, err := factory.NewRRSPFactory(config).NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calculator.SetFinances(testFinances(), nil)
+	calculator.SetTargetSpouseB()
+
+	body := "{" + testHousehold + `, "spouse": "b", "amount": 2000}`
+
+	refund := &RRSPResponse{}
+	status := post(t, ts, "/v1/rrsp/refund", body, refund)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status\nwant: %d\n got: %d", http.StatusOK, status)
+	}
+	expected, _ := calculator.TaxRefund(2000)
+	if refund.TaxRefund == nil || math.Abs(*refund.TaxRefund-expected) > 1e-6 {
+		t.Errorf("unexpected refund\nwant: %.2f\n got: %v", expected, refund.TaxRefund)
+	}
+	if refund.TaxPaid != nil || refund.ContributionEarned != nil {
+		t.Error("expected only the refund to be set")
+	}
+
+	withdrawal := &RRSPResponse{}
+	status = post(t, ts, "/v1/rrsp/withdrawal", body, withdrawal)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status\nwant: %d\n got: %d", http.StatusOK, status)
+	}
+	expected, _ = calculator.TaxPaid(2000)
+	if withdrawal.TaxPaid == nil || math.Abs(*withdrawal.TaxPaid-expected) > 1e-6 {
+		t.Errorf("unexpected tax paid\nwant: %.2f\n got: %v", expected, withdrawal.TaxPaid)
+	}
+
+	room := &RRSPResponse{}
+	status = post(t, ts, "/v1/rrsp/room", body, room)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status\nwant: %d\n got: %d", http.StatusOK, status)
+	}
+	expected = calculator.ContributionEarned()
+	if room.ContributionEarned == nil || *room.ContributionEarned != expected {
+		t.Errorf("unexpected contribution room\nwant: %.2f\n got: %v", expected, room.ContributionEarned)
+	}
+}
+
+func TestServer_Params(t *testing.T) {
+
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/v1/params")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	resp := ParamsResponse{}
+	err = json.NewDecoder(res.Body).Decode(&resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := history.DefaultRegistry()
+	expected := registry.Years(history.KindTax, core.RegionCA)
+	if !reflect.DeepEqual(resp[history.KindTax][core.RegionCA], expected) {
+		t.Errorf("unexpected years\nwant: %v\n got: %v", expected, resp[history.KindTax][core.RegionCA])
+	}
+	if len(resp[history.KindTax]) != len(registry.Regions(history.KindTax)) {
+		t.Errorf("expected %d tax regions, got %d", len(registry.Regions(history.KindTax)), len(resp[history.KindTax]))
+	}
+	for _, kind := range history.ParamsKinds() {
+		if len(resp[kind]) == 0 {
+			t.Errorf("expected the regions of %s params", kind)
+		}
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{
+			name:   "unknown-path",
+			method: http.MethodGet,
+			path:   "/v1/magic",
+			status: http.StatusNotFound,
+			code:   "not_found",
+		},
+		{
+			name:   "wrong-method",
+			method: http.MethodGet,
+			path:   "/v1/tax",
+			status: http.StatusMethodNotAllowed,
+			code:   "method_not_allowed",
+		},
+		{
+			name:   "malformed-body",
+			method: http.MethodPost,
+			path:   "/v1/tax",
+			body:   "{",
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "unknown-field",
+			method: http.MethodPost,
+			path:   "/v1/tax",
+			body:   "{" + testHousehold + `, "pets": 2}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "invalid-birth-date",
+			method: http.MethodPost,
+			path:   "/v1/benefits",
+			body:   `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"birth_date": "2018-13-01"}]}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "invalid-custody",
+			method: http.MethodPost,
+			path:   "/v1/benefits",
+			body:   `{"year": 2019, "regions": ["Canada"], "spouse_a": {}, "dependents": [{"custody_percent": 120}]}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "missing-year",
+			method: http.MethodPost,
+			path:   "/v1/benefits",
+			body:   `{"regions": ["Canada"], "spouse_a": {}}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "unknown-source",
+			method: http.MethodPost,
+			path:   "/v1/tax",
+			body:   `{"year": 2019, "regions": ["Canada"], "spouse_a": {"lottery": 1}}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "unknown-spouse",
+			method: http.MethodPost,
+			path:   "/v1/rrsp/refund",
+			body:   "{" + testHousehold + `, "spouse": "c"}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "negative-amount",
+			method: http.MethodPost,
+			path:   "/v1/rrsp/withdrawal",
+			body:   "{" + testHousehold + `, "amount": -1}`,
+			status: http.StatusBadRequest,
+			code:   "invalid_request",
+		},
+		{
+			name:   "unknown-region",
+			method: http.MethodPost,
+			path:   "/v1/tax",
+			body:   `{"year": 2019, "regions": ["Atlantis"], "spouse_a": {}}`,
+			status: http.StatusNotFound,
+			code:   "region_not_exist",
+		},
+		{
+			name:   "unknown-year",
+			method: http.MethodPost,
+			path:   "/v1/rrsp/room",
+			body:   `{"year": 1000, "regions": ["Canada"], "spouse_a": {}}`,
+			status: http.StatusNotFound,
+			code:   "params_not_exist",
+		},
+		{
+			name:   "no-child-benefits",
+			method: http.MethodPost,
+			path:   "/v1/benefits",
+			body:   `{"year": 2019, "regions": ["Alberta"], "spouse_a": {}}`,
+			status: http.StatusNotFound,
+			code:   "region_not_exist",
+		},
+	}
+
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	for i, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("case%d-%s", i, c.name), func(t *testing.T) {
+
+			req, err := http.NewRequest(c.method, ts.URL+c.path, strings.NewReader(c.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != c.status {
+				t.Errorf("unexpected status\nwant: %d\n got: %d", c.status, res.StatusCode)
+			}
+
+			resp := &errorResponse{}
+			err = json.NewDecoder(res.Body).Decode(resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error.Code != c.code {
+				t.Errorf("unexpected error code\nwant: %q\n got: %q", c.code, resp.Error.Code)
+			}
+			if resp.Error.Message == "" {
+				t.Error("expected an error message")
+			}
+		})
+	}
+}
+
+func TestMapError(t *testing.T) {
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{errors.Wrap(history.ErrRegionNotExist, "wrapped"), http.StatusNotFound},
+		{errors.Wrap(history.ErrParamsNotExist, "wrapped"), http.StatusNotFound},
+		{errors.Wrap(tax.ErrTooManyYears, "wrapped"), http.StatusUnprocessableEntity},
+		{errors.Wrap(factory.ErrFactoryNotInit, "wrapped"), http.StatusInternalServerError},
+		{errors.New("unknown"), http.StatusInternalServerError},
+	}
+
+	for i, c := range cases {
+		status, body := mapError(c.err)
+		if status != c.status {
+			t.Errorf("case%d: unexpected status\nwant: %d\n got: %d", i, c.status, status)
+		}
+		if body.Error.Message != c.err.Error() {
+			t.Errorf("case%d: unexpected message\nwant: %q\n got: %q", i, c.err.Error(), body.Error.Message)
+		}
+	}
+}